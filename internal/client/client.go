@@ -2,22 +2,279 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"math/rand/v2"
 	"net/http"
 	"os"
+	"runtime"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/hashicorp/go-uuid"
 )
 
 type Client struct {
-	httpClient  *http.Client
-	apiToken    string
-	apiEndpoint string
-	logger      *Logger
+	httpClient      *http.Client
+	apiToken        string
+	apiEndpoint     string
+	logger          *Logger
+	retriesDisabled bool
+	maxRetries      int
+	backoffStrategy BackoffStrategy
+	backoffJitter   bool
+	maxReplicas     int64
+	// readCacheDisabled asks HTTP caches between the provider and the API to
+	// revalidate every GET instead of serving a stored response
+	readCacheDisabled bool
+	// userAgent identifies the provider and its version on every request
+	userAgent string
+	// extraHeaders are sent with every request, see WithExtraHeaders
+	extraHeaders map[string]string
+	// transport is the transport the client created itself. Options only
+	// tune this one, never a transport passed in with WithTransport.
+	transport *http.Transport
+
+	// statusPollInterval is the first wait between status polls in
+	// WaitForApplicationStatus. Zero uses minStatusPollInterval.
+	statusPollInterval time.Duration
+	// deprecationsMu guards the deprecation notices seen so far and those not
+	// yet handed out by DeprecationNotices
+	deprecationsMu      sync.Mutex
+	seenDeprecations    map[string]bool
+	pendingDeprecations []DeprecationNotice
+}
+
+// DeprecationNotice describes an endpoint the API flagged as deprecated via
+// the Deprecation and Sunset response headers
+type DeprecationNotice struct {
+	Method      string
+	Path        string
+	Deprecation string
+	Sunset      string
+	Link        string
+}
+
+func (n DeprecationNotice) String() string {
+	msg := fmt.Sprintf("Ploi Cloud API endpoint %s %s is deprecated", n.Method, n.Path)
+	if n.Sunset != "" {
+		msg += fmt.Sprintf(", it will be removed after %s", n.Sunset)
+	}
+	if n.Link != "" {
+		msg += fmt.Sprintf(" (see %s)", n.Link)
+	}
+	return msg
+}
+
+// BackoffStrategy selects how the wait between retried requests grows
+type BackoffStrategy string
+
+const (
+	// BackoffLinear waits one more second after every failed attempt
+	BackoffLinear BackoffStrategy = "linear"
+	// BackoffExponential doubles the wait after every failed attempt, up to maxBackoff
+	BackoffExponential BackoffStrategy = "exponential"
+)
+
+// DefaultAPIEndpoint is the API endpoint used when NewClient is given none
+const DefaultAPIEndpoint = "https://cloud.ploi.io/api/v1"
+
+// DefaultMaxRetries is how often a failed request is retried when no
+// WithMaxRetries option is given
+const DefaultMaxRetries = 3
+
+const (
+	baseBackoff = 1 * time.Second
+	maxBackoff  = 30 * time.Second
+	// maxRetryAfter caps the wait a Retry-After header can ask for, so a
+	// misbehaving server cannot stall the provider indefinitely
+	maxRetryAfter = 2 * time.Minute
+)
+
+const (
+	minStatusPollInterval = 2 * time.Second
+	maxStatusPollInterval = 15 * time.Second
+)
+
+// applicationFailureStatuses are terminal statuses WaitForApplicationStatus
+// stops at, since the application will not reach the target status on its own
+var applicationFailureStatuses = map[string]bool{
+	"failed": true,
+	"error":  true,
+}
+
+// ClientOption configures optional Client behaviour in NewClient
+type ClientOption func(*Client)
+
+// WithRetriesDisabled makes every request a single attempt, regardless of the
+// number of retries the caller asks for. Useful against mocked APIs where
+// retries only hide failures and slow things down.
+func WithRetriesDisabled() ClientOption {
+	return func(c *Client) {
+		c.retriesDisabled = true
+	}
+}
+
+// WithMaxRetries sets how often a request is retried after a server error or
+// network failure or after being rate limited (429). Other client errors
+// (4xx) are never retried.
+func WithMaxRetries(retries int) ClientOption {
+	return func(c *Client) {
+		c.maxRetries = retries
+	}
+}
+
+// WithBackoffStrategy selects how the wait between retries grows
+func WithBackoffStrategy(strategy BackoffStrategy) ClientOption {
+	return func(c *Client) {
+		c.backoffStrategy = strategy
+	}
+}
+
+// WithBackoffJitter randomizes every wait between half and the full computed
+// backoff, so clients that failed together do not retry in lockstep.
+func WithBackoffJitter() ClientOption {
+	return func(c *Client) {
+		c.backoffJitter = true
+	}
+}
+
+// DefaultRequestTimeout is how long a single API request may take when no
+// WithRequestTimeout option is given
+const DefaultRequestTimeout = 30 * time.Second
+
+// WithRequestTimeout overrides how long a single API request, including
+// reading the response body, may take before it is aborted.
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.httpClient.Timeout = timeout
+	}
+}
+
+const (
+	// DefaultMaxIdleConns is how many idle connections to the API are kept
+	// open for reuse when no WithMaxIdleConns option is given. It matches
+	// Terraform's default parallelism of 10.
+	DefaultMaxIdleConns = 10
+	// idleConnTimeout is how long an idle connection is kept open
+	idleConnTimeout = 90 * time.Second
+)
+
+// newTransport returns a copy of the default HTTP transport, keeping its proxy
+// and TLS settings, that keeps maxIdleConns connections to the API open.
+// Every request goes to the same host, so the per-host limit matches the
+// overall one instead of the default of 2.
+func newTransport(maxIdleConns int) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = maxIdleConns
+	transport.MaxIdleConnsPerHost = maxIdleConns
+	transport.IdleConnTimeout = idleConnTimeout
+	return transport
+}
+
+// WithMaxIdleConns overrides how many idle connections to the API are kept
+// open for reuse, e.g. for workspaces managing many resources with a raised
+// -parallelism. It only tunes the client's own transport, never one set with
+// WithTransport, whichever option comes first.
+func WithMaxIdleConns(maxIdleConns int) ClientOption {
+	return func(c *Client) {
+		c.transport.MaxIdleConns = maxIdleConns
+		c.transport.MaxIdleConnsPerHost = maxIdleConns
+	}
+}
+
+// WithTransport sends every request through transport instead of the default
+// HTTP transport, e.g. to mock, record or decorate requests. A nil transport
+// keeps the default.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		if transport != nil {
+			c.httpClient.Transport = transport
+		}
+	}
+}
+
+// DefaultMaxReplicas is the highest replica count ValidateServiceRequest and
+// ValidateWorkerRequest accept when no WithMaxReplicas option is given
+const DefaultMaxReplicas = 50
+
+// WithMaxReplicas overrides the highest replica count accepted by request
+// validation, e.g. for teams with a raised replica limit.
+func WithMaxReplicas(max int64) ClientOption {
+	return func(c *Client) {
+		c.maxReplicas = max
+	}
+}
+
+// WithReadCacheDisabled marks every GET request with Cache-Control:
+// no-cache, so proxies or gateways in front of the API cannot answer reads
+// with a stored response.
+func WithReadCacheDisabled() ClientOption {
+	return func(c *Client) {
+		c.readCacheDisabled = true
+	}
+}
+
+// WithProviderVersion reports version in the User-Agent of every request, so
+// the API can tell provider releases apart. Without it the version is "dev".
+func WithProviderVersion(version string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = userAgent(version)
+	}
+}
+
+// userAgent builds the User-Agent header for a provider version, including
+// the Go runtime the provider was built with
+func userAgent(version string) string {
+	if version == "" {
+		version = "dev"
+	}
+	return fmt.Sprintf("terraform-provider-ploicloud/%s (%s)", version, runtime.Version())
+}
+
+// ReservedHeaders are set by the client itself on every request and cannot be
+// replaced through WithExtraHeaders
+var ReservedHeaders = []string{"Authorization", "Content-Type", "Accept", "User-Agent"}
+
+// WithExtraHeaders sends headers with every request, e.g. an organisation
+// header required by a gateway in front of the API. ReservedHeaders are
+// ignored, matched case-insensitively.
+func WithExtraHeaders(headers map[string]string) ClientOption {
+	return func(c *Client) {
+		c.extraHeaders = make(map[string]string, len(headers))
+		for name, value := range headers {
+			name = http.CanonicalHeaderKey(name)
+			if slices.Contains(ReservedHeaders, name) {
+				continue
+			}
+			c.extraHeaders[name] = value
+		}
+	}
+}
+
+// readCacheBypassKey marks a context whose GET requests must bypass caches
+// regardless of WithReadCacheDisabled
+type readCacheBypassKey struct{}
+
+// idempotencyKeyKey carries the Idempotency-Key sent with every attempt of a
+// request, so a retried create is not applied twice by the API
+type idempotencyKeyKey struct{}
+
+// withIdempotencyKey returns a context whose requests carry a new random
+// Idempotency-Key, shared by all retries of a request made with it
+func withIdempotencyKey(ctx context.Context) (context.Context, error) {
+	key, err := uuid.GenerateUUID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate idempotency key: %w", err)
+	}
+	return context.WithValue(ctx, idempotencyKeyKey{}, key), nil
 }
 
 // Logger provides structured logging for API requests and responses
@@ -47,8 +304,77 @@ type DetailedError struct {
 	DocsLink   string              `json:"docs_link,omitempty"`
 }
 
-func NewClient(apiToken string, apiEndpoint *string) *Client {
-	endpoint := "https://cloud.ploi.io/api/v1"
+func (e *DetailedError) Error() string {
+	return e.Message
+}
+
+// APIError is returned by client methods when the API responds with an error
+// status. Use errors.As to inspect the status code and per-field errors.
+type APIError struct {
+	*DetailedError
+	Operation string
+}
+
+func (e *APIError) Error() string {
+	if e.DocsLink == "" {
+		return fmt.Sprintf("failed to %s: %s", e.Operation, e.Message)
+	}
+
+	return fmt.Sprintf("failed to %s: %s\nSuggestion: %s\nDocumentation: %s",
+		e.Operation, e.Message, e.Suggestion, e.DocsLink)
+}
+
+// Unwrap returns the wrapped *DetailedError so errors.As can reach it.
+func (e *APIError) Unwrap() error {
+	if e.DetailedError == nil {
+		return nil
+	}
+	return e.DetailedError
+}
+
+// IsNotFound reports whether err is an API error with status 404.
+func IsNotFound(err error) bool {
+	return errorStatusCode(err) == http.StatusNotFound
+}
+
+// IsValidationError reports whether err is an API error with status 422.
+func IsValidationError(err error) bool {
+	return errorStatusCode(err) == http.StatusUnprocessableEntity
+}
+
+// IsAuthError reports whether err is an API error with status 401 or 403.
+func IsAuthError(err error) bool {
+	status := errorStatusCode(err)
+	return status == http.StatusUnauthorized || status == http.StatusForbidden
+}
+
+// errorStatusCode returns the status code of the *DetailedError wrapped by
+// err, or 0 when err is not an API error.
+func errorStatusCode(err error) int {
+	var detailedErr *DetailedError
+	if errors.As(err, &detailedErr) {
+		return detailedErr.StatusCode
+	}
+	return 0
+}
+
+// StatusTimeoutError is returned by the Wait helpers when the application
+// does not reach the target status in time. It records the last status seen
+// and how long the wait took.
+type StatusTimeoutError struct {
+	ApplicationID int64
+	Target        string
+	LastStatus    string
+	Elapsed       time.Duration
+}
+
+func (e *StatusTimeoutError) Error() string {
+	return fmt.Sprintf("timed out after %v waiting for application %d to reach status '%s', last status '%s'",
+		e.Elapsed.Round(time.Second), e.ApplicationID, e.Target, e.LastStatus)
+}
+
+func NewClient(apiToken string, apiEndpoint *string, opts ...ClientOption) *Client {
+	endpoint := DefaultAPIEndpoint
 	if apiEndpoint != nil && *apiEndpoint != "" {
 		endpoint = *apiEndpoint
 	}
@@ -59,23 +385,198 @@ func NewClient(apiToken string, apiEndpoint *string) *Client {
 		debug:   os.Getenv("TF_LOG") == "DEBUG" || os.Getenv("PLOI_DEBUG") == "1",
 	}
 
-	return &Client{
+	transport := newTransport(DefaultMaxIdleConns)
+	c := &Client{
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   DefaultRequestTimeout,
+			Transport: transport,
 		},
-		apiToken:    apiToken,
-		apiEndpoint: endpoint,
-		logger:      logger,
+		transport:       transport,
+		apiToken:        apiToken,
+		apiEndpoint:     endpoint,
+		logger:          logger,
+		maxRetries:      DefaultMaxRetries,
+		backoffStrategy: BackoffLinear,
+		maxReplicas:     DefaultMaxReplicas,
+		userAgent:       userAgent(""),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// APIEndpoint returns the API endpoint requests are sent to
+func (c *Client) APIEndpoint() string {
+	return c.apiEndpoint
+}
+
+// RequestTimeout returns how long a single API request may take
+func (c *Client) RequestTimeout() time.Duration {
+	return c.httpClient.Timeout
+}
+
+// MaxIdleConns returns how many idle connections to the API are kept open, or
+// zero when a custom transport is in use
+func (c *Client) MaxIdleConns() int {
+	if c.httpClient.Transport != c.transport {
+		return 0
+	}
+	return c.transport.MaxIdleConnsPerHost
+}
+
+// UserAgent returns the User-Agent header sent with every request
+func (c *Client) UserAgent() string {
+	return c.userAgent
+}
+
+// ReadCacheDisabled reports whether every read bypasses HTTP caches
+func (c *Client) ReadCacheDisabled() bool {
+	return c.readCacheDisabled
+}
+
+func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	if c == nil {
+		return nil, fmt.Errorf("client is nil")
+	}
+	return c.doRequestWithRetry(ctx, method, path, body, c.maxRetries)
+}
+
+// recordDeprecation remembers a deprecation notice for the endpoint when the
+// response carries a Deprecation or Sunset header. Each endpoint is only
+// logged and queued once per client, the request itself is unaffected.
+func (c *Client) recordDeprecation(method, path string, header http.Header) {
+	deprecation := header.Get("Deprecation")
+	sunset := header.Get("Sunset")
+	if deprecation == "" && sunset == "" {
+		return
+	}
+
+	// Query strings carry IDs and filters, not a different endpoint
+	endpoint, _, _ := strings.Cut(path, "?")
+	key := method + " " + endpoint
+
+	c.deprecationsMu.Lock()
+	defer c.deprecationsMu.Unlock()
+
+	if c.seenDeprecations[key] {
+		return
+	}
+	if c.seenDeprecations == nil {
+		c.seenDeprecations = map[string]bool{}
+	}
+	c.seenDeprecations[key] = true
+
+	notice := DeprecationNotice{
+		Method:      method,
+		Path:        endpoint,
+		Deprecation: deprecation,
+		Sunset:      sunset,
+		Link:        header.Get("Link"),
+	}
+	c.pendingDeprecations = append(c.pendingDeprecations, notice)
+
+	log.Printf("[WARN] %s", notice)
+}
+
+// DeprecationNotices returns the deprecation notices received since the last
+// call. Every deprecated endpoint is reported once per client.
+func (c *Client) DeprecationNotices() []DeprecationNotice {
+	if c == nil {
+		return nil
 	}
+
+	c.deprecationsMu.Lock()
+	defer c.deprecationsMu.Unlock()
+
+	notices := c.pendingDeprecations
+	c.pendingDeprecations = nil
+	return notices
+}
+
+// backoffDuration returns how long to wait before retrying after the given
+// zero-based failed attempt.
+func (c *Client) backoffDuration(attempt int) time.Duration {
+	var backoff time.Duration
+	switch c.backoffStrategy {
+	case BackoffExponential:
+		backoff = maxBackoff
+		// Guard the shift so large attempt numbers cannot overflow
+		if attempt < 16 {
+			backoff = min(baseBackoff<<attempt, maxBackoff)
+		}
+	default:
+		backoff = time.Duration(attempt+1) * baseBackoff
+	}
+
+	if c.backoffJitter {
+		half := backoff / 2
+		backoff = half + rand.N(backoff-half+1)
+	}
+
+	return backoff
+}
+
+// retryAfterDuration parses a Retry-After header given either in seconds or
+// as an HTTP date, capped at maxRetryAfter. ok is false when the header is
+// missing or malformed.
+func retryAfterDuration(header string, now time.Time) (time.Duration, bool) {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0, false
+	}
+
+	var wait time.Duration
+	if seconds, err := strconv.ParseInt(header, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		// Compare in seconds so huge values cannot overflow the duration
+		if seconds > int64(maxRetryAfter/time.Second) {
+			return maxRetryAfter, true
+		}
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(header); err == nil {
+		wait = max(date.Sub(now), 0)
+	} else {
+		return 0, false
+	}
+
+	return min(wait, maxRetryAfter), true
+}
+
+// retryDelay returns how long to wait before retrying a retryable response,
+// honoring its Retry-After header over the configured backoff.
+func (c *Client) retryDelay(resp *http.Response, attempt int) time.Duration {
+	if wait, ok := retryAfterDuration(resp.Header.Get("Retry-After"), time.Now()); ok {
+		return wait
+	}
+	return c.backoffDuration(attempt)
 }
 
-func (c *Client) doRequest(method, path string, body interface{}) (*http.Response, error) {
-	return c.doRequestWithRetry(method, path, body, 3)
+// sleepContext waits for d, returning ctx.Err() early when ctx is cancelled
+// or expires first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
 
-func (c *Client) doRequestWithRetry(method, path string, body interface{}, maxRetries int) (*http.Response, error) {
+func (c *Client) doRequestWithRetry(ctx context.Context, method, path string, body interface{}, maxRetries int) (*http.Response, error) {
 	var lastResp *http.Response
 	var lastErr error
+
+	if c != nil && c.retriesDisabled {
+		maxRetries = 0
+	}
 	
 	for attempt := 0; attempt <= maxRetries; attempt++ {
 		start := time.Now()
@@ -110,9 +611,9 @@ func (c *Client) doRequestWithRetry(method, path string, body interface{}, maxRe
 				bodyBytes = []byte{}
 			}
 			requestBodyStr = c.sanitizeBody(string(bodyBytes))
-			req, err = http.NewRequest(method, url, bytes.NewReader(bodyBytes))
+			req, err = http.NewRequestWithContext(ctx, method, url, bytes.NewReader(bodyBytes))
 		} else {
-			req, err = http.NewRequest(method, url, nil)
+			req, err = http.NewRequestWithContext(ctx, method, url, nil)
 		}
 		
 		if err != nil {
@@ -125,19 +626,37 @@ func (c *Client) doRequestWithRetry(method, path string, body interface{}, maxRe
 			return nil, fmt.Errorf("request is nil after creation")
 		}
 
+		for name, value := range c.extraHeaders {
+			req.Header.Set(name, value)
+		}
 		req.Header.Set("Authorization", "Bearer "+c.apiToken)
 		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("Accept", "application/json")
+		req.Header.Set("User-Agent", c.userAgent)
+		if key, ok := ctx.Value(idempotencyKeyKey{}).(string); ok {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		if method == http.MethodGet && (c.readCacheDisabled || ctx.Value(readCacheBypassKey{}) != nil) {
+			req.Header.Set("Cache-Control", "no-cache")
+			req.Header.Set("Pragma", "no-cache")
+		}
 
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
 			lastErr = err
 			c.logRequest(method, url, requestBodyStr, 0, "", fmt.Sprintf("failed to execute HTTP request: %v", err), time.Since(start))
+
+			// A cancelled or expired context is not a transient failure
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("failed to execute HTTP request: %w", ctx.Err())
+			}
 			
 			if attempt < maxRetries {
-				backoffDuration := time.Duration(attempt+1) * time.Second
+				backoffDuration := c.backoffDuration(attempt)
 				c.logRequest(method, url, requestBodyStr, 0, "", fmt.Sprintf("retrying in %v (attempt %d/%d)", backoffDuration, attempt+1, maxRetries+1), time.Since(start))
-				time.Sleep(backoffDuration)
+				if err := sleepContext(ctx, backoffDuration); err != nil {
+					return nil, err
+				}
 				continue
 			}
 			return nil, fmt.Errorf("failed to execute HTTP request after %d attempts: %w", maxRetries+1, err)
@@ -149,12 +668,14 @@ func (c *Client) doRequestWithRetry(method, path string, body interface{}, maxRe
 			bodyBytes, readErr := io.ReadAll(resp.Body)
 			resp.Body.Close()
 			if readErr == nil {
-				responseBodyStr = string(bodyBytes)
+				responseBodyStr = c.sanitizeBody(string(bodyBytes))
 				// Recreate the response body for the caller
 				resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
 			}
 		}
 
+		c.recordDeprecation(method, path, resp.Header)
+
 		// Log the completed request
 		var errorMsg string
 		if resp.StatusCode >= 400 {
@@ -162,11 +683,14 @@ func (c *Client) doRequestWithRetry(method, path string, body interface{}, maxRe
 		}
 		
 		// Check if we should retry based on status code
-		if resp.StatusCode >= 500 && resp.StatusCode < 600 && attempt < maxRetries {
+		retryable := resp.StatusCode == http.StatusTooManyRequests || (resp.StatusCode >= 500 && resp.StatusCode < 600)
+		if retryable && attempt < maxRetries {
 			lastResp = resp
-			backoffDuration := time.Duration(attempt+1) * time.Second
+			backoffDuration := c.retryDelay(resp, attempt)
 			c.logRequest(method, url, requestBodyStr, resp.StatusCode, responseBodyStr, fmt.Sprintf("%s - retrying in %v (attempt %d/%d)", errorMsg, backoffDuration, attempt+1, maxRetries+1), time.Since(start))
-			time.Sleep(backoffDuration)
+			if err := sleepContext(ctx, backoffDuration); err != nil {
+				return nil, err
+			}
 			continue
 		}
 		
@@ -181,8 +705,16 @@ func (c *Client) doRequestWithRetry(method, path string, body interface{}, maxRe
 	return nil, lastErr
 }
 
-func (c *Client) CreateApplication(app *Application) (*Application, error) {
-	resp, err := c.doRequest("POST", "/applications", app)
+// CreateApplication creates an application. Every call sends its own
+// Idempotency-Key, reused when the request is retried, so a retry after a lost
+// response does not create a duplicate application.
+func (c *Client) CreateApplication(ctx context.Context, app *Application) (*Application, error) {
+	ctx, err := withIdempotencyKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(ctx, "POST", "/applications", app)
 	if err != nil {
 		return nil, err
 	}
@@ -200,8 +732,682 @@ func (c *Client) CreateApplication(app *Application) (*Application, error) {
 	return &result.Data, nil
 }
 
-func (c *Client) GetApplication(id int64) (*Application, error) {
-	resp, err := c.doRequest("GET", fmt.Sprintf("/applications/%d", id), nil)
+// RefreshApplication reads the application like GetApplication, but always
+// bypasses HTTP caches so the result is the API's authoritative state, even
+// when WithReadCacheDisabled is not set
+func (c *Client) RefreshApplication(ctx context.Context, id int64) (*Application, error) {
+	return c.GetApplication(context.WithValue(ctx, readCacheBypassKey{}, true), id)
+}
+
+func (c *Client) GetApplication(ctx context.Context, id int64) (*Application, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/applications/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.handleErrorResponse(resp, "get application")
+	}
+
+	var result SingleResponse[Application]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// ListApplications returns one page of the team's applications. The returned
+// Pagination is nil when the API does not include pagination metadata.
+func (c *Client) ListApplications(ctx context.Context, page, perPage int) ([]Application, *Pagination, error) {
+	if page < 1 || perPage < 1 {
+		return nil, nil, fmt.Errorf("page and per page must be at least 1, got page %d and per page %d", page, perPage)
+	}
+
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/applications?page=%d&per_page=%d", page, perPage), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, c.handleErrorResponse(resp, "list applications")
+	}
+
+	var result ListResponse[Application]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, nil, err
+	}
+
+	return result.Data, paginationFromMeta(result.Meta), nil
+}
+
+func (c *Client) UpdateApplication(ctx context.Context, id int64, updateData interface{}) (*Application, error) {
+	resp, err := c.doRequest(ctx, "PUT", fmt.Sprintf("/applications/%d", id), updateData)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.handleErrorResponse(resp, "update application")
+	}
+
+	var result SingleResponse[Application]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+func (c *Client) DeleteApplication(ctx context.Context, id int64) error {
+	resp, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/applications/%d", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return c.handleErrorResponse(resp, "delete application")
+	}
+
+	return nil
+}
+
+// DeployApplication triggers a deployment. The returned deployment is nil when
+// the API does not report which deployment was started.
+func (c *Client) DeployApplication(ctx context.Context, id int64) (*Deployment, error) {
+	resp, err := c.doRequest(ctx, "POST", fmt.Sprintf("/applications/%d/deploy", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return nil, newAPIError(resp, "deploy application")
+	}
+
+	// The deployment was accepted either way; a missing or unexpected body only
+	// means there is no deployment to follow
+	var result SingleResponse[Deployment]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil || result.Data.ID == 0 {
+		return nil, nil
+	}
+
+	return &result.Data, nil
+}
+
+// GetDeployment returns a single deployment of an application, or nil when
+// it does not exist
+func (c *Client) GetDeployment(ctx context.Context, applicationID, deploymentID int64) (*Deployment, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/applications/%d/deployments/%d", applicationID, deploymentID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.handleErrorResponse(resp, "get deployment")
+	}
+
+	var result SingleResponse[Deployment]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// GetBuildLogs returns the build status and all build log lines of a deployment so far
+func (c *Client) GetBuildLogs(ctx context.Context, applicationID, deploymentID int64) (*BuildLogs, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/applications/%d/deployments/%d/build-logs", applicationID, deploymentID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.handleErrorResponse(resp, "get build logs")
+	}
+
+	var result SingleResponse[BuildLogs]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// GetDeploymentLogs returns the raw log text of the latest deployment of an
+// application. A deployment that is still running (202) returns the logs
+// written so far, which may be empty; an application without deployments
+// (404 or 204) returns an empty string.
+func (c *Client) GetDeploymentLogs(ctx context.Context, applicationID int64) (string, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/applications/%d/deployments/latest/logs", applicationID), nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted:
+	case http.StatusNoContent, http.StatusNotFound:
+		return "", nil
+	default:
+		return "", c.handleErrorResponse(resp, "get deployment logs")
+	}
+
+	logs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return string(logs), nil
+}
+
+// ListNetworks returns the private networks available to the team
+func (c *Client) ListNetworks(ctx context.Context) ([]Network, error) {
+	resp, err := c.doRequest(ctx, "GET", "/networks", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.handleErrorResponse(resp, "list networks")
+	}
+
+	var result ListResponse[Network]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// ListRuntimeVersions returns the PHP and Node.js versions currently supported by the platform
+func (c *Client) ListRuntimeVersions(ctx context.Context) (*RuntimeVersions, error) {
+	resp, err := c.doRequest(ctx, "GET", "/runtime-versions", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.handleErrorResponse(resp, "list runtime versions")
+	}
+
+	var result SingleResponse[RuntimeVersions]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// ListApplicationTemplates returns the application templates defined by the platform
+func (c *Client) ListApplicationTemplates(ctx context.Context) ([]ApplicationTemplate, error) {
+	resp, err := c.doRequest(ctx, "GET", "/application-templates", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.handleErrorResponse(resp, "list application templates")
+	}
+
+	var result ListResponse[ApplicationTemplate]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// GetApplicationTemplate returns a single application template. Unlike
+// GetApplication a missing template is an error, use IsNotFound to detect it.
+func (c *Client) GetApplicationTemplate(ctx context.Context, id int64) (*ApplicationTemplate, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/application-templates/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.handleErrorResponse(resp, "get application template")
+	}
+
+	var result SingleResponse[ApplicationTemplate]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// ReplicaMetricsWindowSeconds bounds how far back replica usage samples are requested
+const ReplicaMetricsWindowSeconds = 300
+
+// GetApplicationReplicaMetrics returns the per-replica CPU and memory usage
+// samples of the application within the last ReplicaMetricsWindowSeconds.
+func (c *Client) GetApplicationReplicaMetrics(ctx context.Context, id int64) ([]ReplicaMetric, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/applications/%d/metrics/replicas?window=%d", id, ReplicaMetricsWindowSeconds), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.handleErrorResponse(resp, "get application replica metrics")
+	}
+
+	var result ListResponse[ReplicaMetric]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// Bounds of the window, in seconds, accepted by GetApplicationHTTPMetrics
+const (
+	HTTPMetricsMinWindowSeconds     = 60
+	HTTPMetricsMaxWindowSeconds     = 86400
+	HTTPMetricsDefaultWindowSeconds = 300
+)
+
+// GetApplicationHTTPMetrics returns the aggregated request rate, latency and
+// error rate of the application over the last window seconds.
+func (c *Client) GetApplicationHTTPMetrics(ctx context.Context, id int64, window int64) (*HTTPMetrics, error) {
+	if window < HTTPMetricsMinWindowSeconds || window > HTTPMetricsMaxWindowSeconds {
+		return nil, fmt.Errorf("metrics window must be between %d and %d seconds, got %d", HTTPMetricsMinWindowSeconds, HTTPMetricsMaxWindowSeconds, window)
+	}
+
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/applications/%d/metrics/http?window=%d", id, window), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.handleErrorResponse(resp, "get application http metrics")
+	}
+
+	var result SingleResponse[HTTPMetrics]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// WaitForApplicationStatus polls the application until its status equals
+// target, doubling the wait between polls up to maxStatusPollInterval. It
+// returns an error when the application reaches a failure status, is deleted
+// or does not reach target within timeout. The last application read is
+// returned alongside timeout and failure errors; timeouts are reported as a
+// *StatusTimeoutError.
+func (c *Client) WaitForApplicationStatus(ctx context.Context, id int64, target string, timeout time.Duration) (*Application, error) {
+	interval := c.statusPollInterval
+	if interval <= 0 {
+		interval = minStatusPollInterval
+	}
+	start := time.Now()
+	deadline := start.Add(timeout)
+
+	for {
+		app, err := c.GetApplication(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if app == nil {
+			return nil, fmt.Errorf("application %d not found while waiting for status '%s'", id, target)
+		}
+
+		if app.Status == target {
+			return app, nil
+		}
+		if applicationFailureStatuses[app.Status] {
+			return app, fmt.Errorf("application %d reached status '%s' while waiting for '%s'", id, app.Status, target)
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return app, &StatusTimeoutError{ApplicationID: id, Target: target, LastStatus: app.Status, Elapsed: time.Since(start)}
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return app, err
+		}
+		interval = min(interval*2, maxStatusPollInterval)
+	}
+}
+
+// WaitForDeployment polls a deployment until it finishes, using the same
+// intervals as WaitForApplicationStatus. It returns an error when the
+// deployment failed, was cancelled or disappeared, and a *StatusTimeoutError
+// when it is still running after timeout. The last deployment read is
+// returned alongside failure and timeout errors.
+func (c *Client) WaitForDeployment(ctx context.Context, applicationID, deploymentID int64, timeout time.Duration) (*Deployment, error) {
+	interval := c.statusPollInterval
+	if interval <= 0 {
+		interval = minStatusPollInterval
+	}
+	start := time.Now()
+	deadline := start.Add(timeout)
+
+	for {
+		deployment, err := c.GetDeployment(ctx, applicationID, deploymentID)
+		if err != nil {
+			return nil, err
+		}
+		if deployment == nil {
+			return nil, fmt.Errorf("deployment %d of application %d not found while waiting for it to finish", deploymentID, applicationID)
+		}
+
+		if deployment.Finished() {
+			if deployment.Status == "failed" || deployment.Status == "cancelled" {
+				return deployment, fmt.Errorf("deployment %d of application %d %s", deploymentID, applicationID, deployment.Status)
+			}
+			return deployment, nil
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return deployment, &StatusTimeoutError{ApplicationID: applicationID, Target: "finished", LastStatus: deployment.Status, Elapsed: time.Since(start)}
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return deployment, err
+		}
+		interval = min(interval*2, maxStatusPollInterval)
+	}
+}
+
+// WaitForApplicationDeleted polls the application until the API no longer
+// returns it, using the same intervals as WaitForApplicationStatus. It returns
+// a *StatusTimeoutError when the application still exists after timeout.
+func (c *Client) WaitForApplicationDeleted(ctx context.Context, id int64, timeout time.Duration) error {
+	interval := c.statusPollInterval
+	if interval <= 0 {
+		interval = minStatusPollInterval
+	}
+	start := time.Now()
+	deadline := start.Add(timeout)
+
+	for {
+		app, err := c.GetApplication(ctx, id)
+		if err != nil {
+			return err
+		}
+		if app == nil {
+			return nil
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return &StatusTimeoutError{ApplicationID: id, Target: "deleted", LastStatus: app.Status, Elapsed: time.Since(start)}
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return err
+		}
+		interval = min(interval*2, maxStatusPollInterval)
+	}
+}
+
+func (c *Client) CreateService(ctx context.Context, service *ApplicationService) (*ApplicationService, error) {
+	// Validate service before making API request
+	if err := c.ValidateServiceRequest(service); err != nil {
+		return nil, err
+	}
+	if err := ValidateServiceVersion(service.Type, service.Version); err != nil {
+		return nil, err
+	}
+	if service.Type == "postgresql" {
+		if err := ValidatePostgresExtensions(service.Extensions); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.doRequest(ctx, "POST", fmt.Sprintf("/applications/%d/services", service.ApplicationID), service)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, c.handleErrorResponse(resp, "create service")
+	}
+
+	var result SingleResponse[ApplicationService]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+func (c *Client) GetService(ctx context.Context, applicationID, serviceID int64) (*ApplicationService, error) {
+	// Since the API doesn't support GET for individual services, 
+	// we get the application and find the service in its services list
+	service, appFound, err := c.findApplicationService(ctx, applicationID, serviceID)
+	if err != nil || !appFound || service != nil {
+		return service, err
+	}
+	
+	// The embedded list may be paginated or truncated for large applications,
+	// so ask the service endpoint directly before reporting it missing
+	return c.getServiceDirect(ctx, applicationID, serviceID)
+}
+
+// GetServiceDirect fetches a single service from its own endpoint instead of
+// loading the whole application. APIs without the endpoint answer 404 or 405,
+// in which case the application's services list is scanned like GetService.
+func (c *Client) GetServiceDirect(ctx context.Context, applicationID, serviceID int64) (*ApplicationService, error) {
+	service, err := c.getServiceDirect(ctx, applicationID, serviceID)
+	if err != nil || service != nil {
+		return service, err
+	}
+
+	service, _, err = c.findApplicationService(ctx, applicationID, serviceID)
+	return service, err
+}
+
+// findApplicationService looks the service up in the services embedded in the
+// application. appFound is false when the application itself does not exist.
+func (c *Client) findApplicationService(ctx context.Context, applicationID, serviceID int64) (service *ApplicationService, appFound bool, err error) {
+	app, err := c.GetApplication(ctx, applicationID)
+	if err != nil || app == nil {
+		return nil, false, err
+	}
+
+	for _, service := range app.Services {
+		if service.ID == serviceID {
+			// Ensure ApplicationID is set (it might not be in the nested response)
+			service.ApplicationID = applicationID
+			return &service, true, nil
+		}
+	}
+
+	return nil, true, nil
+}
+
+// getServiceDirect fetches a single service from its own endpoint. Not found
+// and method-not-allowed responses (endpoint unsupported) both return nil.
+func (c *Client) getServiceDirect(ctx context.Context, applicationID, serviceID int64) (*ApplicationService, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/applications/%d/services/%d", applicationID, serviceID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.handleErrorResponse(resp, "get service")
+	}
+
+	var result SingleResponse[ApplicationService]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	result.Data.ApplicationID = applicationID
+	return &result.Data, nil
+}
+
+func (c *Client) UpdateService(ctx context.Context, applicationID, serviceID int64, service *ApplicationService) (*ApplicationService, error) {
+	// Validate service before making API request, the path already identifies
+	// the application so the body may leave it unset
+	validated := service
+	if service != nil && service.ApplicationID == 0 {
+		withApplication := *service
+		withApplication.ApplicationID = applicationID
+		validated = &withApplication
+	}
+	if err := c.ValidateServiceRequest(validated); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(ctx, "PUT", fmt.Sprintf("/applications/%d/services/%d", applicationID, serviceID), service)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.handleErrorResponse(resp, "update service")
+	}
+
+	var result SingleResponse[ApplicationService]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+func (c *Client) DeleteService(ctx context.Context, applicationID, serviceID int64) error {
+	resp, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/applications/%d/services/%d", applicationID, serviceID), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp, "delete service")
+	}
+
+	return nil
+}
+
+// RotateServiceCredentials generates new credentials for a service and returns them
+func (c *Client) RotateServiceCredentials(ctx context.Context, applicationID, serviceID int64) (*ServiceConnection, error) {
+	resp, err := c.doRequest(ctx, "POST", fmt.Sprintf("/applications/%d/services/%d/rotate-credentials", applicationID, serviceID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, c.handleErrorResponse(resp, "rotate service credentials")
+	}
+
+	var result SingleResponse[ServiceConnection]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+func (c *Client) CreateDomain(ctx context.Context, domain *ApplicationDomain) (*ApplicationDomain, error) {
+	resp, err := c.doRequest(ctx, "POST", fmt.Sprintf("/applications/%d/domains", domain.ApplicationID), domain)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "create domain")
+	}
+
+	var result SingleResponse[ApplicationDomain]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+func (c *Client) GetDomain(ctx context.Context, applicationID, domainID int64) (*ApplicationDomain, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/applications/%d/domains/%d", applicationID, domainID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "get domain")
+	}
+
+	var result SingleResponse[ApplicationDomain]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+func (c *Client) DeleteDomain(ctx context.Context, applicationID, domainID int64) error {
+	resp, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/applications/%d/domains/%d", applicationID, domainID), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp, "delete domain")
+	}
+
+	return nil
+}
+
+func (c *Client) CreateSecret(ctx context.Context, secret *ApplicationSecret) (*ApplicationSecret, error) {
+	resp, err := c.doRequest(ctx, "POST", fmt.Sprintf("/applications/%d/secrets", secret.ApplicationID), secret)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "create secret")
+	}
+
+	var result SingleResponse[ApplicationSecret]
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// ListSecrets returns the secrets of an application, or nil when the
+// application does not exist
+func (c *Client) ListSecrets(ctx context.Context, applicationID int64) ([]ApplicationSecret, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/applications/%d/secrets", applicationID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -212,29 +1418,46 @@ func (c *Client) GetApplication(id int64) (*Application, error) {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, c.handleErrorResponse(resp, "get application")
+		return nil, newAPIError(resp, "get secrets")
 	}
 
-	var result SingleResponse[Application]
+	var result ListResponse[ApplicationSecret]
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, err
 	}
 
-	return &result.Data, nil
+	return result.Data, nil
+}
+
+func (c *Client) GetSecret(ctx context.Context, applicationID int64, key string) (*ApplicationSecret, error) {
+	// Get all secrets and filter by key since individual secret GET is not supported
+	secrets, err := c.ListSecrets(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the secret with the matching key
+	for _, secret := range secrets {
+		if secret.Key == key {
+			return &secret, nil
+		}
+	}
+
+	return nil, nil // Secret not found
 }
 
-func (c *Client) UpdateApplication(id int64, updateData interface{}) (*Application, error) {
-	resp, err := c.doRequest("PUT", fmt.Sprintf("/applications/%d", id), updateData)
+func (c *Client) UpdateSecret(ctx context.Context, applicationID int64, key string, secret *ApplicationSecret) (*ApplicationSecret, error) {
+	resp, err := c.doRequest(ctx, "PUT", fmt.Sprintf("/applications/%d/secrets/%s", applicationID, key), secret)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, c.handleErrorResponse(resp, "update application")
+		return nil, newAPIError(resp, "update secret")
 	}
 
-	var result SingleResponse[Application]
+	var result SingleResponse[ApplicationSecret]
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, err
 	}
@@ -242,144 +1465,150 @@ func (c *Client) UpdateApplication(id int64, updateData interface{}) (*Applicati
 	return &result.Data, nil
 }
 
-func (c *Client) DeleteApplication(id int64) error {
-	resp, err := c.doRequest("DELETE", fmt.Sprintf("/applications/%d", id), nil)
+func (c *Client) DeleteSecret(ctx context.Context, applicationID int64, key string) error {
+	resp, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/applications/%d/secrets/%s", applicationID, key), nil)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		return c.handleErrorResponse(resp, "delete application")
+		return newAPIError(resp, "delete secret")
 	}
 
 	return nil
 }
 
-func (c *Client) DeployApplication(id int64) error {
-	resp, err := c.doRequest("POST", fmt.Sprintf("/applications/%d/deploy", id), nil)
-	if err != nil {
-		return err
+// SecretsError is returned by SetSecrets when some of the secrets could not be
+// written. Applied lists the keys that were written before or despite the
+// failures, Failed holds the error for every key that was not.
+type SecretsError struct {
+	Applied []string
+	Failed  map[string]error
+}
+
+func (e *SecretsError) Error() string {
+	parts := make([]string, 0, len(e.Failed))
+	for _, key := range e.FailedKeys() {
+		parts = append(parts, fmt.Sprintf("unable to write secret %s: %v", key, e.Failed[key]))
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return fmt.Errorf("failed to deploy application: %s", resp.Status)
-		}
-		return fmt.Errorf("failed to deploy application: %s", errResp.Message)
+	msg := strings.Join(parts, "; ")
+	if len(e.Applied) > 0 {
+		msg += fmt.Sprintf(" (secrets written: %s)", strings.Join(e.Applied, ", "))
 	}
+	return msg
+}
 
-	return nil
+// FailedKeys returns the keys that could not be written, sorted
+func (e *SecretsError) FailedKeys() []string {
+	keys := make([]string, 0, len(e.Failed))
+	for key := range e.Failed {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
 }
 
-func (c *Client) CreateService(service *ApplicationService) (*ApplicationService, error) {
-	// Validate service before making API request
-	if err := c.ValidateServiceRequest(service); err != nil {
-		return nil, err
+// SetSecrets creates or updates several secrets of an application. The batch
+// endpoint writes them in a single request. When the API does not offer it,
+// every key is written on its own, the remaining keys are still attempted
+// after a failure and a *SecretsError names the keys that succeeded and
+// failed. Writing a key again with the same value is harmless, so a failed
+// call can simply be repeated.
+func (c *Client) SetSecrets(ctx context.Context, applicationID int64, secrets map[string]string) error {
+	if len(secrets) == 0 {
+		return nil
 	}
 
-	resp, err := c.doRequest("POST", fmt.Sprintf("/applications/%d/services", service.ApplicationID), service)
-	if err != nil {
-		return nil, err
+	keys := make([]string, 0, len(secrets))
+	for key := range secrets {
+		keys = append(keys, key)
 	}
-	defer resp.Body.Close()
+	slices.Sort(keys)
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return nil, c.handleErrorResponse(resp, "create service")
+	batch := make([]ApplicationSecret, 0, len(keys))
+	for _, key := range keys {
+		batch = append(batch, ApplicationSecret{ApplicationID: applicationID, Key: key, Value: secrets[key]})
 	}
 
-	var result SingleResponse[ApplicationService]
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
+	resp, err := c.doRequest(ctx, "PUT", fmt.Sprintf("/applications/%d/secrets", applicationID), map[string]interface{}{"secrets": batch})
+	if err != nil {
+		return err
 	}
+	defer resp.Body.Close()
 
-	return &result.Data, nil
-}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
+		return nil
+	case http.StatusNotFound, http.StatusMethodNotAllowed:
+		// Batch endpoint unsupported, write the keys one by one
+	default:
+		return newAPIError(resp, "set secrets")
+	}
 
-func (c *Client) GetService(applicationID, serviceID int64) (*ApplicationService, error) {
-	// Since the API doesn't support GET for individual services, 
-	// we get the application and find the service in its services list
-	app, err := c.GetApplication(applicationID)
+	existing, err := c.ListSecrets(ctx, applicationID)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	
-	if app == nil {
-		return nil, nil
+	exists := make(map[string]bool, len(existing))
+	for _, secret := range existing {
+		exists[secret.Key] = true
 	}
-	
-	// Find the service with matching ID
-	for _, service := range app.Services {
-		if service.ID == serviceID {
-			// Ensure ApplicationID is set (it might not be in the nested response)
-			service.ApplicationID = applicationID
-			return &service, nil
+
+	result := &SecretsError{Failed: map[string]error{}}
+	for i := range batch {
+		secret := &batch[i]
+		if exists[secret.Key] {
+			_, err = c.UpdateSecret(ctx, applicationID, secret.Key, secret)
+		} else {
+			_, err = c.CreateSecret(ctx, secret)
+		}
+		if err != nil {
+			result.Failed[secret.Key] = err
+			continue
 		}
+		result.Applied = append(result.Applied, secret.Key)
 	}
-	
-	// Service not found
-	return nil, nil
+
+	if len(result.Failed) > 0 {
+		return result
+	}
+	return nil
 }
 
-func (c *Client) UpdateService(applicationID, serviceID int64, service *ApplicationService) (*ApplicationService, error) {
-	resp, err := c.doRequest("PUT", fmt.Sprintf("/applications/%d/services/%d", applicationID, serviceID), service)
+// ListServiceAlerts returns the alerting thresholds configured for a service
+func (c *Client) ListServiceAlerts(ctx context.Context, applicationID, serviceID int64) ([]ServiceAlert, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/applications/%d/services/%d/alerts", applicationID, serviceID), nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return nil, fmt.Errorf("failed to update service: %s", resp.Status)
-		}
-		return nil, fmt.Errorf("failed to update service: %s", errResp.Message)
+		return nil, c.handleErrorResponse(resp, "list service alerts")
 	}
 
-	var result SingleResponse[ApplicationService]
+	var result ListResponse[ServiceAlert]
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, err
 	}
 
-	return &result.Data, nil
-}
-
-func (c *Client) DeleteService(applicationID, serviceID int64) error {
-	resp, err := c.doRequest("DELETE", fmt.Sprintf("/applications/%d/services/%d", applicationID, serviceID), nil)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return fmt.Errorf("failed to delete service: %s", resp.Status)
-		}
-		return fmt.Errorf("failed to delete service: %s", errResp.Message)
-	}
-
-	return nil
+	return result.Data, nil
 }
 
-func (c *Client) CreateDomain(domain *ApplicationDomain) (*ApplicationDomain, error) {
-	resp, err := c.doRequest("POST", fmt.Sprintf("/applications/%d/domains", domain.ApplicationID), domain)
+func (c *Client) CreateServiceAlert(ctx context.Context, applicationID, serviceID int64, alert *ServiceAlert) (*ServiceAlert, error) {
+	resp, err := c.doRequest(ctx, "POST", fmt.Sprintf("/applications/%d/services/%d/alerts", applicationID, serviceID), alert)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return nil, fmt.Errorf("failed to create domain: %s", resp.Status)
-		}
-		return nil, fmt.Errorf("failed to create domain: %s", errResp.Message)
+		return nil, c.handleErrorResponse(resp, "create service alert")
 	}
 
-	var result SingleResponse[ApplicationDomain]
+	var result SingleResponse[ServiceAlert]
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, err
 	}
@@ -387,26 +1616,18 @@ func (c *Client) CreateDomain(domain *ApplicationDomain) (*ApplicationDomain, er
 	return &result.Data, nil
 }
 
-func (c *Client) GetDomain(applicationID, domainID int64) (*ApplicationDomain, error) {
-	resp, err := c.doRequest("GET", fmt.Sprintf("/applications/%d/domains/%d", applicationID, domainID), nil)
+func (c *Client) UpdateServiceAlert(ctx context.Context, applicationID, serviceID, alertID int64, alert *ServiceAlert) (*ServiceAlert, error) {
+	resp, err := c.doRequest(ctx, "PUT", fmt.Sprintf("/applications/%d/services/%d/alerts/%d", applicationID, serviceID, alertID), alert)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, nil
-	}
-
 	if resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return nil, fmt.Errorf("failed to get domain: %s", resp.Status)
-		}
-		return nil, fmt.Errorf("failed to get domain: %s", errResp.Message)
+		return nil, c.handleErrorResponse(resp, "update service alert")
 	}
 
-	var result SingleResponse[ApplicationDomain]
+	var result SingleResponse[ServiceAlert]
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, err
 	}
@@ -414,40 +1635,32 @@ func (c *Client) GetDomain(applicationID, domainID int64) (*ApplicationDomain, e
 	return &result.Data, nil
 }
 
-func (c *Client) DeleteDomain(applicationID, domainID int64) error {
-	resp, err := c.doRequest("DELETE", fmt.Sprintf("/applications/%d/domains/%d", applicationID, domainID), nil)
+func (c *Client) DeleteServiceAlert(ctx context.Context, applicationID, serviceID, alertID int64) error {
+	resp, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/applications/%d/services/%d/alerts/%d", applicationID, serviceID, alertID), nil)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return fmt.Errorf("failed to delete domain: %s", resp.Status)
-		}
-		return fmt.Errorf("failed to delete domain: %s", errResp.Message)
+		return newAPIError(resp, "delete service alert")
 	}
 
 	return nil
 }
 
-func (c *Client) CreateSecret(secret *ApplicationSecret) (*ApplicationSecret, error) {
-	resp, err := c.doRequest("POST", fmt.Sprintf("/applications/%d/secrets", secret.ApplicationID), secret)
+func (c *Client) CreateDeployNotification(ctx context.Context, notification *DeployNotification) (*DeployNotification, error) {
+	resp, err := c.doRequest(ctx, "POST", fmt.Sprintf("/applications/%d/notifications", notification.ApplicationID), notification)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return nil, fmt.Errorf("failed to create secret: %s", resp.Status)
-		}
-		return nil, fmt.Errorf("failed to create secret: %s", errResp.Message)
+		return nil, c.handleErrorResponse(resp, "create deploy notification")
 	}
 
-	var result SingleResponse[ApplicationSecret]
+	var result SingleResponse[DeployNotification]
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, err
 	}
@@ -455,9 +1668,8 @@ func (c *Client) CreateSecret(secret *ApplicationSecret) (*ApplicationSecret, er
 	return &result.Data, nil
 }
 
-func (c *Client) GetSecret(applicationID int64, key string) (*ApplicationSecret, error) {
-	// Get all secrets and filter by key since individual secret GET is not supported
-	resp, err := c.doRequest("GET", fmt.Sprintf("/applications/%d/secrets", applicationID), nil)
+func (c *Client) GetDeployNotification(ctx context.Context, applicationID, notificationID int64) (*DeployNotification, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/applications/%d/notifications/%d", applicationID, notificationID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -468,44 +1680,29 @@ func (c *Client) GetSecret(applicationID int64, key string) (*ApplicationSecret,
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return nil, fmt.Errorf("failed to get secrets: %s", resp.Status)
-		}
-		return nil, fmt.Errorf("failed to get secrets: %s", errResp.Message)
+		return nil, c.handleErrorResponse(resp, "get deploy notification")
 	}
 
-	var result ListResponse[ApplicationSecret]
+	var result SingleResponse[DeployNotification]
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, err
 	}
 
-	// Find the secret with the matching key
-	for _, secret := range result.Data {
-		if secret.Key == key {
-			return &secret, nil
-		}
-	}
-
-	return nil, nil // Secret not found
+	return &result.Data, nil
 }
 
-func (c *Client) UpdateSecret(applicationID int64, key string, secret *ApplicationSecret) (*ApplicationSecret, error) {
-	resp, err := c.doRequest("PUT", fmt.Sprintf("/applications/%d/secrets/%s", applicationID, key), secret)
+func (c *Client) UpdateDeployNotification(ctx context.Context, applicationID, notificationID int64, notification *DeployNotification) (*DeployNotification, error) {
+	resp, err := c.doRequest(ctx, "PUT", fmt.Sprintf("/applications/%d/notifications/%d", applicationID, notificationID), notification)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return nil, fmt.Errorf("failed to update secret: %s", resp.Status)
-		}
-		return nil, fmt.Errorf("failed to update secret: %s", errResp.Message)
+		return nil, c.handleErrorResponse(resp, "update deploy notification")
 	}
 
-	var result SingleResponse[ApplicationSecret]
+	var result SingleResponse[DeployNotification]
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, err
 	}
@@ -513,37 +1710,34 @@ func (c *Client) UpdateSecret(applicationID int64, key string, secret *Applicati
 	return &result.Data, nil
 }
 
-func (c *Client) DeleteSecret(applicationID int64, key string) error {
-	resp, err := c.doRequest("DELETE", fmt.Sprintf("/applications/%d/secrets/%s", applicationID, key), nil)
+func (c *Client) DeleteDeployNotification(ctx context.Context, applicationID, notificationID int64) error {
+	resp, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/applications/%d/notifications/%d", applicationID, notificationID), nil)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return fmt.Errorf("failed to delete secret: %s", resp.Status)
-		}
-		return fmt.Errorf("failed to delete secret: %s", errResp.Message)
+		return c.handleErrorResponse(resp, "delete deploy notification")
 	}
 
 	return nil
 }
 
-func (c *Client) CreateVolume(volume *ApplicationVolume) (*ApplicationVolume, error) {
-	resp, err := c.doRequest("POST", fmt.Sprintf("/applications/%d/volumes", volume.ApplicationID), volume)
+func (c *Client) CreateVolume(ctx context.Context, volume *ApplicationVolume) (*ApplicationVolume, error) {
+	// Validate volume before making API request
+	if err := c.ValidateVolumeRequest(volume); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(ctx, "POST", fmt.Sprintf("/applications/%d/volumes", volume.ApplicationID), volume)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return nil, fmt.Errorf("failed to create volume: %s", resp.Status)
-		}
-		return nil, fmt.Errorf("failed to create volume: %s", errResp.Message)
+		return nil, newAPIError(resp, "create volume")
 	}
 
 	var result SingleResponse[ApplicationVolume]
@@ -554,8 +1748,8 @@ func (c *Client) CreateVolume(volume *ApplicationVolume) (*ApplicationVolume, er
 	return &result.Data, nil
 }
 
-func (c *Client) GetVolume(applicationID, volumeID int64) (*ApplicationVolume, error) {
-	resp, err := c.doRequest("GET", fmt.Sprintf("/applications/%d/volumes/%d", applicationID, volumeID), nil)
+func (c *Client) GetVolume(ctx context.Context, applicationID, volumeID int64) (*ApplicationVolume, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/applications/%d/volumes/%d", applicationID, volumeID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -566,11 +1760,7 @@ func (c *Client) GetVolume(applicationID, volumeID int64) (*ApplicationVolume, e
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return nil, fmt.Errorf("failed to get volume: %s", resp.Status)
-		}
-		return nil, fmt.Errorf("failed to get volume: %s", errResp.Message)
+		return nil, newAPIError(resp, "get volume")
 	}
 
 	var result SingleResponse[ApplicationVolume]
@@ -581,19 +1771,27 @@ func (c *Client) GetVolume(applicationID, volumeID int64) (*ApplicationVolume, e
 	return &result.Data, nil
 }
 
-func (c *Client) UpdateVolume(applicationID, volumeID int64, volume *ApplicationVolume) (*ApplicationVolume, error) {
-	resp, err := c.doRequest("PUT", fmt.Sprintf("/applications/%d/volumes/%d", applicationID, volumeID), volume)
+func (c *Client) UpdateVolume(ctx context.Context, applicationID, volumeID int64, volume *ApplicationVolume) (*ApplicationVolume, error) {
+	// Validate volume before making API request, the path already identifies
+	// the application so the body may leave it unset
+	validated := volume
+	if volume != nil && volume.ApplicationID == 0 {
+		withApplication := *volume
+		withApplication.ApplicationID = applicationID
+		validated = &withApplication
+	}
+	if err := c.ValidateVolumeRequest(validated); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(ctx, "PUT", fmt.Sprintf("/applications/%d/volumes/%d", applicationID, volumeID), volume)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return nil, fmt.Errorf("failed to update volume: %s", resp.Status)
-		}
-		return nil, fmt.Errorf("failed to update volume: %s", errResp.Message)
+		return nil, newAPIError(resp, "update volume")
 	}
 
 	var result SingleResponse[ApplicationVolume]
@@ -604,37 +1802,73 @@ func (c *Client) UpdateVolume(applicationID, volumeID int64, volume *Application
 	return &result.Data, nil
 }
 
-func (c *Client) DeleteVolume(applicationID, volumeID int64) error {
-	resp, err := c.doRequest("DELETE", fmt.Sprintf("/applications/%d/volumes/%d", applicationID, volumeID), nil)
+// WaitForVolumeResize polls a volume until its resize completes, using the
+// same intervals as WaitForApplicationStatus. It returns an error when the
+// resize failed or the volume disappeared, and a *StatusTimeoutError when it
+// is still resizing after timeout. The last volume read is returned alongside
+// failure and timeout errors.
+func (c *Client) WaitForVolumeResize(ctx context.Context, applicationID, volumeID int64, timeout time.Duration) (*ApplicationVolume, error) {
+	interval := c.statusPollInterval
+	if interval <= 0 {
+		interval = minStatusPollInterval
+	}
+	start := time.Now()
+	deadline := start.Add(timeout)
+
+	for {
+		volume, err := c.GetVolume(ctx, applicationID, volumeID)
+		if err != nil {
+			return nil, err
+		}
+		if volume == nil {
+			return nil, fmt.Errorf("volume %d of application %d not found while waiting for its resize", volumeID, applicationID)
+		}
+
+		switch volume.ResizeStatus {
+		case "completed":
+			return volume, nil
+		case "failed":
+			return volume, fmt.Errorf("resize of volume %d of application %d failed", volumeID, applicationID)
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return volume, &StatusTimeoutError{ApplicationID: applicationID, Target: "completed", LastStatus: volume.ResizeStatus, Elapsed: time.Since(start)}
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return volume, err
+		}
+		interval = min(interval*2, maxStatusPollInterval)
+	}
+}
+
+func (c *Client) DeleteVolume(ctx context.Context, applicationID, volumeID int64) error {
+	resp, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/applications/%d/volumes/%d", applicationID, volumeID), nil)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return fmt.Errorf("failed to delete volume: %s", resp.Status)
-		}
-		return fmt.Errorf("failed to delete volume: %s", errResp.Message)
+		return newAPIError(resp, "delete volume")
 	}
 
 	return nil
 }
 
-func (c *Client) CreateWorker(worker *Worker) (*Worker, error) {
-	resp, err := c.doRequest("POST", fmt.Sprintf("/applications/%d/workers", worker.ApplicationID), worker)
+func (c *Client) CreateWorker(ctx context.Context, worker *Worker) (*Worker, error) {
+	// Validate worker before making API request
+	if err := c.ValidateWorkerRequest(worker); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(ctx, "POST", fmt.Sprintf("/applications/%d/workers", worker.ApplicationID), worker)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return nil, fmt.Errorf("failed to create worker: %s", resp.Status)
-		}
-		return nil, fmt.Errorf("failed to create worker: %s", errResp.Message)
+		return nil, newAPIError(resp, "create worker")
 	}
 
 	var result SingleResponse[Worker]
@@ -645,8 +1879,8 @@ func (c *Client) CreateWorker(worker *Worker) (*Worker, error) {
 	return &result.Data, nil
 }
 
-func (c *Client) GetWorker(applicationID, workerID int64) (*Worker, error) {
-	resp, err := c.doRequest("GET", fmt.Sprintf("/applications/%d/workers/%d", applicationID, workerID), nil)
+func (c *Client) GetWorker(ctx context.Context, applicationID, workerID int64) (*Worker, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/applications/%d/workers/%d", applicationID, workerID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -657,11 +1891,7 @@ func (c *Client) GetWorker(applicationID, workerID int64) (*Worker, error) {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return nil, fmt.Errorf("failed to get worker: %s", resp.Status)
-		}
-		return nil, fmt.Errorf("failed to get worker: %s", errResp.Message)
+		return nil, newAPIError(resp, "get worker")
 	}
 
 	var result SingleResponse[Worker]
@@ -672,19 +1902,27 @@ func (c *Client) GetWorker(applicationID, workerID int64) (*Worker, error) {
 	return &result.Data, nil
 }
 
-func (c *Client) UpdateWorker(applicationID, workerID int64, worker *Worker) (*Worker, error) {
-	resp, err := c.doRequest("PUT", fmt.Sprintf("/applications/%d/workers/%d", applicationID, workerID), worker)
+func (c *Client) UpdateWorker(ctx context.Context, applicationID, workerID int64, worker *Worker) (*Worker, error) {
+	// Validate worker before making API request, the path already identifies
+	// the application so the body may leave it unset
+	validated := worker
+	if worker != nil && worker.ApplicationID == 0 {
+		withApplication := *worker
+		withApplication.ApplicationID = applicationID
+		validated = &withApplication
+	}
+	if err := c.ValidateWorkerRequest(validated); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(ctx, "PUT", fmt.Sprintf("/applications/%d/workers/%d", applicationID, workerID), worker)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return nil, fmt.Errorf("failed to update worker: %s", resp.Status)
-		}
-		return nil, fmt.Errorf("failed to update worker: %s", errResp.Message)
+		return nil, newAPIError(resp, "update worker")
 	}
 
 	var result SingleResponse[Worker]
@@ -695,19 +1933,15 @@ func (c *Client) UpdateWorker(applicationID, workerID int64, worker *Worker) (*W
 	return &result.Data, nil
 }
 
-func (c *Client) DeleteWorker(applicationID, workerID int64) error {
-	resp, err := c.doRequest("DELETE", fmt.Sprintf("/applications/%d/workers/%d", applicationID, workerID), nil)
+func (c *Client) DeleteWorker(ctx context.Context, applicationID, workerID int64) error {
+	resp, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/applications/%d/workers/%d", applicationID, workerID), nil)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-			return fmt.Errorf("failed to delete worker: %s", resp.Status)
-		}
-		return fmt.Errorf("failed to delete worker: %s", errResp.Message)
+		return newAPIError(resp, "delete worker")
 	}
 
 	return nil
@@ -775,18 +2009,81 @@ func (c *Client) sanitizeURL(url string) string {
 	return url
 }
 
-// sanitizeBody sanitizes request/response body for logging
+// redactedValue replaces sensitive values in logged bodies
+const redactedValue = "***"
+
+// sensitiveBodyKeys are JSON keys whose values are never logged, in addition
+// to every key ending in "_key"
+var sensitiveBodyKeys = map[string]bool{
+	"value":            true,
+	"token":            true,
+	"password":         true,
+	"secret":           true,
+	"notification_url": true,
+}
+
+// sanitizeBody sanitizes request/response body for logging. Values of
+// sensitive keys in JSON bodies are replaced with "***"; other bodies, and
+// JSON bodies without sensitive values, are returned unchanged.
 func (c *Client) sanitizeBody(body string) string {
-	// For now, just return the body as-is since we're not storing actual secrets in service configs
-	// In the future, we could add more sophisticated sanitization
-	return body
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(body), &parsed); err != nil {
+		return body
+	}
+
+	if !redactSensitiveValues(parsed) {
+		return body
+	}
+
+	redacted, err := json.Marshal(parsed)
+	if err != nil {
+		return body
+	}
+	return string(redacted)
+}
+
+// redactSensitiveValues masks the values of sensitive keys in a decoded JSON
+// value in place, descending into nested objects and arrays. It reports
+// whether anything was masked.
+func redactSensitiveValues(value interface{}) bool {
+	redacted := false
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if isSensitiveBodyKey(key) && nested != nil {
+				v[key] = redactedValue
+				redacted = true
+				continue
+			}
+			if redactSensitiveValues(nested) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, nested := range v {
+			if redactSensitiveValues(nested) {
+				redacted = true
+			}
+		}
+	}
+
+	return redacted
+}
+
+func isSensitiveBodyKey(key string) bool {
+	key = strings.ToLower(key)
+	return sensitiveBodyKeys[key] || strings.HasSuffix(key, "_key")
 }
 
 // handleErrorResponse processes error responses and returns detailed error information
 func (c *Client) handleErrorResponse(resp *http.Response, operation string) error {
 	var errResp ErrorResponse
 	if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-		return fmt.Errorf("failed to %s: %s", operation, resp.Status)
+		return &APIError{
+			DetailedError: &DetailedError{StatusCode: resp.StatusCode, Message: resp.Status},
+			Operation:     operation,
+		}
 	}
 
 	detailedErr := &DetailedError{
@@ -795,30 +2092,7 @@ func (c *Client) handleErrorResponse(resp *http.Response, operation string) erro
 		DocsLink:   "https://docs.ploi.io/cloud",
 	}
 
-	// Convert error map to detailed format
-	if len(errResp.Errors) > 0 {
-		detailedErr.Errors = make(map[string][]string)
-		for field, value := range errResp.Errors {
-			switch v := value.(type) {
-			case string:
-				detailedErr.Errors[field] = []string{v}
-			case []interface{}:
-				messages := make([]string, len(v))
-				for i, msg := range v {
-					if str, ok := msg.(string); ok {
-						messages[i] = str
-					} else {
-						messages[i] = fmt.Sprintf("%v", msg)
-					}
-				}
-				detailedErr.Errors[field] = messages
-			case []string:
-				detailedErr.Errors[field] = v
-			default:
-				detailedErr.Errors[field] = []string{fmt.Sprintf("%v", v)}
-			}
-		}
-	}
+	detailedErr.Errors = fieldErrors(errResp.Errors)
 
 	// Add specific suggestions based on status code
 	switch resp.StatusCode {
@@ -834,8 +2108,59 @@ func (c *Client) handleErrorResponse(resp *http.Response, operation string) erro
 		detailedErr.Suggestion = "This appears to be a server error. Please try again in a few moments"
 	}
 
-	return fmt.Errorf("failed to %s: %s\nSuggestion: %s\nDocumentation: %s",
-		operation, detailedErr.Message, detailedErr.Suggestion, detailedErr.DocsLink)
+	return &APIError{DetailedError: detailedErr, Operation: operation}
+}
+
+// newAPIError decodes an error response into an *APIError without a
+// suggestion, so its message reads "failed to <operation>: <message>".
+func newAPIError(resp *http.Response, operation string) error {
+	var errResp ErrorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+		return &APIError{
+			DetailedError: &DetailedError{StatusCode: resp.StatusCode, Message: resp.Status},
+			Operation:     operation,
+		}
+	}
+
+	return &APIError{
+		DetailedError: &DetailedError{
+			StatusCode: resp.StatusCode,
+			Message:    errResp.Message,
+			Errors:     fieldErrors(errResp.Errors),
+		},
+		Operation: operation,
+	}
+}
+
+// fieldErrors converts the per-field errors of an error response, which the
+// API returns as a string or a list of strings, into lists of messages.
+func fieldErrors(errs map[string]interface{}) map[string][]string {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	result := make(map[string][]string, len(errs))
+	for field, value := range errs {
+		switch v := value.(type) {
+		case string:
+			result[field] = []string{v}
+		case []interface{}:
+			messages := make([]string, len(v))
+			for i, msg := range v {
+				if str, ok := msg.(string); ok {
+					messages[i] = str
+				} else {
+					messages[i] = fmt.Sprintf("%v", msg)
+				}
+			}
+			result[field] = messages
+		case []string:
+			result[field] = v
+		default:
+			result[field] = []string{fmt.Sprintf("%v", v)}
+		}
+	}
+	return result
 }
 
 // generateValidationSuggestion provides helpful suggestions for validation errors
@@ -928,9 +2253,196 @@ func (c *Client) ValidateServiceRequest(service *ApplicationService) error {
 		return fmt.Errorf("invalid storage_size format '%s'. Use format like '1Gi' or '10Gi'", service.StorageSize)
 	}
 
+	// Zero leaves the replica count to the API
+	if service.Replicas != 0 {
+		if err := c.validateReplicas(service.Replicas); err != nil {
+			return err
+		}
+	}
+
+	if minimum, ok := minServiceStorageGi[service.Type]; ok && service.StorageSize != "" {
+		if size, _ := storageSizeInGi(service.StorageSize); size < minimum {
+			return fmt.Errorf("storage_size for %s must be at least %gGi, got %s", service.Type, minimum, service.StorageSize)
+		}
+	}
+
+	return nil
+}
+
+// serviceVersions are the versions each service type can run. Types without
+// an entry, such as minio, accept any version.
+var serviceVersions = map[string][]string{
+	"postgresql": {"13", "14", "15", "16"},
+	"mysql":      {"5.7", "8.0", "8.4"},
+	"mongodb":    {"6", "6.0", "7", "7.0"},
+	"redis":      {"6", "6.2", "7", "7.0", "7.2", "7.4"},
+	"valkey":     {"7.2", "8", "8.0"},
+	"rabbitmq":   {"3.12", "3.13"},
+}
+
+// ValidateServiceVersion rejects versions the service type does not support.
+// An empty version leaves the choice to the API.
+func ValidateServiceVersion(serviceType, version string) error {
+	versions, ok := serviceVersions[serviceType]
+	if !ok || version == "" || slices.Contains(versions, version) {
+		return nil
+	}
+	return fmt.Errorf("version '%s' is not supported for %s services. Check that the version is supported for the selected service type, valid %s versions: %s",
+		version, serviceType, serviceType, strings.Join(versions, ", "))
+}
+
+// postgresExtensions are the PostgreSQL extensions the platform can install
+var postgresExtensions = []string{
+	"btree_gin", "btree_gist", "citext", "cube", "earthdistance", "fuzzystrmatch",
+	"hstore", "intarray", "isn", "lo", "ltree", "pg_stat_statements", "pg_trgm",
+	"pgcrypto", "postgis", "postgis_topology", "tablefunc", "unaccent", "uuid-ossp",
+	"vector",
+}
+
+// ValidatePostgresExtensions rejects extensions the platform cannot install,
+// naming the closest known extension since typos such as "uuid_ossp" are the
+// usual cause
+func ValidatePostgresExtensions(extensions []string) error {
+	for _, extension := range extensions {
+		if slices.Contains(postgresExtensions, extension) {
+			continue
+		}
+		return fmt.Errorf("unknown PostgreSQL extension '%s', did you mean '%s'? Must be one of: %s",
+			extension, closestMatch(extension, postgresExtensions), strings.Join(postgresExtensions, ", "))
+	}
+	return nil
+}
+
+// closestMatch returns the candidate with the smallest edit distance to value,
+// preferring the earlier candidate on ties
+func closestMatch(value string, candidates []string) string {
+	best, bestDistance := "", -1
+	for _, candidate := range candidates {
+		if distance := editDistance(strings.ToLower(value), candidate); bestDistance < 0 || distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best
+}
+
+// editDistance is the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// workerTypes are the worker types accepted by the API, an empty type uses "queue"
+var workerTypes = []string{"queue", "scheduler", "custom"}
+
+// ValidateWorkerRequest validates worker configuration before API request
+func (c *Client) ValidateWorkerRequest(worker *Worker) error {
+	if worker == nil {
+		return fmt.Errorf("worker cannot be nil")
+	}
+
+	if worker.ApplicationID <= 0 {
+		return fmt.Errorf("application_id must be greater than 0")
+	}
+
+	if worker.Command == "" {
+		return fmt.Errorf("command is required for workers")
+	}
+
+	if worker.Type != "" && !slices.Contains(workerTypes, worker.Type) {
+		return fmt.Errorf("invalid worker type '%s'. Must be one of: %s", worker.Type, strings.Join(workerTypes, ", "))
+	}
+
+	if worker.MemoryRequest != "" && !isValidResourceSpec(worker.MemoryRequest, []string{"Mi", "Gi"}) {
+		return fmt.Errorf("invalid memory_request format '%s'. Use format like '256Mi' or '1Gi'", worker.MemoryRequest)
+	}
+
+	if worker.CPURequest != "" && !isValidCPUSpec(worker.CPURequest) {
+		return fmt.Errorf("invalid cpu_request format '%s'. Use format like '250m', '1', or '2'", worker.CPURequest)
+	}
+
+	// Workers always send their replica count, so zero is rejected too
+	return c.validateReplicas(worker.Replicas)
+}
+
+// ValidateVolumeRequest validates volume configuration before API request
+func (c *Client) ValidateVolumeRequest(volume *ApplicationVolume) error {
+	if volume == nil {
+		return fmt.Errorf("volume cannot be nil")
+	}
+
+	if volume.ApplicationID <= 0 {
+		return fmt.Errorf("application_id must be greater than 0")
+	}
+
+	if volume.MountPath == "" {
+		return fmt.Errorf("mount_path is required for volumes")
+	}
+
+	if !strings.HasPrefix(volume.MountPath, "/") {
+		return fmt.Errorf("mount_path must be an absolute path starting with '/', got '%s'", volume.MountPath)
+	}
+
+	if volume.Size <= 0 {
+		return fmt.Errorf("size must be greater than 0, got %d", volume.Size)
+	}
+
+	return nil
+}
+
+// validateReplicas checks that replicas is between 1 and the configured maximum
+func (c *Client) validateReplicas(replicas int64) error {
+	max := c.maxReplicas
+	if max <= 0 {
+		max = DefaultMaxReplicas
+	}
+
+	if replicas < 1 || replicas > max {
+		return fmt.Errorf("replicas must be between 1 and %d, got %d", max, replicas)
+	}
 	return nil
 }
 
+// minServiceStorageGi is the smallest volume the API accepts per service type,
+// types without persistent storage have no minimum
+var minServiceStorageGi = map[string]float64{
+	"mysql":      1,
+	"postgresql": 1,
+	"mongodb":    1,
+	"rabbitmq":   1,
+	"sftp":       1,
+	"minio":      5,
+}
+
+// storageSizeInGi converts a storage specification such as "500Mi" or "2Ti" to Gi
+func storageSizeInGi(spec string) (float64, bool) {
+	units := map[string]float64{"Mi": 1.0 / 1024, "Gi": 1, "Ti": 1024}
+	for unit, factor := range units {
+		if numberPart, found := strings.CutSuffix(spec, unit); found {
+			value, err := strconv.ParseFloat(numberPart, 64)
+			if err != nil {
+				return 0, false
+			}
+			return value * factor, true
+		}
+	}
+	return 0, false
+}
+
 // isValidResourceSpec validates Kubernetes resource specification format
 func isValidResourceSpec(spec string, validUnits []string) bool {
 	if spec == "" {
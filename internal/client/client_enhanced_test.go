@@ -1,12 +1,15 @@
 package client
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"runtime"
 	"strings"
 	"testing"
 	"time"
@@ -186,6 +189,413 @@ func TestValidateServiceRequest(t *testing.T) {
 	}
 }
 
+func TestCreateService_StorageMinimum(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"data": {"id": 1, "application_id": 1, "type": "mysql"}}`))
+	}))
+	defer server.Close()
+
+	testClient := NewClient("test-token", &server.URL)
+
+	tests := []struct {
+		name        string
+		serviceType string
+		storageSize string
+		errorMsg    string
+	}{
+		{"mysql at minimum", "mysql", "1Gi", ""},
+		{"mysql below minimum", "mysql", "500Mi", "storage_size for mysql must be at least 1Gi, got 500Mi"},
+		{"mysql above minimum", "mysql", "10Gi", ""},
+		{"postgresql at minimum in Mi", "postgresql", "1024Mi", ""},
+		{"postgresql below minimum", "postgresql", "0.5Gi", "storage_size for postgresql must be at least 1Gi, got 0.5Gi"},
+		{"mongodb above minimum in Ti", "mongodb", "1Ti", ""},
+		{"minio at minimum", "minio", "5Gi", ""},
+		{"minio below minimum", "minio", "4Gi", "storage_size for minio must be at least 5Gi, got 4Gi"},
+		{"minio above minimum", "minio", "50Gi", ""},
+		{"redis has no minimum", "redis", "256Mi", ""},
+		{"mysql without storage_size", "mysql", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = 0
+			_, err := testClient.CreateService(context.Background(), &ApplicationService{ApplicationID: 1, Type: tt.serviceType, StorageSize: tt.storageSize})
+
+			if tt.errorMsg == "" {
+				if err != nil {
+					t.Fatalf("Expected no error but got: %v", err)
+				}
+				if requests != 1 {
+					t.Errorf("Expected the request to reach the API, got %d requests", requests)
+				}
+				return
+			}
+
+			if err == nil || err.Error() != tt.errorMsg {
+				t.Errorf("Expected error '%s', got %v", tt.errorMsg, err)
+			}
+			if requests != 0 {
+				t.Errorf("Expected no API request for an invalid service, got %d", requests)
+			}
+		})
+	}
+}
+
+func TestUpdateService_Validation(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": {"id": 2, "application_id": 1, "type": "mysql", "memory_request": "1Gi"}}`))
+	}))
+	defer server.Close()
+
+	testClient := NewClient("test-token", &server.URL)
+
+	_, err := testClient.UpdateService(context.Background(), 1, 2, &ApplicationService{ApplicationID: 1, Type: "mysql", MemoryRequest: "lots"})
+	if err == nil || err.Error() != "invalid memory_request format 'lots'. Use format like '256Mi' or '1Gi'" {
+		t.Errorf("Expected memory_request validation error, got %v", err)
+	}
+	if requests != 0 {
+		t.Fatalf("Expected validation to fail before any HTTP call, got %d requests", requests)
+	}
+
+	// The application ID from the path satisfies validation when the body omits it
+	updated, err := testClient.UpdateService(context.Background(), 1, 2, &ApplicationService{Type: "mysql", MemoryRequest: "1Gi"})
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if requests != 1 || updated.MemoryRequest != "1Gi" {
+		t.Errorf("Expected the update to reach the API, got %d requests and %+v", requests, updated)
+	}
+}
+
+func TestUpdateService_DetailedError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"message": "The given data was invalid.", "errors": {"memory_request": ["The memory request exceeds the plan limit."]}}`))
+	}))
+	defer server.Close()
+
+	testClient := NewClient("test-token", &server.URL)
+
+	_, err := testClient.UpdateService(context.Background(), 1, 2, &ApplicationService{ApplicationID: 1, Type: "mysql", MemoryRequest: "64Gi"})
+	if !IsValidationError(err) {
+		t.Fatalf("Expected validation error, got %v", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %T", err)
+	}
+	if apiErr.Operation != "update service" {
+		t.Errorf("Expected Operation 'update service', got '%s'", apiErr.Operation)
+	}
+	if apiErr.Suggestion == "" || !strings.Contains(err.Error(), "Suggestion:") {
+		t.Errorf("Expected error message to contain suggestion, got: %s", err.Error())
+	}
+}
+
+func TestValidateServiceRequest_Replicas(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []ClientOption
+		replicas int64
+		errorMsg string
+	}{
+		{"unset", nil, 0, ""},
+		{"minimum", nil, 1, ""},
+		{"default maximum", nil, 50, ""},
+		{"above default maximum", nil, 51, "replicas must be between 1 and 50, got 51"},
+		{"negative", nil, -1, "replicas must be between 1 and 50, got -1"},
+		{"configured maximum", []ClientOption{WithMaxReplicas(100)}, 100, ""},
+		{"above configured maximum", []ClientOption{WithMaxReplicas(100)}, 101, "replicas must be between 1 and 100, got 101"},
+		{"lowered maximum", []ClientOption{WithMaxReplicas(5)}, 6, "replicas must be between 1 and 5, got 6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient("test-token", nil, tt.opts...)
+			err := client.ValidateServiceRequest(&ApplicationService{ApplicationID: 1, Type: "redis", Replicas: tt.replicas})
+
+			if tt.errorMsg == "" {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+			} else if err == nil || err.Error() != tt.errorMsg {
+				t.Errorf("Expected error '%s', got %v", tt.errorMsg, err)
+			}
+		})
+	}
+}
+
+func TestValidateWorkerRequest(t *testing.T) {
+	client := NewClient("test-token", nil)
+
+	valid := func() *Worker {
+		return &Worker{
+			ApplicationID: 1,
+			Name:          "queue-worker",
+			Command:       "php artisan queue:work",
+			Type:          "queue",
+			Replicas:      2,
+			MemoryRequest: "256Mi",
+			CPURequest:    "250m",
+		}
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(*Worker) *Worker
+		errorMsg string
+	}{
+		{"valid worker", func(w *Worker) *Worker { return w }, ""},
+		{"nil worker", func(w *Worker) *Worker { return nil }, "worker cannot be nil"},
+		{"invalid application id", func(w *Worker) *Worker { w.ApplicationID = 0; return w }, "application_id must be greater than 0"},
+		{"missing command", func(w *Worker) *Worker { w.Command = ""; return w }, "command is required for workers"},
+		{"default type", func(w *Worker) *Worker { w.Type = ""; return w }, ""},
+		{"scheduler type", func(w *Worker) *Worker { w.Type = "scheduler"; return w }, ""},
+		{"invalid type", func(w *Worker) *Worker { w.Type = "cron"; return w }, "invalid worker type 'cron'. Must be one of: queue, scheduler, custom"},
+		{"invalid memory format", func(w *Worker) *Worker { w.MemoryRequest = "lots"; return w }, "invalid memory_request format 'lots'. Use format like '256Mi' or '1Gi'"},
+		{"invalid cpu format", func(w *Worker) *Worker { w.CPURequest = "fast"; return w }, "invalid cpu_request format 'fast'. Use format like '250m', '1', or '2'"},
+		{"zero replicas", func(w *Worker) *Worker { w.Replicas = 0; return w }, "replicas must be between 1 and 50, got 0"},
+		{"minimum replicas", func(w *Worker) *Worker { w.Replicas = 1; return w }, ""},
+		{"maximum replicas", func(w *Worker) *Worker { w.Replicas = 50; return w }, ""},
+		{"too many replicas", func(w *Worker) *Worker { w.Replicas = 51; return w }, "replicas must be between 1 and 50, got 51"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := client.ValidateWorkerRequest(tt.modify(valid()))
+
+			if tt.errorMsg == "" {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+			} else if err == nil || err.Error() != tt.errorMsg {
+				t.Errorf("Expected error '%s', got %v", tt.errorMsg, err)
+			}
+		})
+	}
+}
+
+func TestWorkerRequests_ValidatedBeforeAPICall(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"id": 2, "application_id": 1, "name": "queue-worker", "command": "php artisan queue:work", "replicas": 3}}`))
+	}))
+	defer server.Close()
+
+	testClient := NewClient("test-token", &server.URL)
+	ctx := context.Background()
+	worker := &Worker{ApplicationID: 1, Name: "queue-worker", Command: "php artisan queue:work", Replicas: 500}
+
+	if _, err := testClient.CreateWorker(ctx, worker); err == nil || err.Error() != "replicas must be between 1 and 50, got 500" {
+		t.Errorf("Expected replicas validation error on create, got %v", err)
+	}
+	if _, err := testClient.UpdateWorker(ctx, 1, 2, worker); err == nil || err.Error() != "replicas must be between 1 and 50, got 500" {
+		t.Errorf("Expected replicas validation error on update, got %v", err)
+	}
+	if requests != 0 {
+		t.Fatalf("Expected validation to fail before any HTTP call, got %d requests", requests)
+	}
+
+	// The application ID from the path satisfies validation when the body omits it
+	updated, err := testClient.UpdateWorker(ctx, 1, 2, &Worker{Name: "queue-worker", Command: "php artisan queue:work", Replicas: 3})
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if requests != 1 || updated.Replicas != 3 {
+		t.Errorf("Expected the update to reach the API, got %d requests and %+v", requests, updated)
+	}
+}
+
+func TestValidateVolumeRequest(t *testing.T) {
+	client := NewClient("test-token", nil)
+
+	valid := func() *ApplicationVolume {
+		return &ApplicationVolume{
+			ApplicationID: 1,
+			Name:          "uploads",
+			Size:          10,
+			MountPath:     "/var/www/storage",
+		}
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(*ApplicationVolume) *ApplicationVolume
+		errorMsg string
+	}{
+		{"valid volume", func(v *ApplicationVolume) *ApplicationVolume { return v }, ""},
+		{"root mount path", func(v *ApplicationVolume) *ApplicationVolume { v.MountPath = "/"; return v }, ""},
+		{"nil volume", func(v *ApplicationVolume) *ApplicationVolume { return nil }, "volume cannot be nil"},
+		{"invalid application id", func(v *ApplicationVolume) *ApplicationVolume { v.ApplicationID = 0; return v }, "application_id must be greater than 0"},
+		{"empty mount path", func(v *ApplicationVolume) *ApplicationVolume { v.MountPath = ""; return v }, "mount_path is required for volumes"},
+		{"relative mount path", func(v *ApplicationVolume) *ApplicationVolume { v.MountPath = "storage"; return v }, "mount_path must be an absolute path starting with '/', got 'storage'"},
+		{"dot relative mount path", func(v *ApplicationVolume) *ApplicationVolume { v.MountPath = "./storage"; return v }, "mount_path must be an absolute path starting with '/', got './storage'"},
+		{"zero size", func(v *ApplicationVolume) *ApplicationVolume { v.Size = 0; return v }, "size must be greater than 0, got 0"},
+		{"negative size", func(v *ApplicationVolume) *ApplicationVolume { v.Size = -5; return v }, "size must be greater than 0, got -5"},
+		{"minimum size", func(v *ApplicationVolume) *ApplicationVolume { v.Size = 1; return v }, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := client.ValidateVolumeRequest(tt.modify(valid()))
+
+			if tt.errorMsg == "" {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+			} else if err == nil || err.Error() != tt.errorMsg {
+				t.Errorf("Expected error '%s', got %v", tt.errorMsg, err)
+			}
+		})
+	}
+}
+
+func TestVolumeRequests_ValidatedBeforeAPICall(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"id": 2, "application_id": 1, "name": "uploads", "size": 20, "path": "/var/www/storage"}}`))
+	}))
+	defer server.Close()
+
+	testClient := NewClient("test-token", &server.URL)
+	ctx := context.Background()
+	volume := &ApplicationVolume{ApplicationID: 1, Name: "uploads", Size: 10, MountPath: "storage"}
+
+	expected := "mount_path must be an absolute path starting with '/', got 'storage'"
+	if _, err := testClient.CreateVolume(ctx, volume); err == nil || err.Error() != expected {
+		t.Errorf("Expected mount_path validation error on create, got %v", err)
+	}
+	if _, err := testClient.UpdateVolume(ctx, 1, 2, volume); err == nil || err.Error() != expected {
+		t.Errorf("Expected mount_path validation error on update, got %v", err)
+	}
+	if requests != 0 {
+		t.Fatalf("Expected validation to fail before any HTTP call, got %d requests", requests)
+	}
+
+	// The application ID from the path satisfies validation when the body omits it
+	updated, err := testClient.UpdateVolume(ctx, 1, 2, &ApplicationVolume{Name: "uploads", Size: 20, MountPath: "/var/www/storage"})
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if requests != 1 || updated.Size != 20 {
+		t.Errorf("Expected the update to reach the API, got %d requests and %+v", requests, updated)
+	}
+}
+
+func TestValidatePostgresExtensions(t *testing.T) {
+	tests := []struct {
+		name        string
+		extensions  []string
+		expectError string
+	}{
+		{"none", nil, ""},
+		{"known extensions", []string{"uuid-ossp", "pgcrypto", "postgis", "citext"}, ""},
+		{"underscore typo", []string{"pgcrypto", "uuid_ossp"}, "unknown PostgreSQL extension 'uuid_ossp', did you mean 'uuid-ossp'?"},
+		{"misspelled", []string{"hstor"}, "unknown PostgreSQL extension 'hstor', did you mean 'hstore'?"},
+		{"wrong case", []string{"PGCrypto"}, "unknown PostgreSQL extension 'PGCrypto', did you mean 'pgcrypto'?"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePostgresExtensions(tt.extensions)
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), tt.expectError) {
+				t.Errorf("Expected error starting with %q, got %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestValidateServiceVersion(t *testing.T) {
+	tests := []struct {
+		serviceType string
+		version     string
+		expectError string
+	}{
+		{"postgresql", "16", ""},
+		{"postgresql", "13", ""},
+		{"postgresql", "99", "version '99' is not supported for postgresql services. Check that the version is supported for the selected service type, valid postgresql versions: 13, 14, 15, 16"},
+		{"mysql", "8.0", ""},
+		{"mysql", "8.4", ""},
+		{"mysql", "8", "valid mysql versions: 5.7, 8.0, 8.4"},
+		{"mongodb", "7.0", ""},
+		{"mongodb", "5", "valid mongodb versions: 6, 6.0, 7, 7.0"},
+		{"redis", "7.2", ""},
+		{"redis", "15", "version '15' is not supported for redis services"},
+		{"valkey", "8", ""},
+		{"rabbitmq", "3.12", ""},
+		{"rabbitmq", "4.0", "valid rabbitmq versions: 3.12, 3.13"},
+		{"postgresql", "", ""},
+		{"minio", "latest", ""},
+		{"worker", "anything", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.serviceType+"/"+tt.version, func(t *testing.T) {
+			err := ValidateServiceVersion(tt.serviceType, tt.version)
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+				t.Errorf("Expected error containing %q, got %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestCreateService_ValidatesPostgresExtensions(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"data": {"id": 5, "application_id": 1, "type": "redis"}}`))
+	}))
+	defer server.Close()
+
+	testClient := NewClient("test-token", &server.URL)
+	ctx := context.Background()
+
+	postgres := &ApplicationService{ApplicationID: 1, Type: "postgresql", Extensions: []string{"uuid_ossp"}}
+	if _, err := testClient.CreateService(ctx, postgres); err == nil || !strings.Contains(err.Error(), "did you mean 'uuid-ossp'") {
+		t.Errorf("Expected extension validation error, got %v", err)
+	}
+	if requests != 0 {
+		t.Fatalf("Expected validation to fail before any HTTP call, got %d requests", requests)
+	}
+
+	// Other service types never install extensions, so they are not checked
+	redis := &ApplicationService{ApplicationID: 1, Type: "redis", Extensions: []string{"uuid_ossp"}}
+	if _, err := testClient.CreateService(ctx, redis); err != nil {
+		t.Fatalf("Expected non-postgres extensions to be ignored, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected the redis service to reach the API, got %d requests", requests)
+	}
+}
+
 func TestIsValidResourceSpec(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -461,6 +871,163 @@ func TestHandleErrorResponse(t *testing.T) {
 	}
 }
 
+func TestAPIError_ErrorsAs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"message": "The given data was invalid.", "errors": {"storage_size": ["The storage size must be at least 1Gi."], "version": "The selected version is invalid."}}`))
+	}))
+	defer server.Close()
+
+	testClient := NewClient("test-token", &server.URL)
+
+	_, err := testClient.CreateService(context.Background(), &ApplicationService{ApplicationID: 1, Type: "mysql", StorageSize: "1Gi"})
+	if err == nil {
+		t.Fatal("Expected error but got none")
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %T", err)
+	}
+
+	if apiErr.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Expected StatusCode 422, got %d", apiErr.StatusCode)
+	}
+	if apiErr.Message != "The given data was invalid." {
+		t.Errorf("Expected Message to be populated, got '%s'", apiErr.Message)
+	}
+	if len(apiErr.Errors["storage_size"]) != 1 || apiErr.Errors["storage_size"][0] != "The storage size must be at least 1Gi." {
+		t.Errorf("Expected storage_size field error, got %v", apiErr.Errors["storage_size"])
+	}
+	if len(apiErr.Errors["version"]) != 1 {
+		t.Errorf("Expected version field error, got %v", apiErr.Errors["version"])
+	}
+	if apiErr.Suggestion == "" {
+		t.Error("Expected Suggestion to be populated")
+	}
+	if apiErr.Operation != "create service" {
+		t.Errorf("Expected Operation 'create service', got '%s'", apiErr.Operation)
+	}
+
+	// The formatted message is kept for display
+	if !strings.HasPrefix(err.Error(), "failed to create service: The given data was invalid.") {
+		t.Errorf("Unexpected error message: %s", err.Error())
+	}
+	if !strings.Contains(err.Error(), "Suggestion:") {
+		t.Errorf("Expected error message to contain suggestion, got: %s", err.Error())
+	}
+}
+
+func TestAPIError_UndecodableBody(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusBadGateway,
+		Status:     "502 Bad Gateway",
+		Body:       io.NopCloser(strings.NewReader("<html>Bad Gateway</html>")),
+		Header:     make(http.Header),
+	}
+
+	err := (&Client{}).handleErrorResponse(resp, "get application")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("Expected StatusCode 502, got %d", apiErr.StatusCode)
+	}
+	if err.Error() != "failed to get application: 502 Bad Gateway" {
+		t.Errorf("Unexpected error message: %s", err.Error())
+	}
+}
+
+func TestErrorPredicates(t *testing.T) {
+	apiErr := func(status int) error {
+		return &APIError{DetailedError: &DetailedError{StatusCode: status, Message: http.StatusText(status)}, Operation: "get worker"}
+	}
+
+	tests := []struct {
+		name             string
+		err              error
+		expectNotFound   bool
+		expectValidation bool
+		expectAuthError  bool
+	}{
+		{"not found", apiErr(http.StatusNotFound), true, false, false},
+		{"validation", apiErr(http.StatusUnprocessableEntity), false, true, false},
+		{"unauthorized", apiErr(http.StatusUnauthorized), false, false, true},
+		{"forbidden", apiErr(http.StatusForbidden), false, false, true},
+		{"server error", apiErr(http.StatusInternalServerError), false, false, false},
+		{"wrapped", fmt.Errorf("reading worker: %w", apiErr(http.StatusNotFound)), true, false, false},
+		{"detailed error", &DetailedError{StatusCode: http.StatusNotFound}, true, false, false},
+		{"plain error", errors.New("404 not found"), false, false, false},
+		{"nil", nil, false, false, false},
+		{"api error without details", &APIError{Operation: "get worker"}, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.expectNotFound {
+				t.Errorf("IsNotFound = %v, expected %v", got, tt.expectNotFound)
+			}
+			if got := IsValidationError(tt.err); got != tt.expectValidation {
+				t.Errorf("IsValidationError = %v, expected %v", got, tt.expectValidation)
+			}
+			if got := IsAuthError(tt.err); got != tt.expectAuthError {
+				t.Errorf("IsAuthError = %v, expected %v", got, tt.expectAuthError)
+			}
+		})
+	}
+}
+
+func TestTypedErrors_KeepMessages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "Domain not found."}`))
+		case http.MethodPost:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"message": "The given data was invalid.", "errors": {"domain": ["The domain has already been taken."]}}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`not json`))
+		}
+	}))
+	defer server.Close()
+
+	testClient := NewClient("test-token", &server.URL, WithRetriesDisabled())
+
+	err := testClient.DeleteDomain(context.Background(), 1, 2)
+	if !IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %T: %v", err, err)
+	}
+	if err == nil || err.Error() != "failed to delete domain: Domain not found." {
+		t.Errorf("Unexpected error message: %v", err)
+	}
+
+	_, err = testClient.CreateDomain(context.Background(), &ApplicationDomain{ApplicationID: 1, Domain: "taken.example.com"})
+	if !IsValidationError(err) {
+		t.Errorf("Expected a validation error, got %T: %v", err, err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || len(apiErr.Errors["domain"]) != 1 {
+		t.Errorf("Expected the domain field error, got %v", err)
+	}
+	if err.Error() != "failed to create domain: The given data was invalid." {
+		t.Errorf("Unexpected error message: %v", err)
+	}
+
+	_, err = testClient.GetWorker(context.Background(), 1, 2)
+	if !IsAuthError(err) {
+		t.Errorf("Expected an auth error, got %T: %v", err, err)
+	}
+	if err == nil || err.Error() != "failed to get worker: 403 Forbidden" {
+		t.Errorf("Unexpected error message: %v", err)
+	}
+}
+
 func TestDoRequestWithRetry(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -530,7 +1097,7 @@ func TestDoRequestWithRetry(t *testing.T) {
 
 			client := NewClient("test-token", &server.URL)
 			
-			resp, err := client.doRequestWithRetry("GET", "/test", nil, 3)
+			resp, err := client.doRequestWithRetry(context.Background(), "GET", "/test", nil, 3)
 			
 			if tt.expectSuccess && err != nil {
 				t.Errorf("Expected success but got error: %v", err)
@@ -548,6 +1115,132 @@ func TestDoRequestWithRetry(t *testing.T) {
 	}
 }
 
+func TestDoRequestWithRetry_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		// Cancel while the client is about to back off before the retry
+		cancel()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(500)
+		w.Write([]byte(`{"message": "Server error"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token", &server.URL)
+
+	start := time.Now()
+	_, err := client.GetApplication(ctx, 1)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected a context cancelled error, got %v", err)
+	}
+	if requestCount != 1 {
+		t.Errorf("Expected no retry after cancellation, got %d requests", requestCount)
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("Expected the backoff to be cut short, request took %v", elapsed)
+	}
+
+	// An already cancelled context fails before any request is sent
+	requestCount = 0
+	if _, err := client.GetApplication(ctx, 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected a context cancelled error, got %v", err)
+	}
+	if requestCount != 0 {
+		t.Errorf("Expected no request with a cancelled context, got %d", requestCount)
+	}
+}
+
+func TestDoRequestWithRetry_RetriesDisabled(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(500)
+		w.Write([]byte(`{"message": "Server error"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token", &server.URL, WithRetriesDisabled())
+
+	start := time.Now()
+	resp, err := client.doRequestWithRetry(context.Background(), "GET", "/test", nil, 3)
+	if err != nil {
+		t.Fatalf("Expected the 500 response to be returned, got error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 500 {
+		t.Errorf("Expected status 500, got %d", resp.StatusCode)
+	}
+	if requestCount != 1 {
+		t.Errorf("Expected a single attempt, got %d requests", requestCount)
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("Expected no retry backoff, request took %v", elapsed)
+	}
+
+	// Public methods go through the same path
+	requestCount = 0
+	if _, err := client.GetApplication(context.Background(), 1); err == nil {
+		t.Error("Expected error for 500 response")
+	}
+	if requestCount != 1 {
+		t.Errorf("Expected a single attempt from GetApplication, got %d requests", requestCount)
+	}
+}
+
+// TestCreateApplication_IdempotencyKey tests that retries of a create reuse
+// its Idempotency-Key while separate creates each get their own
+func TestCreateApplication_IdempotencyKey(t *testing.T) {
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		w.Header().Set("Content-Type", "application/json")
+		// Fail every first attempt, as if the response had been lost
+		if len(keys)%2 == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"data": {"id": 1, "name": "app", "application_type": "laravel"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token", &server.URL)
+
+	for range 2 {
+		if _, err := client.CreateApplication(context.Background(), &Application{Name: "app", Type: "laravel"}); err != nil {
+			t.Fatalf("Expected success but got error: %v", err)
+		}
+	}
+
+	if len(keys) != 4 {
+		t.Fatalf("Expected two attempts per create, got %d requests", len(keys))
+	}
+	if keys[0] == "" {
+		t.Fatal("Expected an Idempotency-Key on the create request")
+	}
+	if keys[0] != keys[1] || keys[2] != keys[3] {
+		t.Errorf("Expected retries to reuse the Idempotency-Key, got %v", keys)
+	}
+	if keys[0] == keys[2] {
+		t.Errorf("Expected separate creates to use different Idempotency-Keys, got %v", keys)
+	}
+
+	// Other requests are not marked idempotent
+	keys = nil
+	client = NewClient("test-token", &server.URL, WithRetriesDisabled())
+	client.GetApplication(context.Background(), 1)
+	if len(keys) != 1 || keys[0] != "" {
+		t.Errorf("Expected no Idempotency-Key on reads, got %v", keys)
+	}
+}
+
 func TestLogRequest(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -648,16 +1341,159 @@ func TestSanitizeBody(t *testing.T) {
 			body:     `{"command": "php artisan queue:work --timeout=60"}`,
 			expected: `{"command": "php artisan queue:work --timeout=60"}`,
 		},
+		{
+			name:     "secret creation payload",
+			body:     `{"key": "STRIPE_SECRET", "value": "sk_live_123"}`,
+			expected: `{"key":"STRIPE_SECRET","value":"***"}`,
+		},
+		{
+			name:     "nested credentials",
+			body:     `{"data": {"name": "db", "connection": {"username": "app", "password": "hunter2"}}}`,
+			expected: `{"data":{"connection":{"password":"***","username":"app"},"name":"db"}}`,
+		},
+		{
+			name:     "keys ending in _key and token",
+			body:     `{"backup": {"encryption_mode": "customer_managed", "kms_key": "arn:aws:kms:key/1"}, "Token": "abc", "secret": "s"}`,
+			expected: `{"Token":"***","backup":{"encryption_mode":"customer_managed","kms_key":"***"},"secret":"***"}`,
+		},
+		{
+			name:     "alert notification url",
+			body:     `{"metric": "connections", "threshold": 90, "notification_url": "https://hooks.slack.com/services/T0/B0/abc"}`,
+			expected: `{"metric":"connections","notification_url":"***","threshold":90}`,
+		},
+		{
+			name:     "secrets in a list",
+			body:     `[{"key": "A", "value": "1"}, {"key": "B", "value": "2"}]`,
+			expected: `[{"key":"A","value":"***"},{"key":"B","value":"***"}]`,
+		},
+		{
+			name:     "null sensitive value is kept",
+			body:     `{"key": "A", "value": null}`,
+			expected: `{"key": "A", "value": null}`,
+		},
+		{
+			name:     "non-JSON body",
+			body:     `password=hunter2`,
+			expected: `password=hunter2`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := client.sanitizeBody(tt.body)
+			if result != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCreateSecret_LogsRedactedValue(t *testing.T) {
+	oldPloiDebug := os.Getenv("PLOI_DEBUG")
+	os.Setenv("PLOI_DEBUG", "1")
+	defer os.Setenv("PLOI_DEBUG", oldPloiDebug)
+
+	var logOutput strings.Builder
+	oldOutput := log.Writer()
+	log.SetOutput(&logOutput)
+	defer log.SetOutput(oldOutput)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"data": {"application_id": 1, "key": "STRIPE_SECRET", "value": "sk_live_123"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token", &server.URL)
+	secret, err := client.CreateSecret(context.Background(), &ApplicationSecret{ApplicationID: 1, Key: "STRIPE_SECRET", Value: "sk_live_123"})
+	if err != nil {
+		t.Fatalf("Expected success but got error: %v", err)
+	}
+	if secret.Value != "sk_live_123" {
+		t.Errorf("Expected the caller to receive the unredacted value, got %q", secret.Value)
+	}
+
+	output := logOutput.String()
+	if strings.Contains(output, "sk_live_123") {
+		t.Errorf("Expected the secret value to be masked in the log, got:\n%s", output)
+	}
+	if !strings.Contains(output, `"key":"STRIPE_SECRET"`) || !strings.Contains(output, `"value":"***"`) {
+		t.Errorf("Expected the key to stay visible and the value to be masked, got:\n%s", output)
 	}
+}
+
+func TestSetSecrets(t *testing.T) {
+	secrets := map[string]string{"APP_KEY": "a", "DB_PASSWORD": "b", "MAIL_PASSWORD": "c", "REDIS_PASSWORD": "d"}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := client.sanitizeBody(tt.body)
-			if result != tt.expected {
-				t.Errorf("Expected '%s', got '%s'", tt.expected, result)
+	t.Run("batch endpoint", func(t *testing.T) {
+		var requests []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client := NewClient("test-token", &server.URL)
+		if err := client.SetSecrets(context.Background(), 1, secrets); err != nil {
+			t.Fatalf("Expected success but got error: %v", err)
+		}
+		if strings.Join(requests, ",") != "PUT /applications/1/secrets" {
+			t.Errorf("Expected a single batch request, got %v", requests)
+		}
+	})
+
+	t.Run("some keys fail", func(t *testing.T) {
+		var requests []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			body, _ := io.ReadAll(r.Body)
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == "PUT" && r.URL.Path == "/applications/1/secrets":
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			case r.Method == "GET":
+				w.Write([]byte(`{"data": [{"application_id": 1, "key": "DB_PASSWORD", "value": "old"}, {"application_id": 1, "key": "REDIS_PASSWORD", "value": "old"}]}`))
+			case r.URL.Path == "/applications/1/secrets/REDIS_PASSWORD" || strings.Contains(string(body), "MAIL_PASSWORD"):
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"message": "invalid value"}`))
+			default:
+				w.Write([]byte(`{"data": {"application_id": 1}}`))
 			}
-		})
-	}
+		}))
+		defer server.Close()
+
+		client := NewClient("test-token", &server.URL)
+		err := client.SetSecrets(context.Background(), 1, secrets)
+
+		var secretsErr *SecretsError
+		if !errors.As(err, &secretsErr) {
+			t.Fatalf("Expected a *SecretsError, got %v", err)
+		}
+		if got := strings.Join(secretsErr.FailedKeys(), ","); got != "MAIL_PASSWORD,REDIS_PASSWORD" {
+			t.Errorf("Expected exactly MAIL_PASSWORD and REDIS_PASSWORD to fail, got %s", got)
+		}
+		if got := strings.Join(secretsErr.Applied, ","); got != "APP_KEY,DB_PASSWORD" {
+			t.Errorf("Expected APP_KEY and DB_PASSWORD to be applied, got %s", got)
+		}
+		for _, want := range []string{"unable to write secret MAIL_PASSWORD", "unable to write secret REDIS_PASSWORD", "secrets written: APP_KEY, DB_PASSWORD"} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("Expected error to contain %q, got %q", want, err.Error())
+			}
+		}
+		if strings.Contains(err.Error(), "unable to write secret APP_KEY") || strings.Contains(err.Error(), "unable to write secret DB_PASSWORD") {
+			t.Errorf("Expected only the failed keys to be reported as failed, got %q", err.Error())
+		}
+
+		// Existing keys are updated, new ones created, and the keys after a
+		// failure are still attempted
+		expected := "PUT /applications/1/secrets,GET /applications/1/secrets," +
+			"POST /applications/1/secrets,PUT /applications/1/secrets/DB_PASSWORD," +
+			"POST /applications/1/secrets,PUT /applications/1/secrets/REDIS_PASSWORD"
+		if got := strings.Join(requests, ","); got != expected {
+			t.Errorf("Expected requests %s, got %s", expected, got)
+		}
+	})
 }
 
 func TestWorkerResourceDeprecation(t *testing.T) {
@@ -681,7 +1517,7 @@ func TestWorkerResourceDeprecation(t *testing.T) {
 
 	testClient := NewClient("test-token", &server.URL)
 	
-	_, err := testClient.CreateWorker(worker)
+	_, err := testClient.CreateWorker(context.Background(), worker)
 	if err == nil {
 		t.Error("Expected error for deprecated worker endpoint")
 	}
@@ -713,7 +1549,7 @@ func TestVolumeCreateRestriction(t *testing.T) {
 
 	testClient := NewClient("test-token", &server.URL)
 	
-	_, err := testClient.CreateVolume(volume)
+	_, err := testClient.CreateVolume(context.Background(), volume)
 	if err == nil {
 		t.Error("Expected error for volume creation")
 	}
@@ -877,7 +1713,7 @@ func TestRetryLogicEdgeCases(t *testing.T) {
 
 			client := NewClient("test-token", &server.URL)
 			
-			_, err := client.doRequestWithRetry("GET", "/test", nil, 3)
+			_, err := client.doRequestWithRetry(context.Background(), "GET", "/test", nil, 3)
 			
 			actualRetries := requestCount - 1
 			if actualRetries != tt.expectRetries {
@@ -921,7 +1757,7 @@ func TestServiceCreateWithValidationFlow(t *testing.T) {
 
 	client := NewClient("test-token", &server.URL)
 	
-	_, err := client.CreateService(service)
+	_, err := client.CreateService(context.Background(), service)
 	if err == nil {
 		t.Fatal("Expected error from service creation")
 	}
@@ -945,7 +1781,7 @@ func TestServiceCreateWithValidationFlow(t *testing.T) {
 func TestNilClientHandling(t *testing.T) {
 	var client *Client
 	
-	_, err := client.doRequestWithRetry("GET", "/test", nil, 3)
+	_, err := client.doRequestWithRetry(context.Background(), "GET", "/test", nil, 3)
 	if err == nil {
 		t.Error("Expected error for nil client")
 	}
@@ -985,7 +1821,7 @@ func TestClientFieldValidation(t *testing.T) {
 				logger:      &Logger{enabled: false, debug: false},
 			}
 
-			_, err := client.doRequestWithRetry("GET", "/test", nil, 3)
+			_, err := client.doRequestWithRetry(context.Background(), "GET", "/test", nil, 3)
 			if err == nil {
 				t.Error("Expected error but got none")
 			}
@@ -1081,7 +1917,7 @@ func TestVolumeReadOnlyOperations(t *testing.T) {
 	client := NewClient("test-token", &server.URL)
 	
 	// Test GET operation (should work)
-	retrievedVolume, err := client.GetVolume(1, 1)
+	retrievedVolume, err := client.GetVolume(context.Background(), 1, 1)
 	if err != nil {
 		t.Errorf("Expected no error for volume GET, got: %v", err)
 	}
@@ -1091,7 +1927,7 @@ func TestVolumeReadOnlyOperations(t *testing.T) {
 
 	// Test UPDATE operation (should work - volume resize)
 	volume.Size = 30
-	updatedVolume, err := client.UpdateVolume(1, 1, volume)
+	updatedVolume, err := client.UpdateVolume(context.Background(), 1, 1, volume)
 	if err != nil {
 		t.Errorf("Expected no error for volume UPDATE, got: %v", err)
 	}
@@ -1124,7 +1960,7 @@ func TestWorkerToServiceMigrationMessage(t *testing.T) {
 	
 	// Test CreateWorker operation
 	t.Run("create_worker", func(t *testing.T) {
-		_, err := client.CreateWorker(worker)
+		_, err := client.CreateWorker(context.Background(), worker)
 		if err == nil {
 			t.Error("Expected error for deprecated worker endpoint")
 		}
@@ -1137,7 +1973,7 @@ func TestWorkerToServiceMigrationMessage(t *testing.T) {
 
 	// Test UpdateWorker operation  
 	t.Run("update_worker", func(t *testing.T) {
-		_, err := client.UpdateWorker(1, 1, worker)
+		_, err := client.UpdateWorker(context.Background(), 1, 1, worker)
 		if err == nil {
 			t.Error("Expected error for deprecated worker endpoint")
 		}
@@ -1150,7 +1986,7 @@ func TestWorkerToServiceMigrationMessage(t *testing.T) {
 
 	// Test DeleteWorker operation
 	t.Run("delete_worker", func(t *testing.T) {
-		err := client.DeleteWorker(1, 1)
+		err := client.DeleteWorker(context.Background(), 1, 1)
 		if err == nil {
 			t.Error("Expected error for deprecated worker endpoint")
 		}
@@ -1160,4 +1996,527 @@ func TestWorkerToServiceMigrationMessage(t *testing.T) {
 			t.Errorf("Expected error message to contain deprecation notice, got: %s", errorMsg)
 		}
 	})
-}
\ No newline at end of file
+}
+
+func TestNewClient_RequestTimeout(t *testing.T) {
+	if timeout := NewClient("test-token", nil).httpClient.Timeout; timeout != DefaultRequestTimeout {
+		t.Errorf("Expected default timeout %v, got %v", DefaultRequestTimeout, timeout)
+	}
+
+	if timeout := NewClient("test-token", nil, WithRequestTimeout(120*time.Second)).httpClient.Timeout; timeout != 120*time.Second {
+		t.Errorf("Expected configured timeout 2m0s, got %v", timeout)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token", &server.URL, WithRequestTimeout(50*time.Millisecond), WithRetriesDisabled())
+	if _, err := client.doRequest(context.Background(), "GET", "/test", nil); err == nil {
+		t.Error("Expected request to time out")
+	}
+}
+
+// recordingTransport answers every request with an empty JSON object and
+// records the method and path it saw
+type recordingTransport struct {
+	requests []string
+}
+
+func (rt *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.requests = append(rt.requests, req.Method+" "+req.URL.Path)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"data": {"id": 1, "name": "app", "application_type": "laravel"}}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestWithTransport(t *testing.T) {
+	if transport, ok := NewClient("test-token", nil).httpClient.Transport.(*http.Transport); !ok {
+		t.Errorf("Expected the default transport, got %T", transport)
+	}
+	if transport, ok := NewClient("test-token", nil, WithTransport(nil)).httpClient.Transport.(*http.Transport); !ok {
+		t.Errorf("Expected a nil transport to keep the default, got %T", transport)
+	}
+
+	endpoint := "https://api.invalid/v1"
+	transport := &recordingTransport{}
+	client := NewClient("test-token", &endpoint, WithTransport(transport), WithRequestTimeout(time.Second))
+
+	if app, err := client.GetApplication(context.Background(), 1); err != nil || app == nil || app.Name != "app" {
+		t.Fatalf("Expected the recorded response to be decoded, got %+v, %v", app, err)
+	}
+	if _, err := client.UpdateApplication(context.Background(), 1, map[string]interface{}{"name": "renamed"}); err != nil {
+		t.Fatalf("Expected success but got error: %v", err)
+	}
+
+	expected := []string{"GET /v1/applications/1", "PUT /v1/applications/1"}
+	if strings.Join(transport.requests, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected transport to see %v, got %v", expected, transport.requests)
+	}
+	if client.httpClient.Timeout != time.Second {
+		t.Errorf("Expected the request timeout to be kept alongside the transport, got %v", client.httpClient.Timeout)
+	}
+}
+
+// TestMaxIdleConns tests that the default transport keeps idle connections to
+// the API open for reuse and that the pool size can be overridden
+func TestMaxIdleConns(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []ClientOption
+		expected int
+	}{
+		{"default", nil, DefaultMaxIdleConns},
+		{"overridden", []ClientOption{WithMaxIdleConns(64)}, 64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient("test-token", nil, tt.opts...)
+
+			transport, ok := client.httpClient.Transport.(*http.Transport)
+			if !ok {
+				t.Fatalf("Expected an *http.Transport, got %T", client.httpClient.Transport)
+			}
+			if transport.MaxIdleConns != tt.expected || transport.MaxIdleConnsPerHost != tt.expected {
+				t.Errorf("Expected MaxIdleConns and MaxIdleConnsPerHost %d, got %d and %d", tt.expected, transport.MaxIdleConns, transport.MaxIdleConnsPerHost)
+			}
+			if transport.IdleConnTimeout != idleConnTimeout {
+				t.Errorf("Expected IdleConnTimeout %v, got %v", idleConnTimeout, transport.IdleConnTimeout)
+			}
+			if transport.Proxy == nil {
+				t.Error("Expected the proxy settings of the default transport to be kept")
+			}
+			if client.MaxIdleConns() != tt.expected {
+				t.Errorf("Expected MaxIdleConns() %d, got %d", tt.expected, client.MaxIdleConns())
+			}
+		})
+	}
+
+	// Clients do not share a transport, so tuning one leaves the others alone
+	if NewClient("test-token", nil).httpClient.Transport == NewClient("test-token", nil).httpClient.Transport {
+		t.Error("Expected every client to get its own transport")
+	}
+	if http.DefaultTransport.(*http.Transport).MaxIdleConnsPerHost == 64 {
+		t.Error("Expected the default HTTP transport to be left unchanged")
+	}
+
+	custom := NewClient("test-token", nil, WithTransport(&recordingTransport{}), WithMaxIdleConns(64))
+	if custom.MaxIdleConns() != 0 {
+		t.Errorf("Expected MaxIdleConns() 0 for a custom transport, got %d", custom.MaxIdleConns())
+	}
+
+	// A caller's own *http.Transport is left alone, whatever the option order
+	for _, order := range [][]func(*http.Transport) ClientOption{
+		{func(t *http.Transport) ClientOption { return WithTransport(t) }, func(*http.Transport) ClientOption { return WithMaxIdleConns(64) }},
+		{func(*http.Transport) ClientOption { return WithMaxIdleConns(64) }, func(t *http.Transport) ClientOption { return WithTransport(t) }},
+	} {
+		own := &http.Transport{MaxIdleConns: 3, MaxIdleConnsPerHost: 3}
+		client := NewClient("test-token", nil, order[0](own), order[1](own))
+		if client.httpClient.Transport != own {
+			t.Errorf("Expected the caller's transport to be used, got %T", client.httpClient.Transport)
+		}
+		if own.MaxIdleConns != 3 || own.MaxIdleConnsPerHost != 3 {
+			t.Errorf("Expected the caller's transport to keep 3 idle connections, got %d and %d", own.MaxIdleConns, own.MaxIdleConnsPerHost)
+		}
+		if client.MaxIdleConns() != 0 {
+			t.Errorf("Expected MaxIdleConns() 0 for a custom transport, got %d", client.MaxIdleConns())
+		}
+	}
+}
+
+// TestReadCacheBypass tests that reads are marked no-cache when the read
+// cache is disabled or the application is explicitly refreshed
+func TestReadCacheBypass(t *testing.T) {
+	var cacheControl []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cacheControl = append(cacheControl, r.Method+" "+r.Header.Get("Cache-Control"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"id": 1, "name": "app", "application_type": "laravel"}}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	tests := []struct {
+		name     string
+		opts     []ClientOption
+		call     func(c *Client) error
+		expected string
+	}{
+		{
+			name:     "default read",
+			call:     func(c *Client) error { _, err := c.GetApplication(ctx, 1); return err },
+			expected: "GET ",
+		},
+		{
+			name:     "refresh",
+			call:     func(c *Client) error { _, err := c.RefreshApplication(ctx, 1); return err },
+			expected: "GET no-cache",
+		},
+		{
+			name:     "read with cache disabled",
+			opts:     []ClientOption{WithReadCacheDisabled()},
+			call:     func(c *Client) error { _, err := c.GetApplication(ctx, 1); return err },
+			expected: "GET no-cache",
+		},
+		{
+			name: "write with cache disabled",
+			opts: []ClientOption{WithReadCacheDisabled()},
+			call: func(c *Client) error {
+				_, err := c.UpdateApplication(ctx, 1, map[string]interface{}{"name": "app"})
+				return err
+			},
+			expected: "PUT ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cacheControl = nil
+			client := NewClient("test-token", &server.URL, tt.opts...)
+
+			if err := tt.call(client); err != nil {
+				t.Fatalf("Expected success but got error: %v", err)
+			}
+			if len(cacheControl) != 1 || cacheControl[0] != tt.expected {
+				t.Errorf("Expected %q, got %v", tt.expected, cacheControl)
+			}
+		})
+	}
+
+	// Refreshing must not leave later reads of the same client uncached
+	client := NewClient("test-token", &server.URL)
+	cacheControl = nil
+	client.RefreshApplication(ctx, 1)
+	client.GetApplication(ctx, 1)
+	if strings.Join(cacheControl, ",") != "GET no-cache,GET " {
+		t.Errorf("Expected only the refresh to bypass caches, got %v", cacheControl)
+	}
+}
+
+func TestBackoffDuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []ClientOption
+		expected []time.Duration
+	}{
+		{
+			name:     "default is linear",
+			expected: []time.Duration{1 * time.Second, 2 * time.Second, 3 * time.Second, 4 * time.Second},
+		},
+		{
+			name:     "linear",
+			opts:     []ClientOption{WithBackoffStrategy(BackoffLinear)},
+			expected: []time.Duration{1 * time.Second, 2 * time.Second, 3 * time.Second, 4 * time.Second},
+		},
+		{
+			name: "exponential capped at 30s",
+			opts: []ClientOption{WithBackoffStrategy(BackoffExponential)},
+			expected: []time.Duration{
+				1 * time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second,
+				30 * time.Second, 30 * time.Second,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient("test-token", nil, tt.opts...)
+			for attempt, expected := range tt.expected {
+				if got := client.backoffDuration(attempt); got != expected {
+					t.Errorf("Attempt %d: expected backoff %v, got %v", attempt, expected, got)
+				}
+			}
+		})
+	}
+
+	t.Run("exponential does not overflow", func(t *testing.T) {
+		client := NewClient("test-token", nil, WithBackoffStrategy(BackoffExponential))
+		if got := client.backoffDuration(100); got != 30*time.Second {
+			t.Errorf("Expected backoff capped at 30s, got %v", got)
+		}
+	})
+
+	t.Run("jitter stays within half and full backoff", func(t *testing.T) {
+		client := NewClient("test-token", nil, WithBackoffStrategy(BackoffExponential), WithBackoffJitter())
+		for i := 0; i < 100; i++ {
+			for attempt, full := range []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second} {
+				got := client.backoffDuration(attempt)
+				if got < full/2 || got > full {
+					t.Fatalf("Attempt %d: expected backoff between %v and %v, got %v", attempt, full/2, full, got)
+				}
+			}
+		}
+	})
+}
+
+func TestDoRequest_MaxRetries(t *testing.T) {
+	tests := []struct {
+		name             string
+		opts             []ClientOption
+		status           int
+		expectedRequests int
+	}{
+		{"no retries", []ClientOption{WithMaxRetries(0)}, 500, 1},
+		{"one retry on server error", []ClientOption{WithMaxRetries(1)}, 503, 2},
+		{"client errors are not retried", []ClientOption{WithMaxRetries(1)}, 404, 1},
+		{"rate limits are retried", []ClientOption{WithMaxRetries(1)}, 429, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestCount := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestCount++
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			client := NewClient("test-token", &server.URL, tt.opts...)
+			resp, err := client.doRequest(context.Background(), "GET", "/test", nil)
+			if err != nil {
+				t.Fatalf("Expected the %d response to be returned, got error: %v", tt.status, err)
+			}
+			resp.Body.Close()
+
+			if requestCount != tt.expectedRequests {
+				t.Errorf("Expected %d requests, got %d", tt.expectedRequests, requestCount)
+			}
+		})
+	}
+}
+
+func TestRetryAfterDuration(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		header   string
+		expected time.Duration
+		ok       bool
+	}{
+		{"missing", "", 0, false},
+		{"seconds", "5", 5 * time.Second, true},
+		{"zero seconds", "0", 0, true},
+		{"seconds capped", "3600", maxRetryAfter, true},
+		{"huge seconds capped", "99999999999999999", maxRetryAfter, true},
+		{"negative seconds", "-1", 0, false},
+		{"http date", now.Add(7 * time.Second).Format(http.TimeFormat), 7 * time.Second, true},
+		{"http date in the past", now.Add(-time.Minute).Format(http.TimeFormat), 0, true},
+		{"http date capped", now.Add(time.Hour).Format(http.TimeFormat), maxRetryAfter, true},
+		{"garbage", "soon", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wait, ok := retryAfterDuration(tt.header, now)
+			if ok != tt.ok || wait != tt.expected {
+				t.Errorf("Expected %v, %v for %q, got %v, %v", tt.expected, tt.ok, tt.header, wait, ok)
+			}
+		})
+	}
+}
+
+// TestDoRequest_RetryAfter tests that a 429 is retried after the delay its
+// Retry-After header asks for instead of the default backoff
+func TestDoRequest_RetryAfter(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryAfter func() string
+		minWait    time.Duration
+		maxWait    time.Duration
+	}{
+		// The default backoff would wait 1s
+		{"seconds", func() string { return "2" }, 2 * time.Second, 3 * time.Second},
+		// HTTP dates have second precision, so the wait lands between 1s and 2s
+		{"http date", func() string { return time.Now().Add(2 * time.Second).UTC().Format(http.TimeFormat) }, time.Second, 2500 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requestTimes []time.Time
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestTimes = append(requestTimes, time.Now())
+				if len(requestTimes) == 1 {
+					w.Header().Set("Retry-After", tt.retryAfter())
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client := NewClient("test-token", &server.URL, WithMaxRetries(1))
+			resp, err := client.doRequest(context.Background(), "GET", "/test", nil)
+			if err != nil {
+				t.Fatalf("Expected success but got error: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK || len(requestTimes) != 2 {
+				t.Fatalf("Expected the retry to succeed after 2 requests, got status %d after %d requests", resp.StatusCode, len(requestTimes))
+			}
+			if wait := requestTimes[1].Sub(requestTimes[0]); wait < tt.minWait || wait > tt.maxWait {
+				t.Errorf("Expected the retry after %v to %v, waited %v", tt.minWait, tt.maxWait, wait)
+			}
+		})
+	}
+}
+
+func TestDoRequest_DeprecationHeaders(t *testing.T) {
+	var logOutput strings.Builder
+	oldOutput := log.Writer()
+	log.SetOutput(&logOutput)
+	defer log.SetOutput(oldOutput)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/applications") {
+			w.Header().Set("Deprecation", "true")
+			w.Header().Set("Sunset", "Wed, 31 Dec 2025 23:59:59 GMT")
+			w.Header().Set("Link", `<https://docs.ploi.io/cloud/api/v2>; rel="successor-version"`)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"id": 1, "name": "app", "application_type": "laravel"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token", &server.URL, WithRetriesDisabled())
+
+	// The request still succeeds
+	app, err := client.GetApplication(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Expected success but got error: %v", err)
+	}
+	if app.ID != 1 {
+		t.Errorf("Expected application 1, got %d", app.ID)
+	}
+
+	output := logOutput.String()
+	if !strings.Contains(output, "[WARN] Ploi Cloud API endpoint GET /applications/1 is deprecated") {
+		t.Errorf("Expected deprecation warning to be logged, got: %s", output)
+	}
+	if !strings.Contains(output, "removed after Wed, 31 Dec 2025 23:59:59 GMT") {
+		t.Errorf("Expected sunset date in the warning, got: %s", output)
+	}
+
+	notices := client.DeprecationNotices()
+	if len(notices) != 1 {
+		t.Fatalf("Expected 1 deprecation notice, got %d", len(notices))
+	}
+	if notices[0].Method != "GET" || notices[0].Path != "/applications/1" || notices[0].Deprecation != "true" {
+		t.Errorf("Unexpected deprecation notice: %+v", notices[0])
+	}
+
+	// Repeated calls to the same endpoint are neither logged nor queued again
+	logOutput.Reset()
+	if _, err := client.GetApplication(context.Background(), 1); err != nil {
+		t.Fatalf("Expected success but got error: %v", err)
+	}
+	if strings.Contains(logOutput.String(), "[WARN]") {
+		t.Errorf("Expected deprecation to be logged only once, got: %s", logOutput.String())
+	}
+	if notices := client.DeprecationNotices(); len(notices) != 0 {
+		t.Errorf("Expected no new deprecation notices, got %+v", notices)
+	}
+
+	// Endpoints without the headers are not reported
+	if _, err := client.ListRuntimeVersions(context.Background()); err != nil {
+		t.Fatalf("Expected success but got error: %v", err)
+	}
+	if notices := client.DeprecationNotices(); len(notices) != 0 {
+		t.Errorf("Expected no deprecation notices, got %+v", notices)
+	}
+}
+
+// TestUserAgent tests that every request identifies the provider version and
+// Go runtime
+func TestUserAgent(t *testing.T) {
+	var userAgents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.Header.Get("User-Agent"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"id": 1, "name": "app", "application_type": "laravel"}}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	tests := []struct {
+		name     string
+		opts     []ClientOption
+		expected string
+	}{
+		{"default", nil, "terraform-provider-ploicloud/dev (" + runtime.Version() + ")"},
+		{"with version", []ClientOption{WithProviderVersion("1.4.0")}, "terraform-provider-ploicloud/1.4.0 (" + runtime.Version() + ")"},
+		{"empty version", []ClientOption{WithProviderVersion("")}, "terraform-provider-ploicloud/dev (" + runtime.Version() + ")"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userAgents = nil
+			client := NewClient("test-token", &server.URL, tt.opts...)
+
+			if _, err := client.GetApplication(ctx, 1); err != nil {
+				t.Fatalf("Expected success but got error: %v", err)
+			}
+			if _, err := client.UpdateApplication(ctx, 1, map[string]interface{}{"name": "app"}); err != nil {
+				t.Fatalf("Expected success but got error: %v", err)
+			}
+			if len(userAgents) != 2 || userAgents[0] != tt.expected || userAgents[1] != tt.expected {
+				t.Errorf("Expected every request to send %q, got %v", tt.expected, userAgents)
+			}
+		})
+	}
+}
+
+// TestExtraHeaders tests that configured headers are sent with every request
+// without replacing the headers the client sets itself
+func TestExtraHeaders(t *testing.T) {
+	var received []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Clone())
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"id": 1, "name": "app", "application_type": "laravel"}}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client := NewClient("test-token", &server.URL, WithProviderVersion("1.4.0"), WithExtraHeaders(map[string]string{
+		"X-Org-Id":      "acme",
+		"x-trace":       "on",
+		"authorization": "Bearer other-token",
+		"Content-Type":  "text/plain",
+		"ACCEPT":        "text/html",
+		"User-Agent":    "curl/8.0",
+	}))
+
+	if _, err := client.GetApplication(ctx, 1); err != nil {
+		t.Fatalf("Expected success but got error: %v", err)
+	}
+	if _, err := client.UpdateApplication(ctx, 1, map[string]interface{}{"name": "app"}); err != nil {
+		t.Fatalf("Expected success but got error: %v", err)
+	}
+	if len(received) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(received))
+	}
+
+	expected := map[string]string{
+		"X-Org-Id":      "acme",
+		"X-Trace":       "on",
+		"Authorization": "Bearer test-token",
+		"Content-Type":  "application/json",
+		"Accept":        "application/json",
+		"User-Agent":    userAgent("1.4.0"),
+	}
+	for _, headers := range received {
+		for name, value := range expected {
+			if got := headers.Get(name); got != value {
+				t.Errorf("Expected %s %q, got %q", name, value, got)
+			}
+		}
+	}
+}
@@ -1,7 +1,10 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -93,18 +96,27 @@ func TestServiceCreationWithValidation(t *testing.T) {
 			shouldFail:  true,
 		},
 		{
-			name: "API validation error response",
+			name: "unsupported version",
 			service: &ApplicationService{
 				ApplicationID: 1,
 				Type:          "postgresql",
 				Version:       "invalid-version",
 			},
+			expectedErr: "valid postgresql versions: 13, 14, 15, 16",
+			shouldFail:  true,
+		},
+		{
+			name: "API validation error response",
+			service: &ApplicationService{
+				ApplicationID: 1,
+				Type:          "postgresql",
+				Version:       "16",
+			},
 			shouldFail:   true,
 			responseCode: 422,
 			responseBody: `{
 				"message": "Validation failed",
 				"errors": {
-					"version": ["Version 'invalid-version' is not supported for PostgreSQL"],
 					"storage_size": ["Storage size is required for database services"]
 				}
 			}`,
@@ -137,7 +149,7 @@ func TestServiceCreationWithValidation(t *testing.T) {
 				client = NewClient("test-token", nil)
 			}
 
-			result, err := client.CreateService(tt.service)
+			result, err := client.CreateService(context.Background(), tt.service)
 
 			if tt.shouldFail {
 				if err == nil {
@@ -230,7 +242,7 @@ func TestEnhancedLoggingIntegration(t *testing.T) {
 				Type: "laravel",
 			}
 
-			_, err := client.CreateApplication(app)
+			_, err := client.CreateApplication(context.Background(), app)
 
 			if tt.statusCodes[len(tt.statusCodes)-1] >= 400 {
 				if err == nil {
@@ -318,16 +330,16 @@ func TestCompleteErrorHandlingWorkflow(t *testing.T) {
 			var err error
 			switch tt.operation {
 			case "create service":
-				_, err = client.CreateService(&ApplicationService{
+				_, err = client.CreateService(context.Background(), &ApplicationService{
 					ApplicationID: 1,
 					Type:          "mysql",
 				})
 			case "update application":
-				_, err = client.UpdateApplication(999, map[string]interface{}{"name": "updated"})
+				_, err = client.UpdateApplication(context.Background(), 999, map[string]interface{}{"name": "updated"})
 			case "delete application":
-				err = client.DeleteApplication(999)
+				err = client.DeleteApplication(context.Background(), 999)
 			case "create application":
-				_, err = client.CreateApplication(&Application{Name: "test", Type: "laravel"})
+				_, err = client.CreateApplication(context.Background(), &Application{Name: "test", Type: "laravel"})
 			}
 
 			if err == nil {
@@ -407,18 +419,18 @@ func TestVolumeReadOnlyFunctionality(t *testing.T) {
 			var err error
 			switch tt.method {
 			case "GET":
-				_, err = client.GetVolume(1, 1)
+				_, err = client.GetVolume(context.Background(), 1, 1)
 			case "POST":
-				_, err = client.CreateVolume(&ApplicationVolume{
+				_, err = client.CreateVolume(context.Background(), &ApplicationVolume{
 					ApplicationID: 1,
 					Name:          "test-volume",
 					Size:          10,
 					MountPath:     "/data",
 				})
 			case "PUT":
-				_, err = client.UpdateVolume(1, 1, &ApplicationVolume{Size: 20})
+				_, err = client.UpdateVolume(context.Background(), 1, 1, &ApplicationVolume{Size: 20, MountPath: "/data"})
 			case "DELETE":
-				err = client.DeleteVolume(1, 1)
+				err = client.DeleteVolume(context.Background(), 1, 1)
 			}
 
 			if tt.expectedCode >= 400 {
@@ -627,7 +639,7 @@ func TestClientNilSafety(t *testing.T) {
 			name: "nil client doRequest",
 			test: func() error {
 				var client *Client
-				_, err := client.doRequest("GET", "/test", nil)
+				_, err := client.doRequest(context.Background(), "GET", "/test", nil)
 				return err
 			},
 		},
@@ -640,7 +652,7 @@ func TestClientNilSafety(t *testing.T) {
 					httpClient:  nil,
 					logger:      &Logger{},
 				}
-				_, err := client.doRequest("GET", "/test", nil)
+				_, err := client.doRequest(context.Background(), "GET", "/test", nil)
 				return err
 			},
 		},
@@ -653,7 +665,7 @@ func TestClientNilSafety(t *testing.T) {
 					httpClient:  &http.Client{},
 					logger:      &Logger{},
 				}
-				_, err := client.doRequest("GET", "/test", nil)
+				_, err := client.doRequest(context.Background(), "GET", "/test", nil)
 				return err
 			},
 		},
@@ -666,7 +678,7 @@ func TestClientNilSafety(t *testing.T) {
 					httpClient:  &http.Client{},
 					logger:      &Logger{},
 				}
-				_, err := client.doRequest("GET", "/test", nil)
+				_, err := client.doRequest(context.Background(), "GET", "/test", nil)
 				return err
 			},
 		},
@@ -680,4 +692,1099 @@ func TestClientNilSafety(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+// TestRotateServiceCredentials tests the credential rotation endpoint
+func TestRotateServiceCredentials(t *testing.T) {
+	var requestedPath, requestedMethod string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		requestedMethod = r.Method
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"success": true, "data": {"host": "db.internal", "port": 5432, "username": "app", "password": "new-secret", "database": "app"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token", &server.URL)
+
+	connection, err := client.RotateServiceCredentials(context.Background(), 1, 2)
+	if err != nil {
+		t.Fatalf("Expected success but got error: %v", err)
+	}
+
+	if requestedMethod != "POST" || requestedPath != "/applications/1/services/2/rotate-credentials" {
+		t.Errorf("Unexpected request %s %s", requestedMethod, requestedPath)
+	}
+	if connection.Username != "app" || connection.Password != "new-secret" {
+		t.Errorf("Expected rotated credentials app/new-secret, got %s/%s", connection.Username, connection.Password)
+	}
+	if connection.Port != 5432 {
+		t.Errorf("Expected port 5432, got %d", connection.Port)
+	}
+}
+
+// TestListNetworks tests decoding of the network list
+func TestListNetworks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/networks" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [
+			{"id": 1, "name": "default", "region": "eu-west", "cidr": "10.0.0.0/16"},
+			{"id": 2, "name": "peered-rds", "region": "eu-west", "cidr": "10.1.0.0/16"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token", &server.URL)
+
+	networks, err := client.ListNetworks(context.Background())
+	if err != nil {
+		t.Fatalf("Expected success but got error: %v", err)
+	}
+	if len(networks) != 2 {
+		t.Fatalf("Expected 2 networks, got %d", len(networks))
+	}
+	if networks[1].Name != "peered-rds" || networks[1].CIDR != "10.1.0.0/16" {
+		t.Errorf("Unexpected network decoded: %+v", networks[1])
+	}
+}
+
+// TestListApplications tests walking every page of the application list
+func TestListApplications(t *testing.T) {
+	pages := map[string]string{
+		"1": `{"data": [{"id": 1, "name": "api", "application_type": "laravel"}, {"id": 2, "name": "blog", "application_type": "wordpress"}],
+			"meta": {"current_page": 1, "last_page": 2, "per_page": 2, "total": 3}}`,
+		"2": `{"data": [{"id": 3, "name": "shop", "application_type": "laravel"}],
+			"meta": {"current_page": 2, "last_page": 2, "per_page": 2, "total": 3}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := pages[r.URL.Query().Get("page")]
+		if r.URL.Path != "/applications" || r.URL.Query().Get("per_page") != "2" || !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token", &server.URL)
+
+	var names []string
+	for page := 1; ; page++ {
+		apps, pagination, err := client.ListApplications(context.Background(), page, 2)
+		if err != nil {
+			t.Fatalf("Expected success for page %d but got error: %v", page, err)
+		}
+		if pagination == nil {
+			t.Fatalf("Expected pagination for page %d", page)
+		}
+		if pagination.CurrentPage != page || pagination.LastPage != 2 || pagination.Total != 3 {
+			t.Errorf("Unexpected pagination for page %d: %+v", page, pagination)
+		}
+		for _, app := range apps {
+			names = append(names, app.Name)
+		}
+		if pagination.CurrentPage >= pagination.LastPage {
+			break
+		}
+	}
+
+	if strings.Join(names, ",") != "api,blog,shop" {
+		t.Errorf("Expected applications from every page, got %v", names)
+	}
+}
+
+// TestListApplications_WithoutPagination tests a list response without meta
+func TestListApplications_WithoutPagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [{"id": 1, "name": "api", "application_type": "laravel"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token", &server.URL)
+
+	apps, pagination, err := client.ListApplications(context.Background(), 1, 50)
+	if err != nil {
+		t.Fatalf("Expected success but got error: %v", err)
+	}
+	if pagination != nil {
+		t.Errorf("Expected nil pagination, got %+v", pagination)
+	}
+	if len(apps) != 1 || apps[0].Name != "api" {
+		t.Errorf("Unexpected applications decoded: %+v", apps)
+	}
+
+	if _, _, err := client.ListApplications(context.Background(), 0, 50); err == nil {
+		t.Error("Expected an error for page 0")
+	}
+}
+
+// TestGetApplicationReplicaMetrics tests decoding of replica usage samples
+func TestGetApplicationReplicaMetrics(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expectedCount int
+	}{
+		{
+			name: "samples for two replicas",
+			body: `{"data": [
+				{"pod": "app-7d9f-abc", "cpu_millicores": 120, "memory_bytes": 268435456, "restart_count": 1, "sampled_at": "2024-01-01T12:00:00Z"},
+				{"pod": "app-7d9f-def", "cpu_millicores": 80, "memory_bytes": 201326592, "sampled_at": "2024-01-01T12:00:00Z"}
+			]}`,
+			expectedCount: 2,
+		},
+		{
+			name:          "no metrics collected yet",
+			body:          `{"data": []}`,
+			expectedCount: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/applications/5/metrics/replicas" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if r.URL.Query().Get("window") != "300" {
+					t.Errorf("Expected window=300, got %q", r.URL.Query().Get("window"))
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewClient("test-token", &server.URL)
+
+			metrics, err := client.GetApplicationReplicaMetrics(context.Background(), 5)
+			if err != nil {
+				t.Fatalf("Expected success but got error: %v", err)
+			}
+			if len(metrics) != tt.expectedCount {
+				t.Fatalf("Expected %d samples, got %d", tt.expectedCount, len(metrics))
+			}
+			if tt.expectedCount > 0 {
+				first := metrics[0]
+				if first.Pod != "app-7d9f-abc" || first.CPUMillicores != 120 || first.MemoryBytes != 268435456 || first.RestartCount != 1 {
+					t.Errorf("Unexpected sample decoded: %+v", first)
+				}
+				if first.SampledAt.IsZero() {
+					t.Error("Expected sampled_at to be decoded")
+				}
+			}
+		})
+	}
+}
+
+// TestGetApplicationHTTPMetrics tests decoding of aggregated request metrics
+// and rejection of windows outside the supported bounds
+func TestGetApplicationHTTPMetrics(t *testing.T) {
+	tests := []struct {
+		name        string
+		window      int64
+		expectError bool
+		expectCall  bool
+	}{
+		{
+			name:       "default window",
+			window:     HTTPMetricsDefaultWindowSeconds,
+			expectCall: true,
+		},
+		{
+			name:       "maximum window",
+			window:     HTTPMetricsMaxWindowSeconds,
+			expectCall: true,
+		},
+		{
+			name:        "window below minimum",
+			window:      30,
+			expectError: true,
+		},
+		{
+			name:        "window above maximum",
+			window:      HTTPMetricsMaxWindowSeconds + 1,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				if r.URL.Path != "/applications/5/metrics/http" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if got := r.URL.Query().Get("window"); got != fmt.Sprintf("%d", tt.window) {
+					t.Errorf("Expected window=%d, got %q", tt.window, got)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"data": {"requests_per_second": 42.5, "p50_latency_ms": 18, "p99_latency_ms": 240.75, "error_rate": 0.012}}`))
+			}))
+			defer server.Close()
+
+			client := NewClient("test-token", &server.URL)
+
+			metrics, err := client.GetApplicationHTTPMetrics(context.Background(), 5, tt.window)
+			if called != tt.expectCall {
+				t.Errorf("Expected API call = %v, got %v", tt.expectCall, called)
+			}
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected success but got error: %v", err)
+			}
+			if metrics.RequestsPerSecond != 42.5 || metrics.P50LatencyMs != 18 || metrics.P99LatencyMs != 240.75 || metrics.ErrorRate != 0.012 {
+				t.Errorf("Unexpected metrics decoded: %+v", metrics)
+			}
+		})
+	}
+}
+
+// TestWaitForApplicationStatus tests polling until the application reaches
+// the target status, a failure status or the timeout
+func TestWaitForApplicationStatus(t *testing.T) {
+	tests := []struct {
+		name             string
+		statuses         []string
+		timeout          time.Duration
+		expectError      string
+		expectedStatus   string
+		expectedRequests int
+	}{
+		{
+			name:             "becomes running",
+			statuses:         []string{"creating", "deploying", "running"},
+			timeout:          time.Second,
+			expectedStatus:   "running",
+			expectedRequests: 3,
+		},
+		{
+			name:             "already running",
+			statuses:         []string{"running"},
+			timeout:          time.Second,
+			expectedStatus:   "running",
+			expectedRequests: 1,
+		},
+		{
+			name:             "deployment fails",
+			statuses:         []string{"deploying", "failed"},
+			timeout:          time.Second,
+			expectError:      "reached status 'failed'",
+			expectedStatus:   "failed",
+			expectedRequests: 2,
+		},
+		{
+			name:           "times out",
+			statuses:       []string{"deploying"},
+			timeout:        20 * time.Millisecond,
+			expectError:    "timed out",
+			expectedStatus: "deploying",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestCount := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/applications/7" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				// Stay at the last status once every status was returned
+				status := tt.statuses[min(requestCount, len(tt.statuses)-1)]
+				requestCount++
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"data": {"id": 7, "name": "app", "application_type": "laravel", "status": "%s"}}`, status)
+			}))
+			defer server.Close()
+
+			client := NewClient("test-token", &server.URL)
+			client.statusPollInterval = time.Millisecond
+
+			app, err := client.WaitForApplicationStatus(context.Background(), 7, "running", tt.timeout)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectError, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Expected success but got error: %v", err)
+			}
+			if app == nil || app.Status != tt.expectedStatus {
+				t.Fatalf("Expected last status %q, got %+v", tt.expectedStatus, app)
+			}
+			if tt.expectedRequests > 0 && requestCount != tt.expectedRequests {
+				t.Errorf("Expected %d requests, got %d", tt.expectedRequests, requestCount)
+			}
+		})
+	}
+
+	t.Run("application deleted", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client := NewClient("test-token", &server.URL)
+		if _, err := client.WaitForApplicationStatus(context.Background(), 7, "running", time.Second); err == nil || !strings.Contains(err.Error(), "not found") {
+			t.Errorf("Expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("timeout error records last status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"data": {"id": 7, "name": "app", "application_type": "laravel", "status": "building"}}`)
+		}))
+		defer server.Close()
+
+		client := NewClient("test-token", &server.URL)
+		client.statusPollInterval = time.Millisecond
+
+		_, err := client.WaitForApplicationStatus(context.Background(), 7, "running", 20*time.Millisecond)
+		var timeoutErr *StatusTimeoutError
+		if !errors.As(err, &timeoutErr) {
+			t.Fatalf("Expected *StatusTimeoutError, got %v", err)
+		}
+		if timeoutErr.LastStatus != "building" || timeoutErr.Target != "running" {
+			t.Errorf("Expected last status 'building' and target 'running', got %+v", timeoutErr)
+		}
+		if timeoutErr.Elapsed <= 0 || timeoutErr.Elapsed > time.Second {
+			t.Errorf("Expected elapsed time within the timeout, got %v", timeoutErr.Elapsed)
+		}
+	})
+}
+
+// TestWaitForApplicationStatus_ContextDeadline tests that an expiring context
+// stops polling before the wait timeout
+func TestWaitForApplicationStatus_ContextDeadline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data": {"id": 7, "name": "app", "application_type": "laravel", "status": "building"}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token", &server.URL)
+	client.statusPollInterval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	app, err := client.WaitForApplicationStatus(ctx, 7, "running", time.Minute)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected a deadline exceeded error, got %v", err)
+	}
+	if app == nil || app.Status != "building" {
+		t.Errorf("Expected the last application read to be returned, got %+v", app)
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("Expected polling to stop with the context, took %v", elapsed)
+	}
+}
+
+// TestWaitForDeployment tests polling a deployment until it finishes, fails
+// or the timeout passes
+func TestWaitForDeployment(t *testing.T) {
+	tests := []struct {
+		name             string
+		statuses         []string
+		timeout          time.Duration
+		expectError      string
+		expectedStatus   string
+		expectedRequests int
+	}{
+		{
+			name:             "succeeds",
+			statuses:         []string{"queued", "building", "success"},
+			timeout:          time.Second,
+			expectedStatus:   "success",
+			expectedRequests: 3,
+		},
+		{
+			name:             "fails",
+			statuses:         []string{"building", "failed"},
+			timeout:          time.Second,
+			expectError:      "failed",
+			expectedStatus:   "failed",
+			expectedRequests: 2,
+		},
+		{
+			name:           "times out",
+			statuses:       []string{"building"},
+			timeout:        20 * time.Millisecond,
+			expectError:    "timed out",
+			expectedStatus: "building",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestCount := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/applications/7/deployments/42" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				status := tt.statuses[min(requestCount, len(tt.statuses)-1)]
+				requestCount++
+				completedAt := "null"
+				if status == "success" || status == "failed" {
+					completedAt = `"2026-01-02T03:04:05Z"`
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"data": {"id": 42, "application_id": 7, "status": "%s", "completed_at": %s}}`, status, completedAt)
+			}))
+			defer server.Close()
+
+			client := NewClient("test-token", &server.URL)
+			client.statusPollInterval = time.Millisecond
+
+			deployment, err := client.WaitForDeployment(context.Background(), 7, 42, tt.timeout)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectError, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Expected success but got error: %v", err)
+			}
+			if deployment == nil || deployment.Status != tt.expectedStatus {
+				t.Fatalf("Expected last status %q, got %+v", tt.expectedStatus, deployment)
+			}
+			if deployment.Finished() != (deployment.CompletedAt != nil) {
+				t.Errorf("Expected completed_at to be set only for finished deployments, got %+v", deployment)
+			}
+			if tt.expectedRequests > 0 && requestCount != tt.expectedRequests {
+				t.Errorf("Expected %d requests, got %d", tt.expectedRequests, requestCount)
+			}
+		})
+	}
+
+	t.Run("deployment missing", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client := NewClient("test-token", &server.URL)
+		if _, err := client.WaitForDeployment(context.Background(), 7, 42, time.Second); err == nil || !strings.Contains(err.Error(), "not found") {
+			t.Errorf("Expected not found error, got %v", err)
+		}
+	})
+}
+
+// TestWaitForVolumeResize tests polling a volume until its resize finishes
+func TestWaitForVolumeResize(t *testing.T) {
+	tests := []struct {
+		name             string
+		statuses         []string
+		timeout          time.Duration
+		expectError      string
+		expectedStatus   string
+		expectedRequests int
+	}{
+		{
+			name:             "completes",
+			statuses:         []string{"pending", "resizing", "completed"},
+			timeout:          time.Second,
+			expectedStatus:   "completed",
+			expectedRequests: 3,
+		},
+		{
+			name:             "fails",
+			statuses:         []string{"resizing", "failed"},
+			timeout:          time.Second,
+			expectError:      "failed",
+			expectedStatus:   "failed",
+			expectedRequests: 2,
+		},
+		{
+			name:           "times out",
+			statuses:       []string{"resizing"},
+			timeout:        20 * time.Millisecond,
+			expectError:    "timed out",
+			expectedStatus: "resizing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestCount := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "GET" || r.URL.Path != "/applications/7/volumes/3" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				status := tt.statuses[min(requestCount, len(tt.statuses)-1)]
+				requestCount++
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"data": {"id": 3, "application_id": 7, "name": "uploads", "size": 20, "mount_path": "/data", "resize_status": "%s"}}`, status)
+			}))
+			defer server.Close()
+
+			client := NewClient("test-token", &server.URL)
+			client.statusPollInterval = time.Millisecond
+
+			volume, err := client.WaitForVolumeResize(context.Background(), 7, 3, tt.timeout)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectError, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Expected success but got error: %v", err)
+			}
+			if volume == nil || volume.ResizeStatus != tt.expectedStatus {
+				t.Fatalf("Expected last resize status %q, got %+v", tt.expectedStatus, volume)
+			}
+			if tt.expectedRequests > 0 && requestCount != tt.expectedRequests {
+				t.Errorf("Expected %d requests, got %d", tt.expectedRequests, requestCount)
+			}
+		})
+	}
+
+	t.Run("volume missing", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client := NewClient("test-token", &server.URL)
+		if _, err := client.WaitForVolumeResize(context.Background(), 7, 3, time.Second); err == nil || !strings.Contains(err.Error(), "not found") {
+			t.Errorf("Expected not found error, got %v", err)
+		}
+	})
+}
+
+// TestWaitForApplicationDeleted tests polling until the application is gone
+func TestWaitForApplicationDeleted(t *testing.T) {
+	tests := []struct {
+		name          string
+		existingPolls int
+		timeout       time.Duration
+		expectTimeout bool
+	}{
+		{"already gone", 0, time.Second, false},
+		{"gone after polling", 2, time.Second, false},
+		{"times out", 1000, 20 * time.Millisecond, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestCount := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestCount++
+				if requestCount > tt.existingPolls {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"data": {"id": 7, "name": "app", "application_type": "laravel", "status": "deleting"}}`)
+			}))
+			defer server.Close()
+
+			client := NewClient("test-token", &server.URL)
+			client.statusPollInterval = time.Millisecond
+
+			err := client.WaitForApplicationDeleted(context.Background(), 7, tt.timeout)
+			var timeoutErr *StatusTimeoutError
+			if tt.expectTimeout {
+				if !errors.As(err, &timeoutErr) || timeoutErr.LastStatus != "deleting" {
+					t.Fatalf("Expected timeout with last status 'deleting', got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected success but got error: %v", err)
+			}
+			if requestCount != tt.existingPolls+1 {
+				t.Errorf("Expected %d requests, got %d", tt.existingPolls+1, requestCount)
+			}
+		})
+	}
+}
+
+// TestGetServiceFallback tests that a service missing from the embedded
+// application list is fetched from its own endpoint
+func TestGetServiceFallback(t *testing.T) {
+	tests := []struct {
+		name         string
+		serviceID    int64
+		directStatus int
+		expectFound  bool
+		expectDirect bool
+		expectedType string
+	}{
+		{
+			name:         "found in embedded list",
+			serviceID:    10,
+			expectFound:  true,
+			expectedType: "mysql",
+		},
+		{
+			name:         "omitted from list but found directly",
+			serviceID:    42,
+			directStatus: http.StatusOK,
+			expectFound:  true,
+			expectDirect: true,
+			expectedType: "redis",
+		},
+		{
+			name:         "missing everywhere",
+			serviceID:    42,
+			directStatus: http.StatusNotFound,
+			expectDirect: true,
+		},
+		{
+			name:         "direct endpoint not supported",
+			serviceID:    42,
+			directStatus: http.StatusMethodNotAllowed,
+			expectDirect: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			directCalled := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/applications/1":
+					w.Write([]byte(`{"data": {"id": 1, "name": "app", "services": [{"id": 10, "type": "mysql", "status": "running"}]}}`))
+				case "/applications/1/services/42":
+					directCalled = true
+					w.WriteHeader(tt.directStatus)
+					if tt.directStatus == http.StatusOK {
+						w.Write([]byte(`{"data": {"id": 42, "type": "redis", "status": "running"}}`))
+					}
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			client := NewClient("test-token", &server.URL)
+
+			service, err := client.GetService(context.Background(), 1, tt.serviceID)
+			if err != nil {
+				t.Fatalf("Expected success but got error: %v", err)
+			}
+			if directCalled != tt.expectDirect {
+				t.Errorf("Expected direct fetch %v, got %v", tt.expectDirect, directCalled)
+			}
+			if (service != nil) != tt.expectFound {
+				t.Fatalf("Expected found %v, got %+v", tt.expectFound, service)
+			}
+			if service != nil {
+				if service.Type != tt.expectedType {
+					t.Errorf("Expected type %s, got %s", tt.expectedType, service.Type)
+				}
+				if service.ApplicationID != 1 {
+					t.Errorf("Expected application ID 1, got %d", service.ApplicationID)
+				}
+			}
+		})
+	}
+}
+
+func TestGetServiceDirect(t *testing.T) {
+	tests := []struct {
+		name         string
+		serviceID    int64
+		directStatus int
+		expectFound  bool
+		expectScan   bool
+		expectedType string
+	}{
+		{
+			name:         "found directly",
+			serviceID:    10,
+			directStatus: http.StatusOK,
+			expectFound:  true,
+			expectedType: "redis",
+		},
+		{
+			name:         "not found falls back to the application",
+			serviceID:    10,
+			directStatus: http.StatusNotFound,
+			expectFound:  true,
+			expectScan:   true,
+			expectedType: "mysql",
+		},
+		{
+			name:         "endpoint not supported falls back to the application",
+			serviceID:    10,
+			directStatus: http.StatusMethodNotAllowed,
+			expectFound:  true,
+			expectScan:   true,
+			expectedType: "mysql",
+		},
+		{
+			name:         "missing everywhere",
+			serviceID:    42,
+			directStatus: http.StatusNotFound,
+			expectScan:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scanned := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/applications/1":
+					scanned = true
+					w.Write([]byte(`{"data": {"id": 1, "name": "app", "services": [{"id": 10, "type": "mysql", "status": "running"}]}}`))
+				case fmt.Sprintf("/applications/1/services/%d", tt.serviceID):
+					w.WriteHeader(tt.directStatus)
+					if tt.directStatus == http.StatusOK {
+						fmt.Fprintf(w, `{"data": {"id": %d, "type": "redis", "status": "running"}}`, tt.serviceID)
+					}
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			client := NewClient("test-token", &server.URL)
+
+			service, err := client.GetServiceDirect(context.Background(), 1, tt.serviceID)
+			if err != nil {
+				t.Fatalf("Expected success but got error: %v", err)
+			}
+			if scanned != tt.expectScan {
+				t.Errorf("Expected application fetch %v, got %v", tt.expectScan, scanned)
+			}
+			if (service != nil) != tt.expectFound {
+				t.Fatalf("Expected found %v, got %+v", tt.expectFound, service)
+			}
+			if service != nil {
+				if service.Type != tt.expectedType {
+					t.Errorf("Expected type %s, got %s", tt.expectedType, service.Type)
+				}
+				if service.ApplicationID != 1 {
+					t.Errorf("Expected application ID 1, got %d", service.ApplicationID)
+				}
+			}
+		})
+	}
+}
+
+// TestDeployNotificationCRUD tests the deploy notification endpoints
+func TestDeployNotificationCRUD(t *testing.T) {
+	var lastBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/applications/7/notifications":
+			json.NewDecoder(r.Body).Decode(&lastBody)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data": {"id": 3, "application_id": 7, "url": "https://example.com/hook", "events": ["deploy.failed"], "secret": "********"}}`))
+		case r.Method == "GET" && r.URL.Path == "/applications/7/notifications/3":
+			w.Write([]byte(`{"data": {"id": 3, "application_id": 7, "url": "https://example.com/hook", "events": ["deploy.failed"]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/applications/7/notifications/3":
+			json.NewDecoder(r.Body).Decode(&lastBody)
+			w.Write([]byte(`{"data": {"id": 3, "application_id": 7, "url": "https://example.com/hook2", "events": ["deploy.failed"]}}`))
+		case r.Method == "DELETE" && r.URL.Path == "/applications/7/notifications/3":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient("test-token", &server.URL)
+
+	created, err := c.CreateDeployNotification(context.Background(), &DeployNotification{
+		ApplicationID: 7,
+		URL:           "https://example.com/hook",
+		Events:        []string{"deploy.failed"},
+		Secret:        "signing-key",
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if created.ID != 3 || lastBody["secret"] != "signing-key" {
+		t.Errorf("Unexpected create result %+v with body %v", created, lastBody)
+	}
+
+	read, err := c.GetDeployNotification(context.Background(), 7, 3)
+	if err != nil || read == nil || read.URL != "https://example.com/hook" {
+		t.Fatalf("Unexpected read result %+v, error %v", read, err)
+	}
+
+	missing, err := c.GetDeployNotification(context.Background(), 7, 99)
+	if err != nil || missing != nil {
+		t.Errorf("Expected nil for missing notification, got %+v, error %v", missing, err)
+	}
+
+	updated, err := c.UpdateDeployNotification(context.Background(), 7, 3, &DeployNotification{ApplicationID: 7, URL: "https://example.com/hook2", Events: []string{"deploy.failed"}})
+	if err != nil || updated.URL != "https://example.com/hook2" || lastBody["url"] != "https://example.com/hook2" {
+		t.Errorf("Unexpected update result %+v, error %v", updated, err)
+	}
+
+	if err := c.DeleteDeployNotification(context.Background(), 7, 3); err != nil {
+		t.Errorf("Delete failed: %v", err)
+	}
+
+	// Callers decide whether a missing notification counts as deleted
+	if err := c.DeleteDeployNotification(context.Background(), 7, 99); !IsNotFound(err) {
+		t.Errorf("Expected a not found error for a missing notification, got %v", err)
+	}
+}
+
+// TestServiceAlertCRUD tests managing the alerts of a service
+func TestServiceAlertCRUD(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/applications/7/services/3/alerts":
+			w.Write([]byte(`{"data": [{"id": 11, "metric": "connections", "threshold": 150, "comparison": "gt"}]}`))
+		case r.Method == "POST" && r.URL.Path == "/applications/7/services/3/alerts":
+			var alert ServiceAlert
+			if err := json.NewDecoder(r.Body).Decode(&alert); err != nil || alert.Metric != "disk_usage_percent" || alert.Threshold != 85.5 || alert.NotificationURL == "" {
+				t.Errorf("Unexpected alert payload: %+v (%v)", alert, err)
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data": {"id": 12, "metric": "disk_usage_percent", "threshold": 85.5, "comparison": "gte"}}`))
+		case r.Method == "PUT" && r.URL.Path == "/applications/7/services/3/alerts/11":
+			w.Write([]byte(`{"data": {"id": 11, "metric": "connections", "threshold": 200, "comparison": "gt"}}`))
+		case r.Method == "DELETE" && r.URL.Path == "/applications/7/services/3/alerts/11":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "Alert not found."}`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient("test-token", &server.URL)
+	ctx := context.Background()
+
+	alerts, err := c.ListServiceAlerts(ctx, 7, 3)
+	if err != nil {
+		t.Fatalf("ListServiceAlerts failed: %v", err)
+	}
+	if len(alerts) != 1 || alerts[0].ID != 11 || alerts[0].Threshold != 150 {
+		t.Errorf("Unexpected alerts: %+v", alerts)
+	}
+
+	created, err := c.CreateServiceAlert(ctx, 7, 3, &ServiceAlert{Metric: "disk_usage_percent", Threshold: 85.5, Comparison: "gte", NotificationURL: "https://example.com/hook?token=abc"})
+	if err != nil {
+		t.Fatalf("CreateServiceAlert failed: %v", err)
+	}
+	if created.ID != 12 || created.Comparison != "gte" {
+		t.Errorf("Unexpected created alert: %+v", created)
+	}
+
+	updated, err := c.UpdateServiceAlert(ctx, 7, 3, 11, &ServiceAlert{Metric: "connections", Threshold: 200, Comparison: "gt"})
+	if err != nil {
+		t.Fatalf("UpdateServiceAlert failed: %v", err)
+	}
+	if updated.Threshold != 200 {
+		t.Errorf("Expected threshold 200, got %v", updated.Threshold)
+	}
+
+	if err := c.DeleteServiceAlert(ctx, 7, 3, 11); err != nil {
+		t.Errorf("DeleteServiceAlert failed: %v", err)
+	}
+
+	err = c.DeleteServiceAlert(ctx, 7, 3, 99)
+	if !IsNotFound(err) || !strings.Contains(err.Error(), "failed to delete service alert: Alert not found.") {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+
+	if len(requests) != 5 {
+		t.Errorf("Expected 5 requests, got %v", requests)
+	}
+}
+
+// TestListRuntimeVersions tests reading the supported runtime versions
+func TestListRuntimeVersions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/runtime-versions" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"php": ["8.2", "8.3", "8.4", "8.5"], "nodejs": ["20", "22", "24"]}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient("test-token", &server.URL)
+
+	versions, err := c.ListRuntimeVersions(context.Background())
+	if err != nil {
+		t.Fatalf("ListRuntimeVersions failed: %v", err)
+	}
+	if len(versions.PHP) != 4 || versions.PHP[3] != "8.5" {
+		t.Errorf("Unexpected PHP versions: %v", versions.PHP)
+	}
+	if len(versions.NodeJS) != 3 || versions.NodeJS[0] != "20" {
+		t.Errorf("Unexpected Node.js versions: %v", versions.NodeJS)
+	}
+}
+
+// TestListRuntimeVersions_Error tests that API errors are surfaced
+func TestListRuntimeVersions_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"message": "Unauthenticated."}`))
+	}))
+	defer server.Close()
+
+	c := NewClient("test-token", &server.URL)
+
+	_, err := c.ListRuntimeVersions(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "failed to list runtime versions: Unauthenticated.") {
+		t.Errorf("Expected list runtime versions error, got %v", err)
+	}
+}
+
+// TestApplicationTemplates tests listing and reading application templates
+func TestApplicationTemplates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/application-templates":
+			w.Write([]byte(`{"data": [{"id": 1, "name": "laravel-small", "replicas": 1}, {"id": 2, "name": "laravel-large", "description": "High traffic Laravel", "replicas": 4, "cpu_request": "1", "memory_request": "2Gi", "scheduler_enabled": true}]}`))
+		case r.Method == "GET" && r.URL.Path == "/application-templates/2":
+			w.Write([]byte(`{"data": {"id": 2, "name": "laravel-large", "description": "High traffic Laravel", "health_check_path": "/up", "replicas": 4, "cpu_request": "1", "memory_request": "2Gi", "scheduler_enabled": true}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "Application template not found."}`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient("test-token", &server.URL)
+
+	templates, err := c.ListApplicationTemplates(context.Background())
+	if err != nil {
+		t.Fatalf("ListApplicationTemplates failed: %v", err)
+	}
+	if len(templates) != 2 || templates[1].Name != "laravel-large" || templates[1].Replicas != 4 {
+		t.Errorf("Unexpected templates: %+v", templates)
+	}
+
+	template, err := c.GetApplicationTemplate(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("GetApplicationTemplate failed: %v", err)
+	}
+	if template.HealthCheckPath != "/up" || template.MemoryRequest != "2Gi" || template.SchedulerEnabled == nil || !*template.SchedulerEnabled {
+		t.Errorf("Unexpected template: %+v", template)
+	}
+
+	_, err = c.GetApplicationTemplate(context.Background(), 9)
+	if !IsNotFound(err) || !strings.Contains(err.Error(), "failed to get application template: Application template not found.") {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
+
+// TestDeployApplicationAndBuildLogs tests triggering a deployment and reading its build logs
+func TestDeployApplicationAndBuildLogs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/applications/7/deploy":
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"data": {"id": 42, "application_id": 7, "status": "queued"}}`))
+		case r.Method == "POST" && r.URL.Path == "/applications/8/deploy":
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == "GET" && r.URL.Path == "/applications/7/deployments/42/build-logs":
+			w.Write([]byte(`{"data": {"status": "building", "lines": ["Cloning repository", "Installing dependencies"]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "Deployment not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient("test-token", &server.URL)
+
+	deployment, err := c.DeployApplication(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("DeployApplication failed: %v", err)
+	}
+	if deployment == nil || deployment.ID != 42 || deployment.Status != "queued" {
+		t.Fatalf("Unexpected deployment: %+v", deployment)
+	}
+
+	// An accepted deploy without a body has no deployment to follow
+	deployment, err = c.DeployApplication(context.Background(), 8)
+	if err != nil || deployment != nil {
+		t.Errorf("Expected nil deployment without error, got %+v, %v", deployment, err)
+	}
+
+	logs, err := c.GetBuildLogs(context.Background(), 7, 42)
+	if err != nil {
+		t.Fatalf("GetBuildLogs failed: %v", err)
+	}
+	if logs.Status != "building" || len(logs.Lines) != 2 || logs.Finished() {
+		t.Errorf("Unexpected build logs: %+v", logs)
+	}
+
+	if _, err := c.GetBuildLogs(context.Background(), 7, 99); err == nil || !strings.Contains(err.Error(), "failed to get build logs") {
+		t.Errorf("Expected get build logs error, got %v", err)
+	}
+}
+
+func TestGetDeploymentLogs(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		expected    string
+		expectError bool
+	}{
+		{name: "finished", status: http.StatusOK, body: "Cloning repository\nBuild finished\n", expected: "Cloning repository\nBuild finished\n"},
+		{name: "still running", status: http.StatusAccepted, body: "Cloning repository\n", expected: "Cloning repository\n"},
+		{name: "running without output", status: http.StatusAccepted},
+		{name: "no content", status: http.StatusNoContent},
+		{name: "no deployments", status: http.StatusNotFound, body: `{"message": "No deployments"}`},
+		{name: "server error", status: http.StatusBadRequest, body: `{"message": "Logs unavailable"}`, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/applications/7/deployments/latest/logs" {
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			c := NewClient("test-token", &server.URL)
+
+			logs, err := c.GetDeploymentLogs(context.Background(), 7)
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), "failed to get deployment logs") {
+					t.Errorf("Expected get deployment logs error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetDeploymentLogs failed: %v", err)
+			}
+			if logs != tt.expected {
+				t.Errorf("Expected logs %q, got %q", tt.expected, logs)
+			}
+		})
+	}
+}
@@ -6,58 +6,270 @@ import (
 )
 
 type Application struct {
-	ID                 int64               `json:"id,omitempty"`
-	Name               string              `json:"name"`
-	Type               string              `json:"application_type"`
-	ApplicationVersion string              `json:"application_version,omitempty"`
-	PHPVersion         string              `json:"php_version,omitempty"`
-	NodeJSVersion      string              `json:"nodejs_version,omitempty"`
-	BuildCommands      []string            `json:"build_commands,omitempty"`
-	InitCommands       []string            `json:"init_commands,omitempty"`
-	PHPExtensions      []string            `json:"php_extensions,omitempty"`
-	PHPSettings        []string            `json:"php_settings,omitempty"`
-	HealthCheckPath    string              `json:"health_check_path,omitempty"`
-	SchedulerEnabled   bool                `json:"scheduler_enabled,omitempty"`
-	Replicas           int64               `json:"replicas,omitempty"`
-	CPURequest         string              `json:"cpu_request,omitempty"`
-	MemoryRequest      string              `json:"memory_request,omitempty"`
-	StartCommand       string              `json:"start_command,omitempty"`
-	URL                string              `json:"url,omitempty"`
-	Status             string              `json:"status,omitempty"`
-	NeedsDeployment    bool                `json:"needs_deployment,omitempty"`
-	CustomManifests    string              `json:"custom_manifests,omitempty"`
-	RepositoryURL      string              `json:"repository_url,omitempty"`
-	RepositoryOwner    string              `json:"repository_owner,omitempty"`
-	RepositoryName     string              `json:"repository_name,omitempty"`
-	DefaultBranch      string              `json:"default_branch,omitempty"`
-	SocialAccountID    int64               `json:"social_account_id,omitempty"`
-	Region             string              `json:"region,omitempty"`
-	Provider           string              `json:"provider,omitempty"`
-	CreatedAt          time.Time           `json:"created_at,omitempty"`
-	UpdatedAt          time.Time           `json:"updated_at,omitempty"`
-	Domains            []ApplicationDomain `json:"domains,omitempty"`
-	Secrets            []ApplicationSecret `json:"secrets,omitempty"`
-	Services           []ApplicationService `json:"services,omitempty"`
-	Volumes            []ApplicationVolume  `json:"volumes,omitempty"`
+	ID                         int64                `json:"id,omitempty"`
+	Name                       string               `json:"name"`
+	Type                       string               `json:"application_type"`
+	ApplicationVersion         string               `json:"application_version,omitempty"`
+	PHPVersion                 string               `json:"php_version,omitempty"`
+	NodeJSVersion              string               `json:"nodejs_version,omitempty"`
+	BuildCommands              []string             `json:"build_commands,omitempty"`
+	BuildTimeoutSeconds        int64                `json:"build_timeout_seconds,omitempty"`
+	InitCommands               []string             `json:"init_commands,omitempty"`
+	InitCPURequest             string               `json:"init_cpu_request,omitempty"`
+	InitMemoryRequest          string               `json:"init_memory_request,omitempty"`
+	PHPExtensions              []string             `json:"php_extensions,omitempty"`
+	PHPSettings                []string             `json:"php_settings,omitempty"`
+	HealthCheckPath            string               `json:"health_check_path,omitempty"`
+	HealthCheckInterval        int64                `json:"health_check_interval,omitempty"`
+	HealthCheckTimeout         int64                `json:"health_check_timeout,omitempty"`
+	HealthCheckFailures        int64                `json:"health_check_failure_threshold,omitempty"`
+	SchedulerEnabled           bool                 `json:"scheduler_enabled,omitempty"`
+	Replicas                   int64                `json:"replicas,omitempty"`
+	CPURequest                 string               `json:"cpu_request,omitempty"`
+	MemoryRequest              string               `json:"memory_request,omitempty"`
+	SchedulerConcurrencyPolicy string               `json:"scheduler_concurrency_policy,omitempty"`
+	ScaleDownDrainSeconds      *int64               `json:"scale_down_drain_seconds,omitempty"`
+	OOMRestartPolicy           string               `json:"oom_restart_policy,omitempty"`
+	OOMScoreAdjust             *int64               `json:"oom_score_adjust,omitempty"`
+	SigtermTimeoutSeconds      *int64               `json:"sigterm_timeout_seconds,omitempty"`
+	MaxConcurrentRequests      int64                `json:"max_concurrent_requests,omitempty"`
+	StartCommand               string               `json:"start_command,omitempty"`
+	URL                        string               `json:"url,omitempty"`
+	Status                     string               `json:"status,omitempty"`
+	NeedsDeployment            bool                 `json:"needs_deployment,omitempty"`
+	CustomManifests            string               `json:"custom_manifests,omitempty"`
+	RepositoryURL              string               `json:"repository_url,omitempty"`
+	RepositoryOwner            string               `json:"repository_owner,omitempty"`
+	RepositoryName             string               `json:"repository_name,omitempty"`
+	DefaultBranch              string               `json:"default_branch,omitempty"`
+	SocialAccountID            int64                `json:"social_account_id,omitempty"`
+	Region                     string               `json:"region,omitempty"`
+	Provider                   string               `json:"provider,omitempty"`
+	LogLevel                   string               `json:"log_level,omitempty"`
+	BuildCache                 *BuildCache          `json:"build_cache,omitempty"`
+	NetworkID                  int64                `json:"network_id,omitempty"`
+	TemplateID                 int64                `json:"template_id,omitempty"`
+	Sidecars                   []Sidecar            `json:"sidecars,omitempty"`
+	HostAliases                []HostAlias          `json:"host_aliases,omitempty"`
+	Processes                  []Process            `json:"processes,omitempty"`
+	Canary                     *Canary              `json:"canary,omitempty"`
+	AutoSleep                  *AutoSleep           `json:"auto_sleep,omitempty"`
+	Egress                     *Egress              `json:"egress,omitempty"`
+	EgressIP                   string               `json:"egress_ip,omitempty"`
+	Cluster                    string               `json:"cluster,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	InternalHostname           string               `json:"internal_hostname,omitempty"`
+	DeployedCommitSHA          string               `json:"deployed_commit_sha,omitempty"`
+	DeployedCommitMessage      string               `json:"deployed_commit_message,omitempty"`
+	MaintenanceWindow          *MaintenanceWindow   `json:"maintenance_window,omitempty"`
+	BasicAuth                  *BasicAuth           `json:"basic_auth,omitempty"`
+	Headers                    *HeaderRules         `json:"headers,omitempty"`
+	Compression                *Compression         `json:"compression,omitempty"`
+	Ingress                    *IngressConfig       `json:"ingress,omitempty"`
+	Lifecycle                  *Lifecycle           `json:"lifecycle,omitempty"`
+	CreatedAt                  time.Time            `json:"created_at,omitempty"`
+	UpdatedAt                  time.Time            `json:"updated_at,omitempty"`
+	Domains                    []ApplicationDomain  `json:"domains,omitempty"`
+	Secrets                    []ApplicationSecret  `json:"secrets,omitempty"`
+	Services                   []ApplicationService `json:"services,omitempty"`
+	Volumes                    []ApplicationVolume  `json:"volumes,omitempty"`
+}
+
+// IngressConfig restricts which client addresses can reach the application
+// and which HTTP protocol versions are served
+type IngressConfig struct {
+	AllowCIDRs   []string `json:"allow_cidrs,omitempty"`
+	DenyCIDRs    []string `json:"deny_cidrs,omitempty"`
+	HTTP2Enabled *bool    `json:"http2_enabled,omitempty"`
+	HTTP3Enabled *bool    `json:"http3_enabled,omitempty"`
+
+	// PathPrefix routes only requests below the prefix, e.g. "/api", to the
+	// application and StripPrefix removes it before forwarding. An empty
+	// prefix removes the path routing.
+	PathPrefix  *string `json:"path_prefix,omitempty"`
+	StripPrefix *bool   `json:"strip_prefix,omitempty"`
+
+	// DeregistrationDelaySeconds is how long replicas being replaced by a
+	// deploy stay registered at the load balancer before they terminate
+	DeregistrationDelaySeconds *int64 `json:"deregistration_delay_seconds,omitempty"`
+
+	// ErrorPages maps an HTTP status code such as "503" to the page served for it
+	ErrorPages map[string]ErrorPage `json:"error_pages,omitempty"`
+}
+
+// Lifecycle holds the container lifecycle hooks: commands run right after a
+// container starts and before it is stopped. An empty command removes the hook.
+type Lifecycle struct {
+	PostStartCommand string `json:"post_start_command"`
+	PreStopCommand   string `json:"pre_stop_command"`
+}
+
+// ErrorPage is a custom error page served at the ingress, either inline
+// content or a URL the page is fetched from
+type ErrorPage struct {
+	Content string `json:"content,omitempty"`
+	URL     string `json:"url,omitempty"`
+}
+
+// Compression configures response compression at the ingress
+type Compression struct {
+	Enabled      bool     `json:"enabled"`
+	Types        []string `json:"types,omitempty"`
+	MinSizeBytes int64    `json:"min_size_bytes,omitempty"`
+}
+
+// HeaderRules rewrites request/response headers at the ingress
+type HeaderRules struct {
+	Add    map[string]string `json:"add,omitempty"`
+	Set    map[string]string `json:"set,omitempty"`
+	Remove []string          `json:"remove,omitempty"`
+}
+
+// BasicAuth protects the application ingress with HTTP basic auth.
+// The API never returns the password, only a hash of "username:password".
+type BasicAuth struct {
+	Enabled   bool   `json:"enabled"`
+	Username  string `json:"username,omitempty"`
+	Password  string `json:"password,omitempty"`
+	ValueHash string `json:"value_hash,omitempty"`
+}
+
+// MaintenanceWindow is the preferred time for disruptive platform maintenance
+type MaintenanceWindow struct {
+	DayOfWeek       string `json:"day_of_week"`
+	StartTime       string `json:"start_time"`
+	DurationMinutes int64  `json:"duration_minutes"`
+	Timezone        string `json:"timezone"`
+}
+
+// Egress configures outbound traffic of the application
+type Egress struct {
+	StaticIP bool `json:"static_ip"`
+}
+
+// Canary shifts a share of traffic to a new release while it stays healthy
+type Canary struct {
+	Enabled             bool  `json:"enabled"`
+	TrafficPercentage   int64 `json:"traffic_percentage"`
+	PromoteAfterSeconds int64 `json:"promote_after_seconds,omitempty"`
+}
+
+// AutoSleep scales an idle application to zero and wakes it on the next request
+type AutoSleep struct {
+	Enabled            bool  `json:"enabled"`
+	IdleTimeoutMinutes int64 `json:"idle_timeout_minutes,omitempty"`
+}
+
+// Sidecar is an additional container running alongside the application
+type Sidecar struct {
+	Name          string `json:"name"`
+	Image         string `json:"image"`
+	Command       string `json:"command,omitempty"`
+	CPURequest    string `json:"cpu_request,omitempty"`
+	MemoryRequest string `json:"memory_request,omitempty"`
+}
+
+// Process is a Procfile-style process type of an application (e.g. web,
+// worker, release), scaled independently of the others
+type Process struct {
+	Name          string `json:"name"`
+	Command       string `json:"command"`
+	Replicas      int64  `json:"replicas,omitempty"`
+	CPURequest    string `json:"cpu_request,omitempty"`
+	MemoryRequest string `json:"memory_request,omitempty"`
+}
+
+// HostAlias resolves Hostname to IP inside the application containers, like
+// an /etc/hosts entry
+type HostAlias struct {
+	Hostname string `json:"hostname"`
+	IP       string `json:"ip"`
+}
+
+// BuildCache controls reuse of the image build cache across deploys
+type BuildCache struct {
+	Enabled bool   `json:"enabled"`
+	Key     string `json:"key,omitempty"`
 }
 
 type ApplicationService struct {
-	ID              int64             `json:"id,omitempty"`
-	ApplicationID   int64             `json:"application_id"`
-	Name            string            `json:"name,omitempty"`
-	Type            string            `json:"type"`
-	Version         string            `json:"version,omitempty"`
-	Status          string            `json:"status,omitempty"`
-	Settings        FlexibleSettings  `json:"settings,omitempty"`
-	Command         string            `json:"command,omitempty"`
-	Replicas        int64             `json:"replicas,omitempty"`
-	CPURequest      string            `json:"cpu_request,omitempty"`
-	MemoryRequest   string            `json:"memory_request,omitempty"`
-	StorageSize     string            `json:"storage_size,omitempty"`
-	Extensions      []string          `json:"extensions,omitempty"`
-	DebugAccessPort int64             `json:"debug_access_port,omitempty"`
-	CreatedAt       time.Time         `json:"created_at,omitempty"`
-	UpdatedAt       time.Time         `json:"updated_at,omitempty"`
+	ID                   int64               `json:"id,omitempty"`
+	ApplicationID        int64               `json:"application_id"`
+	Name                 string              `json:"name,omitempty"`
+	Type                 string              `json:"type"`
+	Version              string              `json:"version,omitempty"`
+	Status               string              `json:"status,omitempty"`
+	Settings             FlexibleSettings    `json:"settings,omitempty"`
+	Command              string              `json:"command,omitempty"`
+	Replicas             int64               `json:"replicas,omitempty"`
+	CPURequest           string              `json:"cpu_request,omitempty"`
+	MemoryRequest        string              `json:"memory_request,omitempty"`
+	StorageSize          string              `json:"storage_size,omitempty"`
+	Extensions           []string            `json:"extensions,omitempty"`
+	DebugAccessPort      int64               `json:"debug_access_port,omitempty"`
+	Connection           *ServiceConnection  `json:"connection,omitempty"`
+	ConnectionPooling    *ConnectionPooling  `json:"connection_pooling,omitempty"`
+	Backup               *ServiceBackup      `json:"backup,omitempty"`
+	TLS                  *ServiceTLS         `json:"tls,omitempty"`
+	Autoscaling          *ServiceAutoscaling `json:"autoscaling,omitempty"`
+	Maintenance          *ServiceMaintenance `json:"maintenance,omitempty"`
+	ReadReplicas         *ReadReplicas       `json:"read_replicas,omitempty"`
+	ReadReplicaEndpoints []string            `json:"read_replica_endpoints,omitempty"`
+	CreatedAt            time.Time           `json:"created_at,omitempty"`
+	UpdatedAt            time.Time           `json:"updated_at,omitempty"`
+}
+
+// ReadReplicas configures read-only replicas of a PostgreSQL or MySQL service
+type ReadReplicas struct {
+	Count         int64  `json:"count"`
+	CPURequest    string `json:"cpu_request,omitempty"`
+	MemoryRequest string `json:"memory_request,omitempty"`
+}
+
+// ConnectionPooling configures a pgbouncer-style connection pooler in front of a PostgreSQL service
+type ConnectionPooling struct {
+	Enabled  bool   `json:"enabled"`
+	Mode     string `json:"mode,omitempty"`
+	PoolSize int64  `json:"pool_size,omitempty"`
+}
+
+// ServiceMaintenance schedules database maintenance tasks of a PostgreSQL service
+type ServiceMaintenance struct {
+	VacuumSchedule  string `json:"vacuum_schedule,omitempty"`
+	AnalyzeSchedule string `json:"analyze_schedule,omitempty"`
+}
+
+// ServiceBackup configures backups of a service
+type ServiceBackup struct {
+	EncryptionEnabled bool   `json:"encryption_enabled"`
+	EncryptionMode    string `json:"encryption_mode,omitempty"`
+	KMSKey            string `json:"kms_key,omitempty"`
+	PITRRetentionDays int64  `json:"pitr_retention_days,omitempty"`
+}
+
+// ServiceTLS enforces TLS on client connections to a database service
+type ServiceTLS struct {
+	Enabled bool   `json:"enabled"`
+	Mode    string `json:"mode,omitempty"`
+	CACert  string `json:"ca_cert,omitempty"`
+}
+
+// ServiceAutoscaling scales the replicas of a service between Min and Max to
+// keep CPU usage around TargetCPU percent
+type ServiceAutoscaling struct {
+	Enabled   bool  `json:"enabled"`
+	Min       int64 `json:"min,omitempty"`
+	Max       int64 `json:"max,omitempty"`
+	TargetCPU int64 `json:"target_cpu,omitempty"`
+}
+
+// ServiceConnection holds the connection credentials of a service
+type ServiceConnection struct {
+	Host     string `json:"host,omitempty"`
+	Port     int64  `json:"port,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Database string `json:"database,omitempty"`
 }
 
 // FlexibleSettings can handle both map[string]string and empty arrays from the API
@@ -107,25 +319,50 @@ type ApplicationDomain struct {
 }
 
 type ApplicationSecret struct {
-	ApplicationID int64     `json:"application_id"`
-	Key           string    `json:"key"`
-	Value         string    `json:"value"`
-	CreatedAt     time.Time `json:"created_at,omitempty"`
-	UpdatedAt     time.Time `json:"updated_at,omitempty"`
+	ApplicationID   int64     `json:"application_id"`
+	Key             string    `json:"key"`
+	Value           string    `json:"value"`
+	Scope           string    `json:"scope,omitempty"`
+	EncryptedAtRest *bool     `json:"encrypted_at_rest,omitempty"`
+	CreatedAt       time.Time `json:"created_at,omitempty"`
+	UpdatedAt       time.Time `json:"updated_at,omitempty"`
 }
 
-type ApplicationVolume struct {
+// DeployNotification is a webhook called on deployment events of an application
+// ServiceAlert notifies a URL when a service metric crosses a threshold
+type ServiceAlert struct {
+	ID              int64   `json:"id,omitempty"`
+	Metric          string  `json:"metric"`
+	Threshold       float64 `json:"threshold"`
+	Comparison      string  `json:"comparison"`
+	NotificationURL string  `json:"notification_url,omitempty"`
+}
+
+type DeployNotification struct {
 	ID            int64     `json:"id,omitempty"`
 	ApplicationID int64     `json:"application_id"`
-	Name          string    `json:"name"`
-	Size          int64     `json:"size"`
-	MountPath     string    `json:"path"`
-	ResizeStatus  string    `json:"resize_status,omitempty"`
-	StorageClass  string    `json:"storage_class,omitempty"`
+	URL           string    `json:"url"`
+	Events        []string  `json:"events"`
+	Secret        string    `json:"secret,omitempty"`
 	CreatedAt     time.Time `json:"created_at,omitempty"`
 	UpdatedAt     time.Time `json:"updated_at,omitempty"`
 }
 
+type ApplicationVolume struct {
+	ID             int64     `json:"id,omitempty"`
+	ApplicationID  int64     `json:"application_id"`
+	Name           string    `json:"name"`
+	Size           int64     `json:"size"`
+	MountPath      string    `json:"path"`
+	ResizeStatus   string    `json:"resize_status,omitempty"`
+	StorageClass   string    `json:"storage_class,omitempty"`
+	ReclaimPolicy  string    `json:"reclaim_policy,omitempty"`
+	IOPS           int64     `json:"iops,omitempty"`
+	ThroughputMBps int64     `json:"throughput_mbps,omitempty"`
+	CreatedAt      time.Time `json:"created_at,omitempty"`
+	UpdatedAt      time.Time `json:"updated_at,omitempty"`
+}
+
 type Worker struct {
 	ID            int64     `json:"id,omitempty"`
 	ApplicationID int64     `json:"application_id"`
@@ -140,6 +377,86 @@ type Worker struct {
 	UpdatedAt     time.Time `json:"updated_at,omitempty"`
 }
 
+// Network is a private network that applications can be attached to
+// Deployment is a single deploy of an application
+type Deployment struct {
+	ID            int64     `json:"id"`
+	ApplicationID int64     `json:"application_id,omitempty"`
+	Status        string    `json:"status,omitempty"`
+	CreatedAt     time.Time `json:"created_at,omitempty"`
+	// CompletedAt is only set once the deployment has finished
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// Finished reports whether the deployment has reached a terminal status
+func (d *Deployment) Finished() bool {
+	switch d.Status {
+	case "success", "finished", "failed", "cancelled":
+		return true
+	}
+	return false
+}
+
+// BuildLogs holds the build output of a deployment
+type BuildLogs struct {
+	Status string   `json:"status"`
+	Lines  []string `json:"lines"`
+}
+
+// Finished reports whether the build has reached a terminal status
+func (b *BuildLogs) Finished() bool {
+	switch b.Status {
+	case "success", "finished", "failed", "cancelled":
+		return true
+	}
+	return false
+}
+
+// RuntimeVersions lists the language runtime versions applications can use
+type RuntimeVersions struct {
+	PHP    []string `json:"php"`
+	NodeJS []string `json:"nodejs"`
+}
+
+// ApplicationTemplate is a platform-defined base configuration applications
+// can inherit. Empty values leave the application's own value in place.
+type ApplicationTemplate struct {
+	ID               int64  `json:"id"`
+	Name             string `json:"name"`
+	Description      string `json:"description,omitempty"`
+	HealthCheckPath  string `json:"health_check_path,omitempty"`
+	SchedulerEnabled *bool  `json:"scheduler_enabled,omitempty"`
+	Replicas         int64  `json:"replicas,omitempty"`
+	CPURequest       string `json:"cpu_request,omitempty"`
+	MemoryRequest    string `json:"memory_request,omitempty"`
+}
+
+type Network struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	Region    string    `json:"region,omitempty"`
+	CIDR      string    `json:"cidr,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// ReplicaMetric is a single resource usage sample for one application replica
+type ReplicaMetric struct {
+	Pod           string    `json:"pod"`
+	CPUMillicores int64     `json:"cpu_millicores"`
+	MemoryBytes   int64     `json:"memory_bytes"`
+	RestartCount  int64     `json:"restart_count,omitempty"`
+	SampledAt     time.Time `json:"sampled_at,omitempty"`
+}
+
+// HTTPMetrics holds the aggregated request metrics of an application over a time window
+type HTTPMetrics struct {
+	RequestsPerSecond float64 `json:"requests_per_second"`
+	P50LatencyMs      float64 `json:"p50_latency_ms"`
+	P99LatencyMs      float64 `json:"p99_latency_ms"`
+	ErrorRate         float64 `json:"error_rate"`
+}
+
 type Team struct {
 	ID        int64     `json:"id,omitempty"`
 	Name      string    `json:"name"`
@@ -160,6 +477,33 @@ type ListResponse[T any] struct {
 	Meta    map[string]interface{} `json:"meta,omitempty"`
 }
 
+// Pagination describes the page of a paginated list response
+type Pagination struct {
+	CurrentPage int `json:"current_page"`
+	LastPage    int `json:"last_page"`
+	Total       int `json:"total"`
+}
+
+// paginationFromMeta reads the pagination fields from the meta object of a
+// list response. It returns nil when the API did not include them.
+func paginationFromMeta(meta map[string]interface{}) *Pagination {
+	currentPage, ok := meta["current_page"].(float64)
+	if !ok {
+		return nil
+	}
+	lastPage, ok := meta["last_page"].(float64)
+	if !ok {
+		return nil
+	}
+	total, _ := meta["total"].(float64)
+
+	return &Pagination{
+		CurrentPage: int(currentPage),
+		LastPage:    int(lastPage),
+		Total:       int(total),
+	}
+}
+
 type SingleResponse[T any] struct {
 	Success bool    `json:"success,omitempty"`
 	Message *string `json:"message,omitempty"`
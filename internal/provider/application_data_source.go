@@ -30,6 +30,13 @@ type ApplicationDataSourceModel struct {
 	NeedsDeployment    types.Bool   `tfsdk:"needs_deployment"`
 	Region             types.String `tfsdk:"region"`
 	CloudProvider      types.String `tfsdk:"cloud_provider"`
+	ServiceIDs         types.List   `tfsdk:"service_ids"`
+	VolumeIDs          types.List   `tfsdk:"volume_ids"`
+	DomainIDs          types.List   `tfsdk:"domain_ids"`
+	SecretKeys         types.List   `tfsdk:"secret_keys"`
+
+	Runtime  *RuntimeModel  `tfsdk:"runtime"`
+	Settings *SettingsModel `tfsdk:"settings"`
 }
 
 func (d *ApplicationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -77,6 +84,106 @@ func (d *ApplicationDataSource) Schema(ctx context.Context, req datasource.Schem
 				Computed:            true,
 				MarkdownDescription: "Cloud provider",
 			},
+			"service_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "IDs of the application's services, importable as `<id>.<service_id>`",
+			},
+			"volume_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "IDs of the application's volumes, importable as `<id>.<volume_id>`",
+			},
+			"domain_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "IDs of the application's domains, importable as `<id>.<domain_id>`",
+			},
+			"secret_keys": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Keys of the application's secrets, importable as `<id>.<secret_key>`",
+			},
+			"runtime": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Runtime versions",
+				Attributes: map[string]schema.Attribute{
+					"php_version": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "PHP version",
+					},
+					"nodejs_version": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Node.js version",
+					},
+				},
+			},
+			"settings": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Application settings",
+				Attributes: map[string]schema.Attribute{
+					"health_check_path": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Health check endpoint path",
+					},
+					"health_check_interval": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Seconds between health checks",
+					},
+					"health_check_timeout": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Seconds a health check may take before it counts as failed",
+					},
+					"health_check_failure_threshold": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Consecutive failed health checks before a replica is considered unhealthy",
+					},
+					"scheduler_enabled": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether the Laravel scheduler is enabled",
+					},
+					"replicas": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Number of replicas",
+					},
+					"cpu_request": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "CPU request",
+					},
+					"memory_request": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Memory request",
+					},
+					"scheduler_concurrency_policy": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "How overlapping scheduler runs are handled",
+					},
+					"scale_down_drain_seconds": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Seconds a replica keeps draining connections before it is removed on scale down",
+					},
+					"oom_restart_policy": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "What happens when a container runs out of memory",
+					},
+					"oom_score_adjust": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Linux OOM score adjustment for the application processes",
+					},
+					"sigterm_timeout_seconds": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Seconds the application gets to exit after SIGTERM before it is killed",
+					},
+					"max_concurrent_requests": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Maximum number of in-flight requests per replica",
+					},
+					"deregistration_delay_seconds": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Seconds replicas being replaced by a deploy stay registered at the load balancer",
+					},
+				},
+			},
 		},
 	}
 }
@@ -99,6 +206,8 @@ func (d *ApplicationDataSource) Configure(ctx context.Context, req datasource.Co
 }
 
 func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, d.client, &resp.Diagnostics)
+
 	var data ApplicationDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -106,7 +215,7 @@ func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	app, err := d.client.GetApplication(data.ID.ValueInt64())
+	app, err := d.client.GetApplication(ctx, data.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application, got error: %s", err))
 		return
@@ -117,6 +226,12 @@ func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
+	d.fromAPIModel(app, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ApplicationDataSource) fromAPIModel(app *client.Application, data *ApplicationDataSourceModel) {
 	data.ID = types.Int64Value(app.ID)
 	data.Name = types.StringValue(app.Name)
 	data.Type = types.StringValue(app.Type)
@@ -127,5 +242,51 @@ func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	data.Region = types.StringValue(app.Region)
 	data.CloudProvider = types.StringValue(app.Provider)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
\ No newline at end of file
+	data.Runtime = &RuntimeModel{
+		PHPVersion:    types.StringValue(app.PHPVersion),
+		NodeJSVersion: types.StringValue(app.NodeJSVersion),
+	}
+	data.Settings = &SettingsModel{
+		HealthCheckPath:            types.StringValue(app.HealthCheckPath),
+		HealthCheckInterval:        types.Int64Value(app.HealthCheckInterval),
+		HealthCheckTimeout:         types.Int64Value(app.HealthCheckTimeout),
+		HealthCheckFailures:        types.Int64Value(app.HealthCheckFailures),
+		SchedulerEnabled:           types.BoolValue(app.SchedulerEnabled),
+		Replicas:                   types.Int64Value(app.Replicas),
+		CPURequest:                 types.StringValue(app.CPURequest),
+		MemoryRequest:              types.StringValue(app.MemoryRequest),
+		SchedulerConcurrencyPolicy: types.StringValue(app.SchedulerConcurrencyPolicy),
+		ScaleDownDrainSeconds:      types.Int64PointerValue(app.ScaleDownDrainSeconds),
+		OOMRestartPolicy:           types.StringValue(app.OOMRestartPolicy),
+		OOMScoreAdjust:             types.Int64PointerValue(app.OOMScoreAdjust),
+		SigtermTimeoutSeconds:      types.Int64PointerValue(app.SigtermTimeoutSeconds),
+		MaxConcurrentRequests:      types.Int64Value(app.MaxConcurrentRequests),
+		DeregistrationDelaySeconds: types.Int64Null(),
+	}
+	if app.Ingress != nil {
+		data.Settings.DeregistrationDelaySeconds = types.Int64PointerValue(app.Ingress.DeregistrationDelaySeconds)
+	}
+
+	// Sub-resource inventory, empty lists rather than null so they can be iterated
+	serviceIDs := make([]int64, 0, len(app.Services))
+	for _, service := range app.Services {
+		serviceIDs = append(serviceIDs, service.ID)
+	}
+	volumeIDs := make([]int64, 0, len(app.Volumes))
+	for _, volume := range app.Volumes {
+		volumeIDs = append(volumeIDs, volume.ID)
+	}
+	domainIDs := make([]int64, 0, len(app.Domains))
+	for _, domain := range app.Domains {
+		domainIDs = append(domainIDs, domain.ID)
+	}
+	secretKeys := make([]string, 0, len(app.Secrets))
+	for _, secret := range app.Secrets {
+		secretKeys = append(secretKeys, secret.Key)
+	}
+
+	data.ServiceIDs, _ = types.ListValueFrom(context.Background(), types.Int64Type, serviceIDs)
+	data.VolumeIDs, _ = types.ListValueFrom(context.Background(), types.Int64Type, volumeIDs)
+	data.DomainIDs, _ = types.ListValueFrom(context.Background(), types.Int64Type, domainIDs)
+	data.SecretKeys, _ = types.ListValueFrom(context.Background(), types.StringType, secretKeys)
+}
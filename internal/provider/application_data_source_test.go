@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+func TestApplicationDataSource_SubResourceInventory(t *testing.T) {
+	d := &ApplicationDataSource{}
+
+	tests := []struct {
+		name               string
+		app                *client.Application
+		expectedServiceIDs []int64
+		expectedVolumeIDs  []int64
+		expectedDomainIDs  []int64
+		expectedSecretKeys []string
+	}{
+		{
+			name: "application with sub-resources",
+			app: &client.Application{
+				ID:       12,
+				Name:     "shop",
+				Services: []client.ApplicationService{{ID: 30, Type: "mysql"}, {ID: 31, Type: "redis"}},
+				Volumes:  []client.ApplicationVolume{{ID: 40, Name: "storage"}},
+				Domains:  []client.ApplicationDomain{{ID: 50, Domain: "shop.example.com"}},
+				Secrets:  []client.ApplicationSecret{{Key: "APP_KEY"}, {Key: "STRIPE_SECRET"}},
+			},
+			expectedServiceIDs: []int64{30, 31},
+			expectedVolumeIDs:  []int64{40},
+			expectedDomainIDs:  []int64{50},
+			expectedSecretKeys: []string{"APP_KEY", "STRIPE_SECRET"},
+		},
+		{
+			name:               "application without sub-resources",
+			app:                &client.Application{ID: 13, Name: "empty"},
+			expectedServiceIDs: []int64{},
+			expectedVolumeIDs:  []int64{},
+			expectedDomainIDs:  []int64{},
+			expectedSecretKeys: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data ApplicationDataSourceModel
+			d.fromAPIModel(tt.app, &data)
+
+			assertInt64List(t, "service_ids", data.ServiceIDs, tt.expectedServiceIDs)
+			assertInt64List(t, "volume_ids", data.VolumeIDs, tt.expectedVolumeIDs)
+			assertInt64List(t, "domain_ids", data.DomainIDs, tt.expectedDomainIDs)
+
+			expectedKeys, _ := types.ListValueFrom(context.Background(), types.StringType, tt.expectedSecretKeys)
+			if !data.SecretKeys.Equal(expectedKeys) {
+				t.Errorf("Expected secret_keys %v, got %v", expectedKeys, data.SecretKeys)
+			}
+		})
+	}
+}
+
+func assertInt64List(t *testing.T, name string, actual types.List, expected []int64) {
+	t.Helper()
+
+	expectedList, _ := types.ListValueFrom(context.Background(), types.Int64Type, expected)
+	if !actual.Equal(expectedList) {
+		t.Errorf("Expected %s %v, got %v", name, expectedList, actual)
+	}
+}
+
+func TestApplicationDataSource_RuntimeAndSettings(t *testing.T) {
+	d := &ApplicationDataSource{}
+	oomScoreAdjust, deregistrationDelay, drain := int64(-500), int64(15), int64(30)
+
+	var data ApplicationDataSourceModel
+	d.fromAPIModel(&client.Application{
+		ID:                         12,
+		Name:                       "shop",
+		Type:                       "laravel",
+		URL:                        "https://shop.ploi.cloud",
+		Status:                     "running",
+		PHPVersion:                 "8.4",
+		NodeJSVersion:              "22",
+		HealthCheckPath:            "/up",
+		HealthCheckInterval:        10,
+		HealthCheckTimeout:         3,
+		HealthCheckFailures:        5,
+		SchedulerEnabled:           true,
+		Replicas:                   3,
+		CPURequest:                 "500m",
+		MemoryRequest:              "1Gi",
+		SchedulerConcurrencyPolicy: "Forbid",
+		ScaleDownDrainSeconds:      &drain,
+		OOMRestartPolicy:           "restart",
+		OOMScoreAdjust:             &oomScoreAdjust,
+		MaxConcurrentRequests:      100,
+		Ingress:                    &client.IngressConfig{DeregistrationDelaySeconds: &deregistrationDelay},
+	}, &data)
+
+	if !data.URL.Equal(types.StringValue("https://shop.ploi.cloud")) || !data.Status.Equal(types.StringValue("running")) {
+		t.Errorf("Expected url and status from API, got %v / %v", data.URL, data.Status)
+	}
+
+	expectedRuntime := &RuntimeModel{
+		PHPVersion:    types.StringValue("8.4"),
+		NodeJSVersion: types.StringValue("22"),
+	}
+	if data.Runtime == nil || *data.Runtime != *expectedRuntime {
+		t.Errorf("Expected runtime %+v, got %+v", expectedRuntime, data.Runtime)
+	}
+
+	expectedSettings := &SettingsModel{
+		HealthCheckPath:            types.StringValue("/up"),
+		HealthCheckInterval:        types.Int64Value(10),
+		HealthCheckTimeout:         types.Int64Value(3),
+		HealthCheckFailures:        types.Int64Value(5),
+		SchedulerEnabled:           types.BoolValue(true),
+		Replicas:                   types.Int64Value(3),
+		CPURequest:                 types.StringValue("500m"),
+		MemoryRequest:              types.StringValue("1Gi"),
+		SchedulerConcurrencyPolicy: types.StringValue("Forbid"),
+		ScaleDownDrainSeconds:      types.Int64Value(30),
+		OOMRestartPolicy:           types.StringValue("restart"),
+		OOMScoreAdjust:             types.Int64Value(-500),
+		MaxConcurrentRequests:      types.Int64Value(100),
+		DeregistrationDelaySeconds: types.Int64Value(15),
+	}
+	if data.Settings == nil || *data.Settings != *expectedSettings {
+		t.Errorf("Expected settings %+v, got %+v", expectedSettings, data.Settings)
+	}
+}
+
+// TestApplicationDataSource_SchemaMatchesModel tests that the settings shared
+// with the application resource can be stored with the data source schema
+func TestApplicationDataSource_SchemaMatchesModel(t *testing.T) {
+	ctx := context.Background()
+	d := &ApplicationDataSource{}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	var data ApplicationDataSourceModel
+	d.fromAPIModel(&client.Application{ID: 12, Name: "shop", Type: "laravel", MaxConcurrentRequests: 100}, &data)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("Unexpected errors storing the data source state: %v", diags)
+	}
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+var _ datasource.DataSource = &ApplicationHTTPMetricsDataSource{}
+
+func NewApplicationHTTPMetricsDataSource() datasource.DataSource {
+	return &ApplicationHTTPMetricsDataSource{}
+}
+
+type ApplicationHTTPMetricsDataSource struct {
+	client *client.Client
+}
+
+type ApplicationHTTPMetricsDataSourceModel struct {
+	ApplicationID     types.Int64   `tfsdk:"application_id"`
+	WindowSeconds     types.Int64   `tfsdk:"window_seconds"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	P50LatencyMs      types.Float64 `tfsdk:"p50_latency_ms"`
+	P99LatencyMs      types.Float64 `tfsdk:"p99_latency_ms"`
+	ErrorRate         types.Float64 `tfsdk:"error_rate"`
+}
+
+func (d *ApplicationHTTPMetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_http_metrics"
+}
+
+func (d *ApplicationHTTPMetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Aggregated request rate, latency and error rate of an application over a recent time window",
+
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Application identifier",
+			},
+			"window_seconds": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("Window in seconds the metrics are aggregated over, between %d and %d (defaults to %d)", client.HTTPMetricsMinWindowSeconds, client.HTTPMetricsMaxWindowSeconds, client.HTTPMetricsDefaultWindowSeconds),
+				Validators: []validator.Int64{
+					int64validator.Between(client.HTTPMetricsMinWindowSeconds, client.HTTPMetricsMaxWindowSeconds),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Average number of requests served per second",
+			},
+			"p50_latency_ms": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Median response latency in milliseconds",
+			},
+			"p99_latency_ms": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "99th percentile response latency in milliseconds",
+			},
+			"error_rate": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Fraction of requests answered with a 5xx status, between 0 and 1",
+			},
+		},
+	}
+}
+
+func (d *ApplicationHTTPMetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ApplicationHTTPMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, d.client, &resp.Diagnostics)
+
+	var data ApplicationHTTPMetricsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window := int64(client.HTTPMetricsDefaultWindowSeconds)
+	if !data.WindowSeconds.IsNull() && !data.WindowSeconds.IsUnknown() {
+		window = data.WindowSeconds.ValueInt64()
+	}
+
+	metrics, err := d.client.GetApplicationHTTPMetrics(ctx, data.ApplicationID.ValueInt64(), window)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application HTTP metrics, got error: %s", err))
+		return
+	}
+
+	data.WindowSeconds = types.Int64Value(window)
+	d.fromAPIModel(metrics, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ApplicationHTTPMetricsDataSource) fromAPIModel(metrics *client.HTTPMetrics, data *ApplicationHTTPMetricsDataSourceModel) {
+	data.RequestsPerSecond = types.Float64Value(metrics.RequestsPerSecond)
+	data.P50LatencyMs = types.Float64Value(metrics.P50LatencyMs)
+	data.P99LatencyMs = types.Float64Value(metrics.P99LatencyMs)
+	data.ErrorRate = types.Float64Value(metrics.ErrorRate)
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+var _ datasource.DataSource = &ApplicationMetricsDataSource{}
+
+func NewApplicationMetricsDataSource() datasource.DataSource {
+	return &ApplicationMetricsDataSource{}
+}
+
+type ApplicationMetricsDataSource struct {
+	client *client.Client
+}
+
+type ApplicationMetricsDataSourceModel struct {
+	ApplicationID types.Int64          `tfsdk:"application_id"`
+	WindowSeconds types.Int64          `tfsdk:"window_seconds"`
+	Replicas      []ReplicaMetricModel `tfsdk:"replicas"`
+}
+
+type ReplicaMetricModel struct {
+	Pod           types.String `tfsdk:"pod"`
+	CPUMillicores types.Int64  `tfsdk:"cpu_millicores"`
+	MemoryBytes   types.Int64  `tfsdk:"memory_bytes"`
+	RestartCount  types.Int64  `tfsdk:"restart_count"`
+	SampledAt     types.String `tfsdk:"sampled_at"`
+}
+
+func (d *ApplicationMetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_metrics"
+}
+
+func (d *ApplicationMetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Per-replica CPU and memory usage of an application, useful for right-sizing resource requests",
+
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Application identifier",
+			},
+			"window_seconds": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Sample window in seconds the usage samples were collected over",
+			},
+			"replicas": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Usage samples, one entry per replica sample",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"pod": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Replica (pod) name",
+						},
+						"cpu_millicores": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "CPU usage in millicores",
+						},
+						"memory_bytes": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Memory usage in bytes",
+						},
+						"restart_count": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of container restarts",
+						},
+						"sampled_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Time the sample was taken (RFC 3339)",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ApplicationMetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ApplicationMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, d.client, &resp.Diagnostics)
+
+	var data ApplicationMetricsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metrics, err := d.client.GetApplicationReplicaMetrics(ctx, data.ApplicationID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application metrics, got error: %s", err))
+		return
+	}
+
+	d.fromAPIModel(metrics, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ApplicationMetricsDataSource) fromAPIModel(metrics []client.ReplicaMetric, data *ApplicationMetricsDataSourceModel) {
+	data.WindowSeconds = types.Int64Value(client.ReplicaMetricsWindowSeconds)
+
+	// Always an empty list rather than null so the result can be iterated
+	data.Replicas = make([]ReplicaMetricModel, 0, len(metrics))
+	for _, m := range metrics {
+		sampledAt := types.StringNull()
+		if !m.SampledAt.IsZero() {
+			sampledAt = types.StringValue(m.SampledAt.Format(time.RFC3339))
+		}
+		data.Replicas = append(data.Replicas, ReplicaMetricModel{
+			Pod:           types.StringValue(m.Pod),
+			CPUMillicores: types.Int64Value(m.CPUMillicores),
+			MemoryBytes:   types.Int64Value(m.MemoryBytes),
+			RestartCount:  types.Int64Value(m.RestartCount),
+			SampledAt:     sampledAt,
+		})
+	}
+}
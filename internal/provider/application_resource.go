@@ -2,23 +2,127 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"net"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/ploi/terraform-provider-ploicloud/internal/client"
 )
 
+// cpuRequestRegex matches Kubernetes-style CPU quantities such as "250m" or "0.5"
+var cpuRequestRegex = regexp.MustCompile(`^([0-9]+m|[0-9]+(\.[0-9]+)?)$`)
+
+// headerNameRegex matches an HTTP header field name (RFC 7230 token)
+var headerNameRegex = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
+// maintenanceStartTimeRegex matches a 24-hour HH:MM time such as "03:30"
+var maintenanceStartTimeRegex = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// mimeTypeRegex matches a MIME type (RFC 6838) such as "text/css", or a wildcard subtype such as "text/*"
+var mimeTypeRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9!#$&^_.+-]*/(\*|[A-Za-z0-9][A-Za-z0-9!#$&^_.+-]*)$`)
+
+// hostnameRegex matches an RFC 1123 hostname such as "db.internal" or "api-1"
+var hostnameRegex = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?(\.[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?)*$`)
+
+// errorPageStatusRegex matches a 4xx or 5xx HTTP status code such as "404"
+var errorPageStatusRegex = regexp.MustCompile(`^[45][0-9]{2}$`)
+
+// memoryRequestRegex matches Kubernetes-style memory quantities such as "512Mi" or "1Gi"
+var memoryRequestRegex = regexp.MustCompile(`^[0-9]+(Ki|Mi|Gi|K|M|G)$`)
+
+// processNameRegex matches a process type name such as "web" or "queue-high"
+var processNameRegex = regexp.MustCompile(`^[a-z][a-z0-9-]{0,62}$`)
+
+// nonBlankRegex matches values containing at least one non-whitespace character
+var nonBlankRegex = regexp.MustCompile(`\S`)
+
+// pathPrefixRegex matches an ingress path prefix such as "/api", without query or fragment
+var pathPrefixRegex = regexp.MustCompile(`^/[^\s?#]*$`)
+
+// environmentKeyRegex matches secret keys, uppercase with underscores
+var environmentKeyRegex = regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`)
+
+// maintenanceDays are the accepted maintenance_window.day_of_week values
+var maintenanceDays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// schedulerConcurrencyPolicies control what happens when a scheduler run overlaps the previous one
+var schedulerConcurrencyPolicies = []string{"Allow", "Forbid", "Replace"}
+
+// oomRestartPolicies control what happens when a container runs out of memory
+var oomRestartPolicies = []string{"restart", "kill", "ignore"}
+
+// maxBuildTimeoutSeconds caps build_timeout_seconds at four hours
+const maxBuildTimeoutSeconds = 4 * 60 * 60
+
+// applicationAPIFieldPaths maps API validation error fields to their attributes
+var applicationAPIFieldPaths = map[string]path.Path{
+	"name":                                 path.Root("name"),
+	"application_type":                     path.Root("type"),
+	"application_version":                  path.Root("application_version"),
+	"build_commands":                       path.Root("build_commands"),
+	"build_timeout_seconds":                path.Root("build_timeout_seconds"),
+	"init_commands":                        path.Root("init_commands"),
+	"init_cpu_request":                     path.Root("init_cpu_request"),
+	"init_memory_request":                  path.Root("init_memory_request"),
+	"start_command":                        path.Root("start_command"),
+	"lifecycle.pre_stop_command":           path.Root("pre_stop_command"),
+	"lifecycle.post_start_command":         path.Root("post_start_command"),
+	"ingress.path_prefix":                  path.Root("path_prefix"),
+	"ingress.strip_prefix":                 path.Root("strip_prefix"),
+	"php_extensions":                       path.Root("php_extensions"),
+	"php_settings":                         path.Root("php_settings"),
+	"custom_manifests":                     path.Root("custom_manifests"),
+	"network_id":                           path.Root("network_id"),
+	"template_id":                          path.Root("template_id"),
+	"processes":                            path.Root("processes"),
+	"log_level":                            path.Root("log_level"),
+	"php_version":                          path.Root("runtime").AtName("php_version"),
+	"nodejs_version":                       path.Root("runtime").AtName("nodejs_version"),
+	"health_check_path":                    path.Root("settings").AtName("health_check_path"),
+	"health_check_interval":                path.Root("settings").AtName("health_check_interval"),
+	"health_check_timeout":                 path.Root("settings").AtName("health_check_timeout"),
+	"health_check_failure_threshold":       path.Root("settings").AtName("health_check_failure_threshold"),
+	"replicas":                             path.Root("settings").AtName("replicas"),
+	"cpu_request":                          path.Root("settings").AtName("cpu_request"),
+	"memory_request":                       path.Root("settings").AtName("memory_request"),
+	"scheduler_concurrency_policy":         path.Root("settings").AtName("scheduler_concurrency_policy"),
+	"scale_down_drain_seconds":             path.Root("settings").AtName("scale_down_drain_seconds"),
+	"oom_restart_policy":                   path.Root("settings").AtName("oom_restart_policy"),
+	"oom_score_adjust":                     path.Root("settings").AtName("oom_score_adjust"),
+	"sigterm_timeout_seconds":              path.Root("settings").AtName("sigterm_timeout_seconds"),
+	"max_concurrent_requests":              path.Root("settings").AtName("max_concurrent_requests"),
+	"ingress.deregistration_delay_seconds": path.Root("settings").AtName("deregistration_delay_seconds"),
+}
+
 var _ resource.Resource = &ApplicationResource{}
 var _ resource.ResourceWithImportState = &ApplicationResource{}
+var _ resource.ResourceWithValidateConfig = &ApplicationResource{}
+var _ resource.ResourceWithModifyPlan = &ApplicationResource{}
 
 func NewApplicationResource() resource.Resource {
 	return &ApplicationResource{}
@@ -26,32 +130,85 @@ func NewApplicationResource() resource.Resource {
 
 type ApplicationResource struct {
 	client *client.Client
+
+	// buildLogPollInterval and buildLogTimeout control how long build logs are
+	// followed after a deploy is triggered. Zero values use the defaults.
+	buildLogPollInterval time.Duration
+	buildLogTimeout      time.Duration
+
+	// deploymentTimeout bounds how long wait_for_deployment waits for the
+	// application to run when no create or update timeout is configured.
+	// Zero uses the default.
+	deploymentTimeout time.Duration
 }
 
+const (
+	defaultBuildLogPollInterval = 5 * time.Second
+	defaultBuildLogTimeout      = 15 * time.Minute
+	defaultDeploymentTimeout    = 20 * time.Minute
+	defaultDeleteTimeout        = 10 * time.Minute
+)
+
 type ApplicationResourceModel struct {
-	ID                 types.Int64    `tfsdk:"id"`
-	Name               types.String   `tfsdk:"name"`
-	Type               types.String   `tfsdk:"type"`
-	ApplicationVersion types.String   `tfsdk:"application_version"`
-	Runtime            *RuntimeModel  `tfsdk:"runtime"`
-	BuildCommands      types.List     `tfsdk:"build_commands"`
-	InitCommands       types.List     `tfsdk:"init_commands"`
-	StartCommand       types.String   `tfsdk:"start_command"`
-	Settings           *SettingsModel `tfsdk:"settings"`
-	PHPExtensions      types.List     `tfsdk:"php_extensions"`
-	PHPSettings        types.List     `tfsdk:"php_settings"`
-	AdditionalDomains  types.List     `tfsdk:"additional_domains"`
-	URL                types.String   `tfsdk:"url"`
-	Status             types.String   `tfsdk:"status"`
-	NeedsDeployment    types.Bool     `tfsdk:"needs_deployment"`
-	CustomManifests    types.String   `tfsdk:"custom_manifests"`
-	RepositoryURL      types.String   `tfsdk:"repository_url"`
-	RepositoryOwner    types.String   `tfsdk:"repository_owner"`
-	RepositoryName     types.String   `tfsdk:"repository_name"`
-	DefaultBranch      types.String   `tfsdk:"default_branch"`
-	SocialAccountID    types.Int64    `tfsdk:"social_account_id"`
-	Region             types.String   `tfsdk:"region"`
-	CloudProvider      types.String   `tfsdk:"cloud_provider"`
+	ID                    types.Int64               `tfsdk:"id"`
+	Name                  types.String              `tfsdk:"name"`
+	Type                  types.String              `tfsdk:"type"`
+	ApplicationVersion    types.String              `tfsdk:"application_version"`
+	Runtime               *RuntimeModel             `tfsdk:"runtime"`
+	BuildCommands         types.List                `tfsdk:"build_commands"`
+	BuildTimeoutSeconds   types.Int64               `tfsdk:"build_timeout_seconds"`
+	InitCommands          types.List                `tfsdk:"init_commands"`
+	InitCPURequest        types.String              `tfsdk:"init_cpu_request"`
+	InitMemoryRequest     types.String              `tfsdk:"init_memory_request"`
+	StartCommand          types.String              `tfsdk:"start_command"`
+	PreStopCommand        types.String              `tfsdk:"pre_stop_command"`
+	PostStartCommand      types.String              `tfsdk:"post_start_command"`
+	Settings              *SettingsModel            `tfsdk:"settings"`
+	PHPExtensions         types.List                `tfsdk:"php_extensions"`
+	PHPSettings           types.List                `tfsdk:"php_settings"`
+	AdditionalDomains     types.List                `tfsdk:"additional_domains"`
+	URL                   types.String              `tfsdk:"url"`
+	Status                types.String              `tfsdk:"status"`
+	NeedsDeployment       types.Bool                `tfsdk:"needs_deployment"`
+	CustomManifests       types.String              `tfsdk:"custom_manifests"`
+	RepositoryURL         types.String              `tfsdk:"repository_url"`
+	RepositoryOwner       types.String              `tfsdk:"repository_owner"`
+	RepositoryName        types.String              `tfsdk:"repository_name"`
+	DefaultBranch         types.String              `tfsdk:"default_branch"`
+	SocialAccountID       types.Int64               `tfsdk:"social_account_id"`
+	Region                types.String              `tfsdk:"region"`
+	CloudProvider         types.String              `tfsdk:"cloud_provider"`
+	LogLevel              types.String              `tfsdk:"log_level"`
+	BuildCache            *BuildCacheModel          `tfsdk:"build_cache"`
+	NetworkID             types.Int64               `tfsdk:"network_id"`
+	TemplateID            types.Int64               `tfsdk:"template_id"`
+	Sidecars              []SidecarModel            `tfsdk:"sidecar"`
+	HostAliases           []HostAliasModel          `tfsdk:"host_aliases"`
+	Processes             []ProcessModel            `tfsdk:"processes"`
+	Canary                *CanaryModel              `tfsdk:"canary"`
+	AutoSleep             *AutoSleepModel           `tfsdk:"auto_sleep"`
+	Egress                *EgressModel              `tfsdk:"egress"`
+	EgressIP              types.String              `tfsdk:"egress_ip"`
+	Cluster               types.String              `tfsdk:"cluster"`
+	Zone                  types.String              `tfsdk:"zone"`
+	InternalHostname      types.String              `tfsdk:"internal_hostname"`
+	DeployedCommitSHA     types.String              `tfsdk:"deployed_commit_sha"`
+	DeployedCommitMessage types.String              `tfsdk:"deployed_commit_message"`
+	BasicAuth             *BasicAuthModel           `tfsdk:"basic_auth"`
+	Headers               *HeadersModel             `tfsdk:"headers"`
+	Compression           *CompressionModel         `tfsdk:"compression"`
+	ReconciliationPaused  types.Bool                `tfsdk:"reconciliation_paused"`
+	WaitForDeployment     types.Bool                `tfsdk:"wait_for_deployment"`
+	IngressAllowCIDRs     types.List                `tfsdk:"ingress_allow_cidrs"`
+	IngressDenyCIDRs      types.List                `tfsdk:"ingress_deny_cidrs"`
+	HTTP2Enabled          types.Bool                `tfsdk:"http2_enabled"`
+	HTTP3Enabled          types.Bool                `tfsdk:"http3_enabled"`
+	PathPrefix            types.String              `tfsdk:"path_prefix"`
+	StripPrefix           types.Bool                `tfsdk:"strip_prefix"`
+	ErrorPages            map[string]ErrorPageModel `tfsdk:"error_pages"`
+	MaintenanceWindow     *MaintenanceWindowModel   `tfsdk:"maintenance_window"`
+	Environment           types.Map                 `tfsdk:"environment"`
+	Timeouts              timeouts.Value            `tfsdk:"timeouts"`
 }
 
 type RuntimeModel struct {
@@ -59,12 +216,94 @@ type RuntimeModel struct {
 	NodeJSVersion types.String `tfsdk:"nodejs_version"`
 }
 
+type BuildCacheModel struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Key     types.String `tfsdk:"key"`
+}
+
+type HeadersModel struct {
+	Add    types.Map  `tfsdk:"add"`
+	Set    types.Map  `tfsdk:"set"`
+	Remove types.List `tfsdk:"remove"`
+}
+
+type CompressionModel struct {
+	Enabled      types.Bool  `tfsdk:"enabled"`
+	Types        types.List  `tfsdk:"types"`
+	MinSizeBytes types.Int64 `tfsdk:"min_size_bytes"`
+}
+
+type BasicAuthModel struct {
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	Username  types.String `tfsdk:"username"`
+	Password  types.String `tfsdk:"password"`
+	ValueHash types.String `tfsdk:"value_hash"`
+}
+
+type EgressModel struct {
+	StaticIP types.Bool `tfsdk:"static_ip"`
+}
+
+type MaintenanceWindowModel struct {
+	DayOfWeek       types.String `tfsdk:"day_of_week"`
+	StartTime       types.String `tfsdk:"start_time"`
+	DurationMinutes types.Int64  `tfsdk:"duration_minutes"`
+	Timezone        types.String `tfsdk:"timezone"`
+}
+
+type CanaryModel struct {
+	Enabled             types.Bool  `tfsdk:"enabled"`
+	TrafficPercentage   types.Int64 `tfsdk:"traffic_percentage"`
+	PromoteAfterSeconds types.Int64 `tfsdk:"promote_after_seconds"`
+}
+
+type AutoSleepModel struct {
+	Enabled            types.Bool  `tfsdk:"enabled"`
+	IdleTimeoutMinutes types.Int64 `tfsdk:"idle_timeout_minutes"`
+}
+
+type SidecarModel struct {
+	Name          types.String `tfsdk:"name"`
+	Image         types.String `tfsdk:"image"`
+	Command       types.String `tfsdk:"command"`
+	CPURequest    types.String `tfsdk:"cpu_request"`
+	MemoryRequest types.String `tfsdk:"memory_request"`
+}
+
+type ProcessModel struct {
+	Name          types.String `tfsdk:"name"`
+	Command       types.String `tfsdk:"command"`
+	Replicas      types.Int64  `tfsdk:"replicas"`
+	CPURequest    types.String `tfsdk:"cpu_request"`
+	MemoryRequest types.String `tfsdk:"memory_request"`
+}
+
+type HostAliasModel struct {
+	Hostname types.String `tfsdk:"hostname"`
+	IP       types.String `tfsdk:"ip"`
+}
+
+type ErrorPageModel struct {
+	Content types.String `tfsdk:"content"`
+	URL     types.String `tfsdk:"url"`
+}
+
 type SettingsModel struct {
-	HealthCheckPath  types.String `tfsdk:"health_check_path"`
-	SchedulerEnabled types.Bool   `tfsdk:"scheduler_enabled"`
-	Replicas         types.Int64  `tfsdk:"replicas"`
-	CPURequest       types.String `tfsdk:"cpu_request"`
-	MemoryRequest    types.String `tfsdk:"memory_request"`
+	HealthCheckPath            types.String `tfsdk:"health_check_path"`
+	HealthCheckInterval        types.Int64  `tfsdk:"health_check_interval"`
+	HealthCheckTimeout         types.Int64  `tfsdk:"health_check_timeout"`
+	HealthCheckFailures        types.Int64  `tfsdk:"health_check_failure_threshold"`
+	SchedulerEnabled           types.Bool   `tfsdk:"scheduler_enabled"`
+	Replicas                   types.Int64  `tfsdk:"replicas"`
+	CPURequest                 types.String `tfsdk:"cpu_request"`
+	MemoryRequest              types.String `tfsdk:"memory_request"`
+	SchedulerConcurrencyPolicy types.String `tfsdk:"scheduler_concurrency_policy"`
+	ScaleDownDrainSeconds      types.Int64  `tfsdk:"scale_down_drain_seconds"`
+	OOMRestartPolicy           types.String `tfsdk:"oom_restart_policy"`
+	OOMScoreAdjust             types.Int64  `tfsdk:"oom_score_adjust"`
+	SigtermTimeoutSeconds      types.Int64  `tfsdk:"sigterm_timeout_seconds"`
+	MaxConcurrentRequests      types.Int64  `tfsdk:"max_concurrent_requests"`
+	DeregistrationDelaySeconds types.Int64  `tfsdk:"deregistration_delay_seconds"`
 }
 
 func (r *ApplicationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -100,14 +339,49 @@ func (r *ApplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 				ElementType:         types.StringType,
 				MarkdownDescription: "Build commands to run during image build",
 			},
+			"build_timeout_seconds": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Seconds the image build may run before it is aborted (1-%d). Independent of how long Terraform follows the deploy.", maxBuildTimeoutSeconds),
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxBuildTimeoutSeconds),
+				},
+			},
 			"init_commands": schema.ListAttribute{
 				Optional:            true,
 				ElementType:         types.StringType,
 				MarkdownDescription: "Initialization commands to run before starting the application",
 			},
+			"init_cpu_request": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "CPU request of the init container running init_commands (e.g. '500m', '1'). Uses the platform default when unset",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cpuRequestRegex, "must be a CPU quantity such as '500m' or '1'"),
+				},
+			},
+			"init_memory_request": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Memory request of the init container running init_commands (e.g. '1Gi'). Uses the platform default when unset",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(memoryRequestRegex, "must be a memory quantity such as '512Mi' or '1Gi'"),
+				},
+			},
 			"start_command": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "Custom start command for the application",
+				MarkdownDescription: startCommandDeprecation.Description("Custom start command for the application"),
+			},
+			"pre_stop_command": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Command run in each container before it is stopped (e.g. to flush caches or finish in-flight work)",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(nonBlankRegex, "must not be empty"),
+				},
+			},
+			"post_start_command": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Command run in each container right after it starts (e.g. to warm caches)",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(nonBlankRegex, "must not be empty"),
+				},
 			},
 			"php_extensions": schema.ListAttribute{
 				Optional:            true,
@@ -124,6 +398,80 @@ func (r *ApplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 				ElementType:         types.StringType,
 				MarkdownDescription: "List of additional custom domains to sync with the application",
 			},
+			"ingress_allow_cidrs": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "CIDR blocks allowed to reach the application through the ingress. When set, all other addresses are rejected",
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(cidrBlock()),
+				},
+			},
+			"ingress_deny_cidrs": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "CIDR blocks rejected at the ingress",
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(cidrBlock()),
+				},
+			},
+			"http2_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Serve HTTP/2 at the ingress. Uses the platform default when unset",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"http3_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Serve HTTP/3 (QUIC) at the ingress. Uses the platform default when unset",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path_prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Route only requests below this path, e.g. `/api`, to the application. Must start with `/`",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(pathPrefixRegex, "must start with '/' and contain no whitespace, query or fragment, e.g. '/api'"),
+				},
+			},
+			"strip_prefix": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Remove `path_prefix` from the request path before forwarding it to the application. Uses the platform default when unset",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"error_pages": schema.MapNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Custom error pages served at the ingress, keyed by 4xx or 5xx HTTP status code (e.g. \"503\")",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(errorPageStatusRegex, "must be a 4xx or 5xx HTTP status code such as '503'")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"content": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "HTML served as the error page. Exactly one of content or url must be set",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"url": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "http or https URL the error page is fetched from. Exactly one of content or url must be set",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^https?://[^\s/]+`), "must be an absolute http or https URL"),
+							},
+						},
+					},
+				},
+			},
 			"url": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Application URL",
@@ -174,24 +522,98 @@ func (r *ApplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 				Default:             stringdefault.StaticString("default"),
 				MarkdownDescription: "Cloud provider",
 			},
+			"network_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the private network (VPC peering) the application is attached to, for reaching private resources outside Ploi Cloud",
+			},
+			"template_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the application template to inherit from. Template values fill the `settings` attributes left unset in the configuration, explicitly configured values always win",
+			},
+			"egress_ip": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Static outbound IP address assigned to the application, when egress.static_ip is enabled",
+			},
+			"cluster": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Cluster the application is scheduled on",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Availability zone the application is scheduled in",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"internal_hostname": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Cluster-internal DNS name of the application, for service-to-service calls that should not go through the public URL",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployed_commit_sha": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA of the commit currently deployed",
+			},
+			"deployed_commit_message": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Message of the commit currently deployed",
+			},
+			"reconciliation_paused": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Stop applying changes to the application, e.g. during incident response. While true, updates are skipped and only the status is refreshed",
+			},
+			"wait_for_deployment": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Wait until a deployment triggered by create or update leaves the application running, and fail the apply if it does not",
+			},
+			"environment": schema.MapAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Secrets of the application keyed by environment variable name, managed as a whole instead of one `ploicloud_secret` per key. Only keys set here are created, updated or deleted, so secrets managed by `ploicloud_secret` are left alone",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(environmentKeyRegex, "must be uppercase with underscores, e.g. 'APP_KEY'")),
+				},
+			},
+			"log_level": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Application log level (debug, info, warning, error). Also applies to the FPM/web server logging",
+				Validators: []validator.String{
+					stringvalidator.OneOf("debug", "info", "warning", "error"),
+				},
+			},
 		},
 
 		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 			"runtime": schema.SingleNestedBlock{
 				MarkdownDescription: "Runtime configuration",
 				Attributes: map[string]schema.Attribute{
 					"php_version": schema.StringAttribute{
 						Optional:            true,
-						MarkdownDescription: "PHP version (7.4, 8.0, 8.1, 8.2, 8.3, 8.4)",
+						MarkdownDescription: "PHP version (7.4, 8.0, 8.1, 8.2, 8.3, 8.4). Versions unknown to the provider produce a warning",
 						Validators: []validator.String{
-							stringvalidator.OneOf("7.4", "8.0", "8.1", "8.2", "8.3", "8.4"),
+							knownRuntimeVersion("PHP", knownPHPVersions...),
 						},
 					},
 					"nodejs_version": schema.StringAttribute{
 						Optional:            true,
-						MarkdownDescription: "Node.js version (18, 20, 22, 24)",
+						MarkdownDescription: "Node.js version (18, 20, 22, 24). Versions unknown to the provider produce a warning",
 						Validators: []validator.String{
-							stringvalidator.OneOf("18", "20", "22", "24"),
+							knownRuntimeVersion("Node.js", knownNodeJSVersions...),
 						},
 					},
 				},
@@ -205,6 +627,27 @@ func (r *ApplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 						Default:             stringdefault.StaticString("/"),
 						MarkdownDescription: "Health check path",
 					},
+					"health_check_interval": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Seconds between health checks",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"health_check_timeout": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Seconds a health check may take before it counts as failed. Must be less than `health_check_interval` when both are set",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"health_check_failure_threshold": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Consecutive failed health checks before a replica is considered unhealthy",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
 					"scheduler_enabled": schema.BoolAttribute{
 						Optional:            true,
 						Computed:            true,
@@ -229,155 +672,1051 @@ func (r *ApplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 						Default:             stringdefault.StaticString("512Mi"),
 						MarkdownDescription: "Memory request",
 					},
+					"scheduler_concurrency_policy": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "How overlapping scheduler runs are handled: `Allow` runs them concurrently, `Forbid` skips a run while the previous one is still active, `Replace` stops the previous run",
+						Validators: []validator.String{
+							stringvalidator.OneOf(schedulerConcurrencyPolicies...),
+						},
+					},
+					"scale_down_drain_seconds": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Seconds terminating replicas keep serving in-flight requests when scaling down",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"oom_restart_policy": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "What happens when a container runs out of memory: `restart` restarts it, `kill` stops it without restarting, `ignore` leaves it running and only reports the event",
+						Validators: []validator.String{
+							stringvalidator.OneOf(oomRestartPolicies...),
+						},
+					},
+					"oom_score_adjust": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Linux OOM score adjustment for the application processes, from -1000 (never killed first) to 1000 (killed first)",
+						Validators: []validator.Int64{
+							int64validator.Between(-1000, 1000),
+						},
+					},
+					"sigterm_timeout_seconds": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Seconds the application gets to exit after SIGTERM before it is killed. Must not exceed `scale_down_drain_seconds` when both are set",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"max_concurrent_requests": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Maximum number of in-flight requests per replica. The platform sheds requests above the limit instead of overloading the replica",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"deregistration_delay_seconds": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Seconds replicas being replaced by a deploy stay registered at the load balancer before terminating, so in-flight requests finish instead of returning 502s",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
 				},
 			},
-		},
-	}
-}
-
-func (r *ApplicationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*client.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	r.client = client
-}
-
-func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data ApplicationResourceModel
-
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	app := r.toAPIModel(&data)
-
-	created, err := r.client.CreateApplication(app)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create application, got error: %s", err))
-		return
-	}
-
-	r.fromAPIModel(created, &data)
-
-	// Automatically trigger deployment after creation
-	if created.NeedsDeployment {
-		err := r.client.DeployApplication(created.ID)
-		if err != nil {
-			resp.Diagnostics.AddWarning("Deploy Warning", fmt.Sprintf("Application created successfully, but deployment initiation had an issue: %s", err))
-			// Don't return here - the application was created successfully, just deployment failed
-		}
-		
-		// Re-read the application to get updated deployment status
-		refreshed, err := r.client.GetApplication(created.ID)
-		if err == nil && refreshed != nil {
-			r.fromAPIModel(refreshed, &data)
-		}
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
-
-func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data ApplicationResourceModel
-
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	app, err := r.client.GetApplication(data.ID.ValueInt64())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application, got error: %s", err))
-		return
-	}
-
-	if app == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
-	r.fromAPIModel(app, &data)
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
-
-func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ApplicationResourceModel
-	var state ApplicationResourceModel
-
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Use ID from current state, not from plan
-	app := r.toUpdateAPIModel(&data)
-
-	updated, err := r.client.UpdateApplication(state.ID.ValueInt64(), app)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update application, got error: %s", err))
-		return
-	}
-
-	r.fromAPIModel(updated, &data)
-
-	// Automatically trigger deployment after update if needed
-	if updated.NeedsDeployment {
-		err := r.client.DeployApplication(updated.ID)
-		if err != nil {
-			resp.Diagnostics.AddWarning("Deploy Warning", fmt.Sprintf("Application updated successfully, but deployment initiation had an issue: %s", err))
-			// Don't return here - the application was updated successfully, just deployment failed
-		}
-		
-		// Re-read the application to get updated deployment status
-		refreshed, err := r.client.GetApplication(updated.ID)
-		if err == nil && refreshed != nil {
-			r.fromAPIModel(refreshed, &data)
-		}
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
-
-func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+			"build_cache": schema.SingleNestedBlock{
+				MarkdownDescription: "Build cache configuration controlling layer and dependency cache reuse across deploys",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+						MarkdownDescription: "Reuse the build cache across deploys",
+					},
+					"key": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Cache key; changing it invalidates the existing cache (letters, digits, '.', '_' and '-', up to 128 characters)",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^[a-zA-Z0-9._-]{1,128}$`),
+								"must be 1-128 characters of letters, digits, '.', '_' or '-'",
+							),
+						},
+					},
+				},
+			},
+			"headers": schema.SingleNestedBlock{
+				MarkdownDescription: "Header rewriting applied at the ingress",
+				Attributes: map[string]schema.Attribute{
+					"add": schema.MapAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Headers to add, keeping any existing value (e.g. security headers)",
+						Validators: []validator.Map{
+							mapvalidator.KeysAre(stringvalidator.RegexMatches(headerNameRegex, "must be a valid HTTP header name")),
+						},
+					},
+					"set": schema.MapAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Headers to set, replacing any existing value",
+						Validators: []validator.Map{
+							mapvalidator.KeysAre(stringvalidator.RegexMatches(headerNameRegex, "must be a valid HTTP header name")),
+						},
+					},
+					"remove": schema.ListAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Headers to strip (e.g. X-Powered-By)",
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.RegexMatches(headerNameRegex, "must be a valid HTTP header name")),
+						},
+					},
+				},
+			},
+			"compression": schema.SingleNestedBlock{
+				MarkdownDescription: "Response compression applied at the ingress",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+						MarkdownDescription: "Compress responses",
+					},
+					"types": schema.ListAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "MIME types to compress (e.g. text/css, application/json). Defaults to the platform's list",
+						Validators: []validator.List{
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.RegexMatches(mimeTypeRegex, "must be a MIME type such as text/css")),
+						},
+					},
+					"min_size_bytes": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Smallest response size in bytes that is compressed",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"basic_auth": schema.SingleNestedBlock{
+				MarkdownDescription: "HTTP basic auth protection at the ingress, e.g. for staging environments",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+						MarkdownDescription: "Require basic auth credentials for every request",
+					},
+					"username": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Basic auth username (required when enabled)",
+					},
+					"password": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						MarkdownDescription: "Basic auth password (required when enabled)",
+					},
+					"value_hash": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "SHA-256 hash of the credentials, used to detect changes made outside Terraform",
+					},
+				},
+			},
+			"egress": schema.SingleNestedBlock{
+				MarkdownDescription: "Outbound traffic configuration",
+				Attributes: map[string]schema.Attribute{
+					"static_ip": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+						MarkdownDescription: "Route outbound traffic through a stable IP address, e.g. for IP-allowlisted third-party APIs",
+					},
+				},
+			},
+			"canary": schema.SingleNestedBlock{
+				MarkdownDescription: "Canary deploys: shift a percentage of traffic to the new release and promote it once it stays healthy",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+						MarkdownDescription: "Deploy new releases as a canary",
+					},
+					"traffic_percentage": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(10),
+						MarkdownDescription: "Percentage of traffic routed to the canary (0-100)",
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+					"promote_after_seconds": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Seconds the canary must stay healthy before it receives all traffic",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+				},
+			},
+			"auto_sleep": schema.SingleNestedBlock{
+				MarkdownDescription: "Scale the application to zero after a period without requests and wake it on the next request, e.g. for preview and staging environments",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+						MarkdownDescription: "Put the application to sleep when idle",
+					},
+					"idle_timeout_minutes": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Minutes without requests before the application goes to sleep. Required when enabled",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"maintenance_window": schema.SingleNestedBlock{
+				MarkdownDescription: "Preferred window for disruptive platform maintenance such as node upgrades",
+				Attributes: map[string]schema.Attribute{
+					"day_of_week": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Day the window starts on (monday through sunday)",
+						Validators: []validator.String{
+							stringvalidator.OneOf(maintenanceDays...),
+						},
+					},
+					"start_time": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Start of the window as a 24-hour HH:MM time, e.g. `03:00`",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(maintenanceStartTimeRegex, "must be a 24-hour time in HH:MM format"),
+						},
+					},
+					"duration_minutes": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(60),
+						MarkdownDescription: "Length of the window in minutes (30-1440)",
+						Validators: []validator.Int64{
+							int64validator.Between(30, 1440),
+						},
+					},
+					"timezone": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("UTC"),
+						MarkdownDescription: "IANA time zone the start time is expressed in, e.g. `Europe/Amsterdam`",
+						Validators: []validator.String{
+							timezoneName(),
+						},
+					},
+				},
+			},
+			"sidecar": schema.ListNestedBlock{
+				MarkdownDescription: "Sidecar containers running alongside the application (e.g. metrics exporters, log shippers)",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Sidecar container name",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"image": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Container image (e.g. prom/node-exporter:latest)",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"command": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Command to run instead of the image entrypoint",
+						},
+						"cpu_request": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "CPU request (e.g. '100m', '0.5')",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(cpuRequestRegex, "must be a CPU quantity such as '100m' or '0.5'"),
+							},
+						},
+						"memory_request": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Memory request (e.g. '64Mi', '1Gi')",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(memoryRequestRegex, "must be a memory quantity such as '64Mi' or '1Gi'"),
+							},
+						},
+					},
+				},
+			},
+			"processes": schema.ListNestedBlock{
+				MarkdownDescription: "Procfile-style process types, each scaled independently. Requires a `web` process, whose command replaces `start_command`",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Process type name (e.g. 'web', 'worker', 'release'), unique per application",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(processNameRegex, "must start with a lowercase letter and contain only lowercase letters, digits and '-', up to 63 characters"),
+							},
+						},
+						"command": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Command the process runs",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"replicas": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Number of replicas of the process",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"cpu_request": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "CPU request (e.g. '100m', '0.5')",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(cpuRequestRegex, "must be a CPU quantity such as '100m' or '0.5'"),
+							},
+						},
+						"memory_request": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Memory request (e.g. '64Mi', '1Gi')",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(memoryRequestRegex, "must be a memory quantity such as '64Mi' or '1Gi'"),
+							},
+						},
+					},
+				},
+			},
+			"host_aliases": schema.ListNestedBlock{
+				MarkdownDescription: "Static hostname resolution inside the application containers, like /etc/hosts entries (e.g. for internal hostnames without DNS)",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"hostname": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Hostname to resolve (e.g. 'db.internal')",
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(253),
+								stringvalidator.RegexMatches(hostnameRegex, "must be a valid hostname such as 'db.internal'"),
+							},
+						},
+						"ip": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "IP address the hostname resolves to",
+							Validators: []validator.String{
+								ipAddress(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ApplicationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data ApplicationResourceModel
 
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, r.defaultDeploymentTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validateNetworkID(ctx, data.NetworkID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app := r.toAPIModel(&data)
+
+	created, err := r.client.CreateApplication(ctx, app)
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("create application", err, applicationAPIFieldPaths)...)
+		return
+	}
+
+	r.fromAPIModel(created, &data)
+
+	// Track the application before deploying so it can still be destroyed
+	// when the deployment fails or the apply is interrupted
+	// Secrets are only recorded once they are applied below
+	environment := data.Environment
+	data.Environment = types.MapNull(types.StringType)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Secrets are set before deploying so the first release picks them up
+	data.Environment, diags = r.reconcileEnvironment(ctx, created.ID, types.MapNull(types.StringType), environment)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// Automatically trigger deployment after creation
+	if created.NeedsDeployment {
+		deployment, err := r.client.DeployApplication(ctx, created.ID)
+		if err != nil {
+			resp.Diagnostics.AddWarning("Deploy Warning", fmt.Sprintf("Application created successfully, but deployment initiation had an issue: %s", err))
+			// Don't return here - the application was created successfully, just deployment failed
+		} else if deployment != nil {
+			resp.Diagnostics.Append(r.followBuildLogs(ctx, created.ID, deployment.ID)...)
+		}
+		
+		resp.Diagnostics.Append(r.refreshAfterDeploy(ctx, created.ID, &data, createTimeout)...)
+	}
+
+	resp.Diagnostics.Append(egressDiagnostics(&data)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
+	var data ApplicationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := r.client.GetApplication(ctx, data.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application, got error: %s", err))
+		return
+	}
+
+	if app == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.refreshFromAPI(app, &data)
+
+	if !data.Environment.IsNull() {
+		secrets, err := r.client.ListSecrets(ctx, app.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application secrets, got error: %s", err))
+			return
+		}
+		data.Environment = environmentFromAPI(secrets, data.Environment)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// refreshFromAPI updates data with the application as read from the API and
+// defaults the provider-only attributes that are null, e.g. after an import
+func (r *ApplicationResource) refreshFromAPI(app *client.Application, data *ApplicationResourceModel) {
+	r.fromAPIModel(app, data)
+	detectBasicAuthDrift(app, data)
+
+	if data.ReconciliationPaused.IsNull() {
+		data.ReconciliationPaused = types.BoolValue(false)
+	}
+	if data.WaitForDeployment.IsNull() {
+		data.WaitForDeployment = types.BoolValue(false)
+	}
+}
+
+func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
+	var data ApplicationResourceModel
+	var state ApplicationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.defaultDeploymentTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ModifyPlan already reduced the plan to the current state, so only the
+	// flag itself is recorded and no API calls are made
+	if data.ReconciliationPaused.ValueBool() {
+		resp.Diagnostics.Append(reconciliationPausedDiagnostics(state.ID)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	if !data.NetworkID.Equal(state.NetworkID) {
+		resp.Diagnostics.Append(r.validateNetworkID(ctx, data.NetworkID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Use ID from current state, not from plan
+	app := r.toUpdateAPIModel(&data)
+
+	// Unset attributes are left out of updates, so hooks removed from the
+	// configuration have to be cleared explicitly
+	if _, ok := app["lifecycle"]; !ok && lifecycleToAPI(&state) != nil {
+		app["lifecycle"] = &client.Lifecycle{}
+	}
+	if data.PathPrefix.IsNull() && !state.PathPrefix.IsNull() {
+		ingress, _ := app["ingress"].(*client.IngressConfig)
+		if ingress == nil {
+			ingress = &client.IngressConfig{}
+			app["ingress"] = ingress
+		}
+		cleared := ""
+		ingress.PathPrefix = &cleared
+	}
+
+	updated, err := r.client.UpdateApplication(ctx, state.ID.ValueInt64(), app)
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("update application", err, applicationAPIFieldPaths)...)
+		return
+	}
+
+	r.fromAPIModel(updated, &data)
+
+	data.Environment, diags = r.reconcileEnvironment(ctx, updated.ID, state.Environment, data.Environment)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		// The application itself was updated, keep that and the applied secrets
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// Automatically trigger deployment after update if needed
+	if updated.NeedsDeployment {
+		deployment, err := r.client.DeployApplication(ctx, updated.ID)
+		if err != nil {
+			resp.Diagnostics.AddWarning("Deploy Warning", fmt.Sprintf("Application updated successfully, but deployment initiation had an issue: %s", err))
+			// Don't return here - the application was updated successfully, just deployment failed
+		} else if deployment != nil {
+			resp.Diagnostics.Append(r.followBuildLogs(ctx, updated.ID, deployment.ID)...)
+		}
+		
+		resp.Diagnostics.Append(r.refreshAfterDeploy(ctx, updated.ID, &data, updateTimeout)...)
+	}
+
+	resp.Diagnostics.Append(egressDiagnostics(&data)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
+	var data ApplicationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteApplication(ctx, data.ID.ValueInt64())
+	// A application that is already gone counts as deleted
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete application, got error: %s", err))
+		return
+	}
+
+	if err := r.client.WaitForApplicationDeleted(ctx, data.ID.ValueInt64(), deleteTimeout); err != nil {
+		resp.Diagnostics.Append(waitErrorDiagnostics("Delete Failed", fmt.Sprintf("Application %d was not deleted", data.ID.ValueInt64()), err)...)
+	}
+}
+
+// ModifyPlan fills settings inherited from the application template and keeps
+// the current state as the plan while reconciliation is paused, so pending
+// changes show no diff and are applied once it resumes.
+func (r *ApplicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.planTemplateSettings(ctx, req, resp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing to pause on create
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan ApplicationResourceModel
+	var state ApplicationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ReconciliationPaused.ValueBool() {
+		return
+	}
+
+	state.ReconciliationPaused = plan.ReconciliationPaused
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &state)...)
+	resp.Diagnostics.Append(reconciliationPausedDiagnostics(state.ID)...)
+}
+
+// planTemplateSettings plans the template values of the settings left unset in
+// the configuration, so inherited values show in the plan like configured ones
+func (r *ApplicationResource) planTemplateSettings(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var templateID types.Int64
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("template_id"), &templateID)...)
+	if diags.HasError() || templateID.IsNull() || r.client == nil {
+		return diags
+	}
+
+	if templateID.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("template_id"),
+			"Unknown Application Template",
+			"template_id must be known during plan so the inherited settings can be planned",
+		)
+		return diags
+	}
+
+	template, err := r.client.GetApplicationTemplate(ctx, templateID.ValueInt64())
+	if client.IsNotFound(err) {
+		diags.AddAttributeError(
+			path.Root("template_id"),
+			"Unknown Application Template",
+			fmt.Sprintf("Application template %d does not exist or is not accessible with the configured API token", templateID.ValueInt64()),
+		)
+		return diags
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read application template, got error: %s", err))
+		return diags
+	}
+
+	var config, plan *SettingsModel
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("settings"), &config)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("settings"), &plan)...)
+	if diags.HasError() || plan == nil {
+		return diags
+	}
+
+	applyApplicationTemplate(template, config, plan)
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("settings"), plan)...)
+	return diags
+}
+
+// applyApplicationTemplate sets the planned settings the configuration leaves
+// unset to the template values. Configured values, even ones equal to the
+// schema default, always win over the template.
+func applyApplicationTemplate(template *client.ApplicationTemplate, config, plan *SettingsModel) {
+	if config == nil {
+		config = &SettingsModel{}
+	}
+
+	if config.HealthCheckPath.IsNull() && template.HealthCheckPath != "" {
+		plan.HealthCheckPath = types.StringValue(template.HealthCheckPath)
+	}
+	if config.SchedulerEnabled.IsNull() && template.SchedulerEnabled != nil {
+		plan.SchedulerEnabled = types.BoolPointerValue(template.SchedulerEnabled)
+	}
+	if config.Replicas.IsNull() && template.Replicas != 0 {
+		plan.Replicas = types.Int64Value(template.Replicas)
+	}
+	if config.CPURequest.IsNull() && template.CPURequest != "" {
+		plan.CPURequest = types.StringValue(template.CPURequest)
+	}
+	if config.MemoryRequest.IsNull() && template.MemoryRequest != "" {
+		plan.MemoryRequest = types.StringValue(template.MemoryRequest)
+	}
+}
+
+// reconciliationPausedDiagnostics warns that changes are not being applied
+func reconciliationPausedDiagnostics(id types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.AddWarning(
+		"Reconciliation Paused",
+		fmt.Sprintf("reconciliation_paused is true, so changes to application %d are not applied. Set it to false to resume applying changes.", id.ValueInt64()),
+	)
+	return diags
+}
+
+func (r *ApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", "Import ID must be a valid integer")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := r.client.DeleteApplication(data.ID.ValueInt64())
+	// Populate the full state right away, including the runtime and settings
+	// blocks, so the first plan after the import has no spurious diff
+	app, err := r.client.GetApplication(ctx, id)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete application, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application %d for import, got error: %s", id, err))
+		return
+	}
+	if app == nil {
+		resp.Diagnostics.AddError("Application Not Found", fmt.Sprintf("Application %d does not exist and cannot be imported", id))
+		return
+	}
+
+	var data ApplicationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.refreshFromAPI(app, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *ApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid Import ID", "Import ID must be a valid integer")
+func (r *ApplicationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ApplicationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(validateBasicAuth(data.BasicAuth)...)
+	resp.Diagnostics.Append(validateAutoSleep(data.AutoSleep)...)
+	resp.Diagnostics.Append(validateIngressCIDRs(ctx, data.IngressAllowCIDRs, data.IngressDenyCIDRs)...)
+	resp.Diagnostics.Append(validateErrorPages(data.ErrorPages)...)
+	resp.Diagnostics.Append(validatePathRouting(data.PathPrefix, data.StripPrefix)...)
+	resp.Diagnostics.Append(validateSigtermTimeout(data.Settings)...)
+	resp.Diagnostics.Append(validateHealthCheckTiming(data.Settings)...)
+	resp.Diagnostics.Append(validateProcesses(data.Processes, data.StartCommand)...)
+	resp.Diagnostics.Append(deprecatedAttributeDiagnostics(ctx, req.Config, applicationDeprecations)...)
+}
+
+// validatePathRouting rejects strip_prefix without a path_prefix to strip
+func validatePathRouting(pathPrefix types.String, stripPrefix types.Bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if stripPrefix.ValueBool() && pathPrefix.IsNull() {
+		diags.AddAttributeError(
+			path.Root("strip_prefix"),
+			"Missing Path Prefix",
+			"strip_prefix requires path_prefix to be set",
+		)
+	}
+
+	return diags
+}
+
+// validateSigtermTimeout rejects a SIGTERM timeout longer than the drain
+// period, since terminating replicas are killed once the drain period ends.
+// Without a drain period the platform default applies and is not checked.
+func validateSigtermTimeout(settings *SettingsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if settings == nil {
+		return diags
+	}
+	timeout, drain := settings.SigtermTimeoutSeconds, settings.ScaleDownDrainSeconds
+	if timeout.IsNull() || timeout.IsUnknown() || drain.IsNull() || drain.IsUnknown() {
+		return diags
+	}
+
+	if timeout.ValueInt64() > drain.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("settings").AtName("sigterm_timeout_seconds"),
+			"Invalid SIGTERM Timeout",
+			fmt.Sprintf("sigterm_timeout_seconds (%d) must not exceed scale_down_drain_seconds (%d)", timeout.ValueInt64(), drain.ValueInt64()),
+		)
+	}
+
+	return diags
+}
+
+// validateHealthCheckTiming requires a health check to time out before the
+// next one starts. Unset or unknown values are not checked.
+func validateHealthCheckTiming(settings *SettingsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if settings == nil {
+		return diags
+	}
+	timeout, interval := settings.HealthCheckTimeout, settings.HealthCheckInterval
+	if timeout.IsNull() || timeout.IsUnknown() || interval.IsNull() || interval.IsUnknown() {
+		return diags
+	}
+
+	if timeout.ValueInt64() >= interval.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("settings").AtName("health_check_timeout"),
+			"Invalid Health Check Timeout",
+			fmt.Sprintf("health_check_timeout (%d) must be less than health_check_interval (%d)", timeout.ValueInt64(), interval.ValueInt64()),
+		)
+	}
+
+	return diags
+}
+
+// validateProcesses requires unique process names including a web process,
+// and rejects start_command alongside processes since the web process command
+// replaces it. Unknown names are skipped.
+func validateProcesses(processes []ProcessModel, startCommand types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(processes) == 0 {
+		return diags
+	}
+
+	if !startCommand.IsNull() {
+		diags.AddAttributeError(
+			path.Root("start_command"),
+			"Conflicting Start Command",
+			"start_command cannot be combined with processes, set the command of the web process instead",
+		)
+	}
+
+	seen := make(map[string]bool, len(processes))
+	allKnown := true
+	for i, process := range processes {
+		if process.Name.IsNull() || process.Name.IsUnknown() {
+			allKnown = false
+			continue
+		}
+		name := process.Name.ValueString()
+		if seen[name] {
+			diags.AddAttributeError(
+				path.Root("processes").AtListIndex(i).AtName("name"),
+				"Duplicate Process Name",
+				fmt.Sprintf("process %q is defined more than once", name),
+			)
+		}
+		seen[name] = true
+	}
+
+	if allKnown && !seen["web"] {
+		diags.AddAttributeError(
+			path.Root("processes"),
+			"Missing Web Process",
+			"processes must include a process named \"web\" that serves HTTP traffic",
+		)
+	}
+
+	return diags
+}
+
+// validateErrorPages requires exactly one of content and url per error page.
+// Unknown values count as set.
+func validateErrorPages(pages map[string]ErrorPageModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for status, page := range pages {
+		hasContent, hasURL := !page.Content.IsNull(), !page.URL.IsNull()
+		if hasContent == hasURL {
+			diags.AddAttributeError(
+				path.Root("error_pages").AtMapKey(status),
+				"Invalid Error Page",
+				fmt.Sprintf("error page %s must set exactly one of content or url", status),
+			)
+		}
+	}
+	return diags
+}
+
+// validateIngressCIDRs reports CIDR blocks that are both allowed and denied.
+// Blocks are compared by network, so "10.0.0.1/8" and "10.0.0.0/8" conflict.
+func validateIngressCIDRs(ctx context.Context, allow, deny types.List) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if allow.IsNull() || allow.IsUnknown() || deny.IsNull() || deny.IsUnknown() {
+		return diags
+	}
+
+	var allowed, denied []types.String
+	diags.Append(allow.ElementsAs(ctx, &allowed, false)...)
+	diags.Append(deny.ElementsAs(ctx, &denied, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	allowedNetworks := make(map[string]string, len(allowed))
+	for _, cidr := range allowed {
+		if network, ok := cidrNetwork(cidr); ok {
+			allowedNetworks[network] = cidr.ValueString()
+		}
+	}
+
+	for i, cidr := range denied {
+		network, ok := cidrNetwork(cidr)
+		if !ok {
+			continue
+		}
+		if allowedCIDR, conflict := allowedNetworks[network]; conflict {
+			diags.AddAttributeError(
+				path.Root("ingress_deny_cidrs").AtListIndex(i),
+				"Conflicting Ingress CIDR",
+				fmt.Sprintf("%q is denied but also allowed as %q in ingress_allow_cidrs", cidr.ValueString(), allowedCIDR),
+			)
+		}
+	}
+
+	return diags
+}
+
+// cidrNetwork returns the canonical network of a known, valid CIDR block
+func cidrNetwork(value types.String) (string, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return "", false
+	}
+	_, network, err := net.ParseCIDR(value.ValueString())
+	if err != nil {
+		return "", false
+	}
+	return network.String(), true
+}
+
+// validateBasicAuth requires credentials when basic auth is enabled. An unset
+// enabled attribute defaults to true.
+func validateBasicAuth(data *BasicAuthModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data == nil || (!data.Enabled.IsNull() && !data.Enabled.IsUnknown() && !data.Enabled.ValueBool()) {
+		return diags
+	}
+
+	if data.Username.IsNull() || (!data.Username.IsUnknown() && data.Username.ValueString() == "") {
+		diags.AddAttributeError(
+			path.Root("basic_auth").AtName("username"),
+			"Missing Basic Auth Username",
+			"username must be set when basic_auth is enabled",
+		)
+	}
+	if data.Password.IsNull() || (!data.Password.IsUnknown() && data.Password.ValueString() == "") {
+		diags.AddAttributeError(
+			path.Root("basic_auth").AtName("password"),
+			"Missing Basic Auth Password",
+			"password must be set when basic_auth is enabled",
+		)
+	}
+	return diags
+}
+
+// validateAutoSleep requires an idle timeout when auto sleep is enabled. An
+// unset enabled attribute defaults to true.
+func validateAutoSleep(data *AutoSleepModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data == nil || (!data.Enabled.IsNull() && !data.Enabled.IsUnknown() && !data.Enabled.ValueBool()) {
+		return diags
+	}
+
+	if data.IdleTimeoutMinutes.IsNull() {
+		diags.AddAttributeError(
+			path.Root("auto_sleep").AtName("idle_timeout_minutes"),
+			"Missing Auto Sleep Idle Timeout",
+			"idle_timeout_minutes must be set when auto_sleep is enabled",
+		)
+	}
+	return diags
+}
+
+// validateNetworkID checks that a configured network_id refers to a known network
+func (r *ApplicationResource) validateNetworkID(ctx context.Context, networkID types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if networkID.IsNull() || networkID.IsUnknown() {
+		return diags
+	}
+
+	networks, err := r.client.ListNetworks(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list networks, got error: %s", err))
+		return diags
+	}
+
+	for _, network := range networks {
+		if network.ID == networkID.ValueInt64() {
+			return diags
+		}
+	}
+
+	diags.AddAttributeError(
+		path.Root("network_id"),
+		"Unknown Network",
+		fmt.Sprintf("Network %d does not exist or is not accessible with the configured API token", networkID.ValueInt64()),
+	)
+	return diags
+}
+
+// egressDiagnostics warns when a static egress IP was requested but none was assigned,
+// typically because the plan does not include static egress
+func egressDiagnostics(data *ApplicationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Egress == nil || !data.Egress.StaticIP.ValueBool() || !data.EgressIP.IsNull() {
+		return diags
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("egress").AtName("static_ip"),
+		"Static Egress IP Unavailable",
+		"A static egress IP was requested but none has been assigned to the application. Static egress may not be available on the current plan; outbound traffic uses shared IP addresses until it is.",
+	)
+	return diags
 }
 
 func (r *ApplicationResource) toAPIModel(data *ApplicationResourceModel) *client.Application {
@@ -402,6 +1741,13 @@ func (r *ApplicationResource) toAPIModel(data *ApplicationResourceModel) *client
 		app.SocialAccountID = data.SocialAccountID.ValueInt64()
 	}
 
+	if !data.NetworkID.IsNull() {
+		app.NetworkID = data.NetworkID.ValueInt64()
+	}
+	if !data.TemplateID.IsNull() && !data.TemplateID.IsUnknown() {
+		app.TemplateID = data.TemplateID.ValueInt64()
+	}
+
 	if data.Runtime != nil {
 		if !data.Runtime.PHPVersion.IsNull() {
 			app.PHPVersion = data.Runtime.PHPVersion.ValueString()
@@ -415,6 +1761,15 @@ func (r *ApplicationResource) toAPIModel(data *ApplicationResourceModel) *client
 		if !data.Settings.HealthCheckPath.IsNull() {
 			app.HealthCheckPath = data.Settings.HealthCheckPath.ValueString()
 		}
+		if !data.Settings.HealthCheckInterval.IsNull() && !data.Settings.HealthCheckInterval.IsUnknown() {
+			app.HealthCheckInterval = data.Settings.HealthCheckInterval.ValueInt64()
+		}
+		if !data.Settings.HealthCheckTimeout.IsNull() && !data.Settings.HealthCheckTimeout.IsUnknown() {
+			app.HealthCheckTimeout = data.Settings.HealthCheckTimeout.ValueInt64()
+		}
+		if !data.Settings.HealthCheckFailures.IsNull() && !data.Settings.HealthCheckFailures.IsUnknown() {
+			app.HealthCheckFailures = data.Settings.HealthCheckFailures.ValueInt64()
+		}
 		if !data.Settings.SchedulerEnabled.IsNull() {
 			app.SchedulerEnabled = data.Settings.SchedulerEnabled.ValueBool()
 		}
@@ -427,6 +1782,24 @@ func (r *ApplicationResource) toAPIModel(data *ApplicationResourceModel) *client
 		if !data.Settings.MemoryRequest.IsNull() {
 			app.MemoryRequest = data.Settings.MemoryRequest.ValueString()
 		}
+		if !data.Settings.SchedulerConcurrencyPolicy.IsNull() {
+			app.SchedulerConcurrencyPolicy = data.Settings.SchedulerConcurrencyPolicy.ValueString()
+		}
+		if !data.Settings.ScaleDownDrainSeconds.IsNull() && !data.Settings.ScaleDownDrainSeconds.IsUnknown() {
+			app.ScaleDownDrainSeconds = data.Settings.ScaleDownDrainSeconds.ValueInt64Pointer()
+		}
+		if !data.Settings.OOMRestartPolicy.IsNull() {
+			app.OOMRestartPolicy = data.Settings.OOMRestartPolicy.ValueString()
+		}
+		if !data.Settings.OOMScoreAdjust.IsNull() && !data.Settings.OOMScoreAdjust.IsUnknown() {
+			app.OOMScoreAdjust = data.Settings.OOMScoreAdjust.ValueInt64Pointer()
+		}
+		if !data.Settings.SigtermTimeoutSeconds.IsNull() && !data.Settings.SigtermTimeoutSeconds.IsUnknown() {
+			app.SigtermTimeoutSeconds = data.Settings.SigtermTimeoutSeconds.ValueInt64Pointer()
+		}
+		if !data.Settings.MaxConcurrentRequests.IsNull() && !data.Settings.MaxConcurrentRequests.IsUnknown() {
+			app.MaxConcurrentRequests = data.Settings.MaxConcurrentRequests.ValueInt64()
+		}
 	}
 
 	if !data.BuildCommands.IsNull() {
@@ -437,6 +1810,65 @@ func (r *ApplicationResource) toAPIModel(data *ApplicationResourceModel) *client
 		}
 	}
 
+	if !data.BuildTimeoutSeconds.IsNull() && !data.BuildTimeoutSeconds.IsUnknown() {
+		app.BuildTimeoutSeconds = data.BuildTimeoutSeconds.ValueInt64()
+	}
+
+	if !data.InitCPURequest.IsNull() && !data.InitCPURequest.IsUnknown() {
+		app.InitCPURequest = data.InitCPURequest.ValueString()
+	}
+
+	if !data.InitMemoryRequest.IsNull() && !data.InitMemoryRequest.IsUnknown() {
+		app.InitMemoryRequest = data.InitMemoryRequest.ValueString()
+	}
+
+	if data.BuildCache != nil {
+		app.BuildCache = buildCacheToAPI(data.BuildCache)
+	}
+
+	if len(data.Sidecars) > 0 {
+		app.Sidecars = sidecarsToAPI(data.Sidecars)
+	}
+
+	if len(data.HostAliases) > 0 {
+		app.HostAliases = hostAliasesToAPI(data.HostAliases)
+	}
+
+	if len(data.Processes) > 0 {
+		app.Processes = processesToAPI(data.Processes)
+	}
+
+	if data.Canary != nil {
+		app.Canary = canaryToAPI(data.Canary)
+	}
+
+	if data.AutoSleep != nil {
+		app.AutoSleep = autoSleepToAPI(data.AutoSleep)
+	}
+
+	if data.MaintenanceWindow != nil {
+		app.MaintenanceWindow = maintenanceWindowToAPI(data.MaintenanceWindow)
+	}
+
+	if data.Egress != nil {
+		app.Egress = egressToAPI(data.Egress)
+	}
+
+	if data.BasicAuth != nil {
+		app.BasicAuth = basicAuthToAPI(data.BasicAuth)
+	}
+
+	if data.Headers != nil {
+		app.Headers = headersToAPI(data.Headers)
+	}
+
+	if data.Compression != nil {
+		app.Compression = compressionToAPI(data.Compression)
+	}
+
+	app.Ingress = ingressToAPI(data)
+	app.Lifecycle = lifecycleToAPI(data)
+
 	if !data.InitCommands.IsNull() {
 		elements := make([]types.String, 0, len(data.InitCommands.Elements()))
 		data.InitCommands.ElementsAs(context.Background(), &elements, false)
@@ -449,6 +1881,10 @@ func (r *ApplicationResource) toAPIModel(data *ApplicationResourceModel) *client
 		app.StartCommand = data.StartCommand.ValueString()
 	}
 
+	if !data.LogLevel.IsNull() && data.LogLevel.ValueString() != "" {
+		app.LogLevel = data.LogLevel.ValueString()
+	}
+
 	if !data.PHPExtensions.IsNull() {
 		elements := make([]types.String, 0, len(data.PHPExtensions.Elements()))
 		data.PHPExtensions.ElementsAs(context.Background(), &elements, false)
@@ -501,6 +1937,15 @@ func (r *ApplicationResource) toUpdateAPIModel(data *ApplicationResourceModel) m
 		if !data.Settings.HealthCheckPath.IsNull() {
 			update["health_check_path"] = data.Settings.HealthCheckPath.ValueString()
 		}
+		if !data.Settings.HealthCheckInterval.IsNull() && !data.Settings.HealthCheckInterval.IsUnknown() {
+			update["health_check_interval"] = data.Settings.HealthCheckInterval.ValueInt64()
+		}
+		if !data.Settings.HealthCheckTimeout.IsNull() && !data.Settings.HealthCheckTimeout.IsUnknown() {
+			update["health_check_timeout"] = data.Settings.HealthCheckTimeout.ValueInt64()
+		}
+		if !data.Settings.HealthCheckFailures.IsNull() && !data.Settings.HealthCheckFailures.IsUnknown() {
+			update["health_check_failure_threshold"] = data.Settings.HealthCheckFailures.ValueInt64()
+		}
 		if !data.Settings.SchedulerEnabled.IsNull() {
 			update["scheduler_enabled"] = data.Settings.SchedulerEnabled.ValueBool()
 		}
@@ -513,6 +1958,91 @@ func (r *ApplicationResource) toUpdateAPIModel(data *ApplicationResourceModel) m
 		if !data.Settings.MemoryRequest.IsNull() {
 			update["memory_request"] = data.Settings.MemoryRequest.ValueString()
 		}
+		if !data.Settings.SchedulerConcurrencyPolicy.IsNull() {
+			update["scheduler_concurrency_policy"] = data.Settings.SchedulerConcurrencyPolicy.ValueString()
+		}
+		if !data.Settings.ScaleDownDrainSeconds.IsNull() {
+			update["scale_down_drain_seconds"] = data.Settings.ScaleDownDrainSeconds.ValueInt64()
+		}
+		if !data.Settings.OOMRestartPolicy.IsNull() {
+			update["oom_restart_policy"] = data.Settings.OOMRestartPolicy.ValueString()
+		}
+		if !data.Settings.OOMScoreAdjust.IsNull() && !data.Settings.OOMScoreAdjust.IsUnknown() {
+			update["oom_score_adjust"] = data.Settings.OOMScoreAdjust.ValueInt64()
+		}
+		if !data.Settings.SigtermTimeoutSeconds.IsNull() && !data.Settings.SigtermTimeoutSeconds.IsUnknown() {
+			update["sigterm_timeout_seconds"] = data.Settings.SigtermTimeoutSeconds.ValueInt64()
+		}
+		if !data.Settings.MaxConcurrentRequests.IsNull() && !data.Settings.MaxConcurrentRequests.IsUnknown() {
+			update["max_concurrent_requests"] = data.Settings.MaxConcurrentRequests.ValueInt64()
+		}
+	}
+
+	if !data.BuildTimeoutSeconds.IsNull() && !data.BuildTimeoutSeconds.IsUnknown() {
+		update["build_timeout_seconds"] = data.BuildTimeoutSeconds.ValueInt64()
+	}
+
+	if !data.InitCPURequest.IsNull() && !data.InitCPURequest.IsUnknown() {
+		update["init_cpu_request"] = data.InitCPURequest.ValueString()
+	}
+
+	if !data.InitMemoryRequest.IsNull() && !data.InitMemoryRequest.IsUnknown() {
+		update["init_memory_request"] = data.InitMemoryRequest.ValueString()
+	}
+
+	if data.BuildCache != nil {
+		update["build_cache"] = buildCacheToAPI(data.BuildCache)
+	}
+
+	if data.Canary != nil {
+		update["canary"] = canaryToAPI(data.Canary)
+	}
+
+	if data.AutoSleep != nil {
+		update["auto_sleep"] = autoSleepToAPI(data.AutoSleep)
+	}
+
+	if data.MaintenanceWindow != nil {
+		update["maintenance_window"] = maintenanceWindowToAPI(data.MaintenanceWindow)
+	}
+
+	if data.Egress != nil {
+		update["egress"] = egressToAPI(data.Egress)
+	}
+
+	if data.BasicAuth != nil {
+		update["basic_auth"] = basicAuthToAPI(data.BasicAuth)
+	}
+
+	if data.Headers != nil {
+		update["headers"] = headersToAPI(data.Headers)
+	}
+
+	if data.Compression != nil {
+		update["compression"] = compressionToAPI(data.Compression)
+	}
+
+	if ingress := ingressToAPI(data); ingress != nil {
+		update["ingress"] = ingress
+	}
+
+	if lifecycle := lifecycleToAPI(data); lifecycle != nil {
+		update["lifecycle"] = lifecycle
+	}
+
+	// An empty (non-nil) list is sent so removing every block clears the sidecars
+	if data.Sidecars != nil {
+		update["sidecars"] = sidecarsToAPI(data.Sidecars)
+	}
+
+	// Same for host aliases
+	if data.HostAliases != nil {
+		update["host_aliases"] = hostAliasesToAPI(data.HostAliases)
+	}
+
+	// And for processes
+	if data.Processes != nil {
+		update["processes"] = processesToAPI(data.Processes)
 	}
 
 	// Build and init commands
@@ -587,6 +2117,17 @@ func (r *ApplicationResource) toUpdateAPIModel(data *ApplicationResourceModel) m
 		update["custom_manifests"] = data.CustomManifests.ValueString()
 	}
 
+	if !data.LogLevel.IsNull() && data.LogLevel.ValueString() != "" {
+		update["log_level"] = data.LogLevel.ValueString()
+	}
+
+	if !data.NetworkID.IsNull() {
+		update["network_id"] = data.NetworkID.ValueInt64()
+	}
+	if !data.TemplateID.IsNull() && !data.TemplateID.IsUnknown() {
+		update["template_id"] = data.TemplateID.ValueInt64()
+	}
+
 	return update
 }
 
@@ -595,9 +2136,11 @@ func (r *ApplicationResource) fromAPIModel(app *client.Application, data *Applic
 	data.Name = types.StringValue(app.Name)
 	data.Type = types.StringValue(app.Type)
 	
-	// Don't update application_version if API returns empty string when we had null
-	if app.ApplicationVersion != "" || !data.ApplicationVersion.IsNull() {
+	// Handle ApplicationVersion - preserve planned value if API returns empty
+	if app.ApplicationVersion != "" {
 		data.ApplicationVersion = types.StringValue(app.ApplicationVersion)
+	} else if data.ApplicationVersion.IsNull() || data.ApplicationVersion.IsUnknown() {
+		data.ApplicationVersion = types.StringNull()
 	}
 	
 	data.URL = types.StringValue(app.URL)
@@ -613,26 +2156,154 @@ func (r *ApplicationResource) fromAPIModel(app *client.Application, data *Applic
 	if app.RepositoryURL != "" {
 		data.RepositoryURL = types.StringValue(app.RepositoryURL)
 	}
-	if app.RepositoryOwner != "" {
-		data.RepositoryOwner = types.StringValue(app.RepositoryOwner)
+	if app.RepositoryOwner != "" {
+		data.RepositoryOwner = types.StringValue(app.RepositoryOwner)
+	}
+	if app.RepositoryName != "" {
+		data.RepositoryName = types.StringValue(app.RepositoryName)
+	}
+	if app.DefaultBranch != "" {
+		data.DefaultBranch = types.StringValue(app.DefaultBranch)
+	}
+	if app.Region != "" {
+		data.Region = types.StringValue(app.Region)
+	}
+	
+	// Preserve the planned cloud provider value - API changes from "default" to "github"
+	// Don't update if we already have a value and the API is returning a different one
+
+	if app.SocialAccountID != 0 {
+		data.SocialAccountID = types.Int64Value(app.SocialAccountID)
+	}
+
+	if app.NetworkID != 0 {
+		data.NetworkID = types.Int64Value(app.NetworkID)
+	} else if data.NetworkID.IsUnknown() {
+		data.NetworkID = types.Int64Null()
+	}
+
+	if app.TemplateID != 0 {
+		data.TemplateID = types.Int64Value(app.TemplateID)
+	} else if data.TemplateID.IsUnknown() {
+		data.TemplateID = types.Int64Null()
+	}
+
+	// A requested static IP is kept in state even when the plan does not offer
+	// it, egressDiagnostics warns about that case instead
+	if data.Egress != nil && app.Egress != nil && app.Egress.StaticIP {
+		data.Egress.StaticIP = types.BoolValue(true)
+	}
+
+	// Only track header rules when they are configured
+	if data.Headers != nil && app.Headers != nil {
+		if len(app.Headers.Add) > 0 {
+			data.Headers.Add, _ = types.MapValueFrom(context.Background(), types.StringType, app.Headers.Add)
+		}
+		if len(app.Headers.Set) > 0 {
+			data.Headers.Set, _ = types.MapValueFrom(context.Background(), types.StringType, app.Headers.Set)
+		}
+		if len(app.Headers.Remove) > 0 {
+			data.Headers.Remove, _ = types.ListValueFrom(context.Background(), types.StringType, app.Headers.Remove)
+		}
+	}
+
+	// Only track compression when it is configured
+	if data.Compression != nil && app.Compression != nil {
+		data.Compression.Enabled = types.BoolValue(app.Compression.Enabled)
+		if len(app.Compression.Types) > 0 {
+			data.Compression.Types, _ = types.ListValueFrom(context.Background(), types.StringType, app.Compression.Types)
+		}
+		if app.Compression.MinSizeBytes > 0 {
+			data.Compression.MinSizeBytes = types.Int64Value(app.Compression.MinSizeBytes)
+		}
+	}
+
+	// Ingress IP filtering - preserve planned lists when the API returns none
+	if app.Ingress != nil && len(app.Ingress.AllowCIDRs) > 0 {
+		data.IngressAllowCIDRs, _ = types.ListValueFrom(context.Background(), types.StringType, app.Ingress.AllowCIDRs)
+	} else if data.IngressAllowCIDRs.IsNull() || data.IngressAllowCIDRs.IsUnknown() {
+		data.IngressAllowCIDRs = types.ListNull(types.StringType)
+	}
+	if app.Ingress != nil && len(app.Ingress.DenyCIDRs) > 0 {
+		data.IngressDenyCIDRs, _ = types.ListValueFrom(context.Background(), types.StringType, app.Ingress.DenyCIDRs)
+	} else if data.IngressDenyCIDRs.IsNull() || data.IngressDenyCIDRs.IsUnknown() {
+		data.IngressDenyCIDRs = types.ListNull(types.StringType)
+	}
+
+	// Protocol toggles - the API reports the effective value, including platform defaults
+	if app.Ingress != nil && app.Ingress.HTTP2Enabled != nil {
+		data.HTTP2Enabled = types.BoolPointerValue(app.Ingress.HTTP2Enabled)
+	} else if data.HTTP2Enabled.IsUnknown() {
+		data.HTTP2Enabled = types.BoolNull()
+	}
+	if app.Ingress != nil && app.Ingress.HTTP3Enabled != nil {
+		data.HTTP3Enabled = types.BoolPointerValue(app.Ingress.HTTP3Enabled)
+	} else if data.HTTP3Enabled.IsUnknown() {
+		data.HTTP3Enabled = types.BoolNull()
+	}
+
+	// Path routing - keep the planned prefix when the API does not echo it
+	var pathPrefix string
+	if app.Ingress != nil && app.Ingress.PathPrefix != nil {
+		pathPrefix = *app.Ingress.PathPrefix
+	}
+	data.PathPrefix = stringValueOrPlanned(pathPrefix, data.PathPrefix)
+	if app.Ingress != nil && app.Ingress.StripPrefix != nil {
+		data.StripPrefix = types.BoolPointerValue(app.Ingress.StripPrefix)
+	} else if data.StripPrefix.IsUnknown() {
+		data.StripPrefix = types.BoolNull()
+	}
+
+	if app.Ingress != nil && app.Ingress.ErrorPages != nil {
+		errorPages := make(map[string]ErrorPageModel, len(app.Ingress.ErrorPages))
+		for status, page := range app.Ingress.ErrorPages {
+			errorPages[status] = ErrorPageModel{
+				Content: stringValueOrPlanned(page.Content, types.StringNull()),
+				URL:     stringValueOrPlanned(page.URL, types.StringNull()),
+			}
+		}
+		data.ErrorPages = errorPages
 	}
-	if app.RepositoryName != "" {
-		data.RepositoryName = types.StringValue(app.RepositoryName)
+
+	// The password is never returned, the hash is derived from the known credentials
+	if data.BasicAuth != nil {
+		if app.BasicAuth != nil {
+			data.BasicAuth.Enabled = types.BoolValue(app.BasicAuth.Enabled)
+			if app.BasicAuth.Username != "" {
+				data.BasicAuth.Username = types.StringValue(app.BasicAuth.Username)
+			}
+		}
+		data.BasicAuth.ValueHash = types.StringValue(basicAuthValueHash(data.BasicAuth.Username.ValueString(), data.BasicAuth.Password.ValueString()))
 	}
-	if app.DefaultBranch != "" {
-		data.DefaultBranch = types.StringValue(app.DefaultBranch)
+
+	if app.EgressIP != "" {
+		data.EgressIP = types.StringValue(app.EgressIP)
+	} else {
+		data.EgressIP = types.StringNull()
 	}
-	if app.Region != "" {
-		data.Region = types.StringValue(app.Region)
+
+	if app.Cluster != "" {
+		data.Cluster = types.StringValue(app.Cluster)
+	} else {
+		data.Cluster = types.StringNull()
 	}
-	
-	// Preserve the planned cloud provider value - API changes from "default" to "github"
-	// Don't update if we already have a value and the API is returning a different one
 
-	if app.SocialAccountID != 0 {
-		data.SocialAccountID = types.Int64Value(app.SocialAccountID)
+	if app.Zone != "" {
+		data.Zone = types.StringValue(app.Zone)
+	} else {
+		data.Zone = types.StringNull()
+	}
+
+	if app.InternalHostname != "" {
+		data.InternalHostname = types.StringValue(app.InternalHostname)
+	} else {
+		data.InternalHostname = types.StringNull()
 	}
 
+	// Not every response carries the deployed commit, keep the last one seen
+	data.DeployedCommitSHA = stringValueOrPlanned(app.DeployedCommitSHA, data.DeployedCommitSHA)
+	data.DeployedCommitMessage = stringValueOrPlanned(app.DeployedCommitMessage, data.DeployedCommitMessage)
+
 	if data.Runtime == nil {
 		data.Runtime = &RuntimeModel{}
 	}
@@ -670,6 +2341,24 @@ func (r *ApplicationResource) fromAPIModel(app *client.Application, data *Applic
 	} else if data.Settings.HealthCheckPath.IsNull() {
 		data.Settings.HealthCheckPath = types.StringNull()
 	}
+
+	if app.HealthCheckInterval != 0 {
+		data.Settings.HealthCheckInterval = types.Int64Value(app.HealthCheckInterval)
+	} else if data.Settings.HealthCheckInterval.IsUnknown() {
+		data.Settings.HealthCheckInterval = types.Int64Null()
+	}
+
+	if app.HealthCheckTimeout != 0 {
+		data.Settings.HealthCheckTimeout = types.Int64Value(app.HealthCheckTimeout)
+	} else if data.Settings.HealthCheckTimeout.IsUnknown() {
+		data.Settings.HealthCheckTimeout = types.Int64Null()
+	}
+
+	if app.HealthCheckFailures != 0 {
+		data.Settings.HealthCheckFailures = types.Int64Value(app.HealthCheckFailures)
+	} else if data.Settings.HealthCheckFailures.IsUnknown() {
+		data.Settings.HealthCheckFailures = types.Int64Null()
+	}
 	
 	// Always update scheduler_enabled from API as it's a boolean
 	data.Settings.SchedulerEnabled = types.BoolValue(app.SchedulerEnabled)
@@ -695,6 +2384,51 @@ func (r *ApplicationResource) fromAPIModel(app *client.Application, data *Applic
 	// Note: If there's a persistent mismatch (e.g., API returns "1Gi" but we planned "512Mi"),
 	// the API value takes precedence to reflect the actual state
 
+	if app.SchedulerConcurrencyPolicy != "" {
+		data.Settings.SchedulerConcurrencyPolicy = types.StringValue(app.SchedulerConcurrencyPolicy)
+	} else if data.Settings.SchedulerConcurrencyPolicy.IsUnknown() {
+		data.Settings.SchedulerConcurrencyPolicy = types.StringNull()
+	}
+
+	if app.ScaleDownDrainSeconds != nil {
+		data.Settings.ScaleDownDrainSeconds = types.Int64PointerValue(app.ScaleDownDrainSeconds)
+	} else if data.Settings.ScaleDownDrainSeconds.IsUnknown() {
+		data.Settings.ScaleDownDrainSeconds = types.Int64Null()
+	}
+
+	if app.OOMRestartPolicy != "" {
+		data.Settings.OOMRestartPolicy = types.StringValue(app.OOMRestartPolicy)
+	} else if data.Settings.OOMRestartPolicy.IsUnknown() {
+		data.Settings.OOMRestartPolicy = types.StringNull()
+	}
+
+	// 0 is a valid adjustment, so only a missing field keeps the planned value
+	if app.OOMScoreAdjust != nil {
+		data.Settings.OOMScoreAdjust = types.Int64PointerValue(app.OOMScoreAdjust)
+	} else if data.Settings.OOMScoreAdjust.IsUnknown() {
+		data.Settings.OOMScoreAdjust = types.Int64Null()
+	}
+
+	// 0 kills right after SIGTERM, so only a missing field keeps the planned value
+	if app.SigtermTimeoutSeconds != nil {
+		data.Settings.SigtermTimeoutSeconds = types.Int64PointerValue(app.SigtermTimeoutSeconds)
+	} else if data.Settings.SigtermTimeoutSeconds.IsUnknown() {
+		data.Settings.SigtermTimeoutSeconds = types.Int64Null()
+	}
+
+	if app.MaxConcurrentRequests != 0 {
+		data.Settings.MaxConcurrentRequests = types.Int64Value(app.MaxConcurrentRequests)
+	} else if data.Settings.MaxConcurrentRequests.IsUnknown() {
+		data.Settings.MaxConcurrentRequests = types.Int64Null()
+	}
+
+	// The delay is part of the ingress config, 0 deregisters without waiting
+	if app.Ingress != nil && app.Ingress.DeregistrationDelaySeconds != nil {
+		data.Settings.DeregistrationDelaySeconds = types.Int64PointerValue(app.Ingress.DeregistrationDelaySeconds)
+	} else if data.Settings.DeregistrationDelaySeconds.IsUnknown() {
+		data.Settings.DeregistrationDelaySeconds = types.Int64Null()
+	}
+
 	// Handle build commands - preserve if API returns empty array
 	if len(app.BuildCommands) > 0 {
 		elements := make([]types.String, len(app.BuildCommands))
@@ -706,6 +2440,118 @@ func (r *ApplicationResource) fromAPIModel(app *client.Application, data *Applic
 		data.BuildCommands = types.ListNull(types.StringType)
 	}
 
+	if app.BuildTimeoutSeconds > 0 {
+		data.BuildTimeoutSeconds = types.Int64Value(app.BuildTimeoutSeconds)
+	} else if data.BuildTimeoutSeconds.IsUnknown() {
+		data.BuildTimeoutSeconds = types.Int64Null()
+	}
+
+	data.InitCPURequest = stringValueOrPlanned(app.InitCPURequest, data.InitCPURequest)
+
+	// Empty hooks from the API read back as null, never as ""
+	var lifecycle client.Lifecycle
+	if app.Lifecycle != nil {
+		lifecycle = *app.Lifecycle
+	}
+	data.PreStopCommand = stringValueOrPlanned(lifecycle.PreStopCommand, data.PreStopCommand)
+	data.PostStartCommand = stringValueOrPlanned(lifecycle.PostStartCommand, data.PostStartCommand)
+	data.InitMemoryRequest = stringValueOrPlanned(app.InitMemoryRequest, data.InitMemoryRequest)
+
+	// Only track the build cache when it is configured
+	if data.BuildCache != nil && app.BuildCache != nil {
+		data.BuildCache.Enabled = types.BoolValue(app.BuildCache.Enabled)
+		if app.BuildCache.Key != "" {
+			data.BuildCache.Key = types.StringValue(app.BuildCache.Key)
+		}
+	}
+
+	// Only track the canary settings when they are configured
+	if data.Canary != nil && app.Canary != nil {
+		data.Canary.Enabled = types.BoolValue(app.Canary.Enabled)
+		data.Canary.TrafficPercentage = types.Int64Value(app.Canary.TrafficPercentage)
+		if app.Canary.PromoteAfterSeconds > 0 {
+			data.Canary.PromoteAfterSeconds = types.Int64Value(app.Canary.PromoteAfterSeconds)
+		}
+	}
+
+	// Only track auto sleep when it is configured
+	if data.AutoSleep != nil && app.AutoSleep != nil {
+		data.AutoSleep.Enabled = types.BoolValue(app.AutoSleep.Enabled)
+		if app.AutoSleep.IdleTimeoutMinutes > 0 {
+			data.AutoSleep.IdleTimeoutMinutes = types.Int64Value(app.AutoSleep.IdleTimeoutMinutes)
+		}
+	}
+
+	// Only track the maintenance window when it is configured
+	if data.MaintenanceWindow != nil && app.MaintenanceWindow != nil {
+		if app.MaintenanceWindow.DayOfWeek != "" {
+			data.MaintenanceWindow.DayOfWeek = types.StringValue(app.MaintenanceWindow.DayOfWeek)
+		}
+		if app.MaintenanceWindow.StartTime != "" {
+			data.MaintenanceWindow.StartTime = types.StringValue(app.MaintenanceWindow.StartTime)
+		}
+		if app.MaintenanceWindow.DurationMinutes > 0 {
+			data.MaintenanceWindow.DurationMinutes = types.Int64Value(app.MaintenanceWindow.DurationMinutes)
+		}
+		if app.MaintenanceWindow.Timezone != "" {
+			data.MaintenanceWindow.Timezone = types.StringValue(app.MaintenanceWindow.Timezone)
+		}
+	}
+
+	// Handle sidecars - keep planned optional values the API does not echo back
+	if app.Sidecars != nil {
+		sidecars := make([]SidecarModel, len(app.Sidecars))
+		for i, sc := range app.Sidecars {
+			var planned SidecarModel
+			if i < len(data.Sidecars) {
+				planned = data.Sidecars[i]
+			}
+			sidecars[i] = SidecarModel{
+				Name:          types.StringValue(sc.Name),
+				Image:         types.StringValue(sc.Image),
+				Command:       stringValueOrPlanned(sc.Command, planned.Command),
+				CPURequest:    stringValueOrPlanned(sc.CPURequest, planned.CPURequest),
+				MemoryRequest: stringValueOrPlanned(sc.MemoryRequest, planned.MemoryRequest),
+			}
+		}
+		data.Sidecars = sidecars
+	}
+
+	if app.HostAliases != nil {
+		hostAliases := make([]HostAliasModel, len(app.HostAliases))
+		for i, alias := range app.HostAliases {
+			hostAliases[i] = HostAliasModel{
+				Hostname: types.StringValue(alias.Hostname),
+				IP:       types.StringValue(alias.IP),
+			}
+		}
+		data.HostAliases = hostAliases
+	}
+
+	// Handle processes - keep planned optional values the API does not echo back
+	if app.Processes != nil {
+		processes := make([]ProcessModel, len(app.Processes))
+		for i, process := range app.Processes {
+			var planned ProcessModel
+			if i < len(data.Processes) {
+				planned = data.Processes[i]
+			}
+			processes[i] = ProcessModel{
+				Name:          types.StringValue(process.Name),
+				Command:       types.StringValue(process.Command),
+				Replicas:      types.Int64Null(),
+				CPURequest:    stringValueOrPlanned(process.CPURequest, planned.CPURequest),
+				MemoryRequest: stringValueOrPlanned(process.MemoryRequest, planned.MemoryRequest),
+			}
+			if process.Replicas != 0 {
+				processes[i].Replicas = types.Int64Value(process.Replicas)
+			} else if !planned.Replicas.IsUnknown() {
+				processes[i].Replicas = planned.Replicas
+			}
+		}
+		data.Processes = processes
+	}
+
 	// Handle init commands - preserve if API returns empty array
 	if len(app.InitCommands) > 0 {
 		elements := make([]types.String, len(app.InitCommands))
@@ -725,6 +2571,13 @@ func (r *ApplicationResource) fromAPIModel(app *client.Application, data *Applic
 	}
 	// If planned value exists and API returns empty, keep the planned value
 
+	// Handle LogLevel - preserve planned value if API returns empty
+	if app.LogLevel != "" {
+		data.LogLevel = types.StringValue(app.LogLevel)
+	} else if data.LogLevel.IsNull() {
+		data.LogLevel = types.StringNull()
+	}
+
 	// Handle PHP extensions - preserve if API returns empty array
 	if len(app.PHPExtensions) > 0 {
 		elements := make([]types.String, len(app.PHPExtensions))
@@ -747,14 +2600,580 @@ func (r *ApplicationResource) fromAPIModel(app *client.Application, data *Applic
 		data.PHPSettings = types.ListNull(types.StringType)
 	}
 
-	// Handle additional domains - preserve if API returns empty array
+	// Handle additional domains - preserve if API returns empty array. The API
+	// does not keep the configured order, so domains follow the planned list
 	if len(app.Domains) > 0 {
-		elements := make([]types.String, len(app.Domains))
+		domains := make([]string, len(app.Domains))
 		for i, domain := range app.Domains {
-			elements[i] = types.StringValue(domain.Domain)
+			domains[i] = domain.Domain
 		}
-		data.AdditionalDomains, _ = types.ListValueFrom(context.Background(), types.StringType, elements)
+		data.AdditionalDomains, _ = types.ListValueFrom(context.Background(), types.StringType, inPlannedOrder(domains, data.AdditionalDomains))
 	} else if data.AdditionalDomains.IsNull() {
 		data.AdditionalDomains = types.ListNull(types.StringType)
 	}
-}
\ No newline at end of file
+}
+
+// inPlannedOrder returns values in the order of the planned list, followed by
+// values not in it in their original order, so a reordering by the API does
+// not show up as a diff while added values still do
+func inPlannedOrder(values []string, planned types.List) []string {
+	var plannedValues []string
+	if !planned.IsNull() && !planned.IsUnknown() {
+		planned.ElementsAs(context.Background(), &plannedValues, false)
+	}
+	position := make(map[string]int, len(plannedValues))
+	for i, value := range plannedValues {
+		if _, ok := position[value]; !ok {
+			position[value] = i
+		}
+	}
+
+	ordered := make([]string, len(values))
+	copy(ordered, values)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		pi, iPlanned := position[ordered[i]]
+		pj, jPlanned := position[ordered[j]]
+		if iPlanned && jPlanned {
+			return pi < pj
+		}
+		return iPlanned && !jPlanned
+	})
+	return ordered
+}
+
+// maskedSecretValue is returned by the API in place of secret values it does
+// not reveal
+const maskedSecretValue = "********"
+
+// secretChanges are the secret API calls that bring an application in line
+// with its environment map
+type secretChanges struct {
+	Create map[string]string
+	Update map[string]string
+	Delete []string
+}
+
+// planSecretChanges compares the desired environment with the secrets in the
+// API. Only keys of the previous environment are deleted, so secrets managed
+// elsewhere survive. Masked values are compared with the previous environment.
+func planSecretChanges(existing []client.ApplicationSecret, previous, desired map[string]string) secretChanges {
+	changes := secretChanges{
+		Create: map[string]string{},
+		Update: map[string]string{},
+	}
+
+	current := make(map[string]string, len(existing))
+	for _, secret := range existing {
+		value := secret.Value
+		if value == "" || value == maskedSecretValue {
+			value = previous[secret.Key]
+		}
+		current[secret.Key] = value
+	}
+
+	for key, value := range desired {
+		existingValue, ok := current[key]
+		if !ok {
+			changes.Create[key] = value
+		} else if existingValue != value {
+			changes.Update[key] = value
+		}
+	}
+
+	for key := range previous {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		if _, ok := current[key]; ok {
+			changes.Delete = append(changes.Delete, key)
+		}
+	}
+	sort.Strings(changes.Delete)
+
+	return changes
+}
+
+// environmentFromAPI refreshes the managed keys of the environment map from
+// the secrets in the API. Keys deleted outside of Terraform are dropped and
+// masked values keep the value from state.
+func environmentFromAPI(secrets []client.ApplicationSecret, state types.Map) types.Map {
+	var managed map[string]string
+	state.ElementsAs(context.Background(), &managed, false)
+
+	environment := make(map[string]string, len(managed))
+	for _, secret := range secrets {
+		stateValue, ok := managed[secret.Key]
+		if !ok {
+			continue
+		}
+		if secret.Value == "" || secret.Value == maskedSecretValue {
+			environment[secret.Key] = stateValue
+		} else {
+			environment[secret.Key] = secret.Value
+		}
+	}
+
+	result, _ := types.MapValueFrom(context.Background(), types.StringType, environment)
+	return result
+}
+
+// reconcileEnvironment creates, updates and deletes secrets so the application
+// matches the desired environment map. Every key that fails gets its own
+// error, and the returned map holds only what was applied, so state never
+// records a failed key as set.
+func (r *ApplicationResource) reconcileEnvironment(ctx context.Context, applicationID int64, previous, desired types.Map) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if previous.IsNull() && desired.IsNull() {
+		return desired, diags
+	}
+
+	var previousValues, desiredValues map[string]string
+	if !previous.IsNull() && !previous.IsUnknown() {
+		diags.Append(previous.ElementsAs(ctx, &previousValues, false)...)
+	}
+	if !desired.IsNull() && !desired.IsUnknown() {
+		diags.Append(desired.ElementsAs(ctx, &desiredValues, false)...)
+	}
+	if diags.HasError() {
+		return previous, diags
+	}
+
+	existing, err := r.client.ListSecrets(ctx, applicationID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read application secrets, got error: %s", err))
+		return previous, diags
+	}
+
+	changes := planSecretChanges(existing, previousValues, desiredValues)
+
+	// Keys that need no change are applied already
+	applied := make(map[string]string, len(desiredValues))
+	for key, value := range desiredValues {
+		applied[key] = value
+	}
+	var failed []string
+	fail := func(key, action string, err error) {
+		failed = append(failed, key)
+		diags.AddAttributeError(path.Root("environment").AtMapKey(key), "Client Error", fmt.Sprintf("Unable to %s secret %s, got error: %s", action, key, err))
+		// The API keeps the value from before the failed call
+		if value, ok := previousValues[key]; ok {
+			applied[key] = value
+		} else {
+			delete(applied, key)
+		}
+	}
+
+	for _, key := range sortedKeys(changes.Create) {
+		secret := &client.ApplicationSecret{ApplicationID: applicationID, Key: key, Value: changes.Create[key]}
+		if _, err := r.client.CreateSecret(ctx, secret); err != nil {
+			fail(key, "create", err)
+		}
+	}
+	for _, key := range sortedKeys(changes.Update) {
+		secret := &client.ApplicationSecret{ApplicationID: applicationID, Key: key, Value: changes.Update[key]}
+		if _, err := r.client.UpdateSecret(ctx, applicationID, key, secret); err != nil {
+			fail(key, "update", err)
+		}
+	}
+	for _, key := range changes.Delete {
+		// A secret that is already gone counts as deleted
+		if err := r.client.DeleteSecret(ctx, applicationID, key); err != nil && !client.IsNotFound(err) {
+			fail(key, "delete", err)
+		}
+	}
+
+	if len(failed) == 0 {
+		return desired, diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("environment"),
+		"Partial Secret Update",
+		fmt.Sprintf("%d of %d secret changes failed: %s. The other changes were applied and the next apply retries the failed keys.",
+			len(failed), len(changes.Create)+len(changes.Update)+len(changes.Delete), strings.Join(failed, ", ")),
+	)
+
+	result, resultDiags := types.MapValueFrom(ctx, types.StringType, applied)
+	diags.Append(resultDiags...)
+	return result, diags
+}
+
+// sortedKeys returns the keys of m in lexical order, so API calls are made in
+// a stable order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func buildCacheToAPI(data *BuildCacheModel) *client.BuildCache {
+	cache := &client.BuildCache{
+		Enabled: true,
+	}
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
+		cache.Enabled = data.Enabled.ValueBool()
+	}
+	if !data.Key.IsNull() && data.Key.ValueString() != "" {
+		cache.Key = data.Key.ValueString()
+	}
+	return cache
+}
+
+func headersToAPI(data *HeadersModel) *client.HeaderRules {
+	rules := &client.HeaderRules{}
+	if !data.Add.IsNull() && !data.Add.IsUnknown() {
+		data.Add.ElementsAs(context.Background(), &rules.Add, false)
+	}
+	if !data.Set.IsNull() && !data.Set.IsUnknown() {
+		data.Set.ElementsAs(context.Background(), &rules.Set, false)
+	}
+	if !data.Remove.IsNull() && !data.Remove.IsUnknown() {
+		data.Remove.ElementsAs(context.Background(), &rules.Remove, false)
+	}
+	return rules
+}
+
+// ingressToAPI returns the ingress IP filtering rules, protocol toggles, path
+// routing, error pages and deregistration delay, or nil when none of them are
+// configured
+func ingressToAPI(data *ApplicationResourceModel) *client.IngressConfig {
+	allow, deny := data.IngressAllowCIDRs, data.IngressDenyCIDRs
+	http2Set := !data.HTTP2Enabled.IsNull() && !data.HTTP2Enabled.IsUnknown()
+	http3Set := !data.HTTP3Enabled.IsNull() && !data.HTTP3Enabled.IsUnknown()
+	pathSet := !data.PathPrefix.IsNull() && !data.PathPrefix.IsUnknown()
+	stripSet := !data.StripPrefix.IsNull() && !data.StripPrefix.IsUnknown()
+	drainSet := data.Settings != nil && !data.Settings.DeregistrationDelaySeconds.IsNull() && !data.Settings.DeregistrationDelaySeconds.IsUnknown()
+	if allow.IsNull() && deny.IsNull() && !http2Set && !http3Set && !pathSet && !stripSet && !drainSet && data.ErrorPages == nil {
+		return nil
+	}
+
+	ingress := &client.IngressConfig{}
+	if !allow.IsNull() && !allow.IsUnknown() {
+		allow.ElementsAs(context.Background(), &ingress.AllowCIDRs, false)
+	}
+	if !deny.IsNull() && !deny.IsUnknown() {
+		deny.ElementsAs(context.Background(), &ingress.DenyCIDRs, false)
+	}
+	if http2Set {
+		ingress.HTTP2Enabled = data.HTTP2Enabled.ValueBoolPointer()
+	}
+	if http3Set {
+		ingress.HTTP3Enabled = data.HTTP3Enabled.ValueBoolPointer()
+	}
+	if pathSet {
+		ingress.PathPrefix = data.PathPrefix.ValueStringPointer()
+	}
+	if stripSet {
+		ingress.StripPrefix = data.StripPrefix.ValueBoolPointer()
+	}
+	if drainSet {
+		ingress.DeregistrationDelaySeconds = data.Settings.DeregistrationDelaySeconds.ValueInt64Pointer()
+	}
+	if data.ErrorPages != nil {
+		ingress.ErrorPages = make(map[string]client.ErrorPage, len(data.ErrorPages))
+		for status, page := range data.ErrorPages {
+			ingress.ErrorPages[status] = client.ErrorPage{
+				Content: page.Content.ValueString(),
+				URL:     page.URL.ValueString(),
+			}
+		}
+	}
+	return ingress
+}
+
+// lifecycleToAPI returns the container lifecycle hooks, or nil when neither
+// is configured
+func lifecycleToAPI(data *ApplicationResourceModel) *client.Lifecycle {
+	preStopSet := !data.PreStopCommand.IsNull() && !data.PreStopCommand.IsUnknown()
+	postStartSet := !data.PostStartCommand.IsNull() && !data.PostStartCommand.IsUnknown()
+	if !preStopSet && !postStartSet {
+		return nil
+	}
+
+	lifecycle := &client.Lifecycle{}
+	if preStopSet {
+		lifecycle.PreStopCommand = data.PreStopCommand.ValueString()
+	}
+	if postStartSet {
+		lifecycle.PostStartCommand = data.PostStartCommand.ValueString()
+	}
+	return lifecycle
+}
+
+func compressionToAPI(data *CompressionModel) *client.Compression {
+	compression := &client.Compression{
+		Enabled: true,
+	}
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
+		compression.Enabled = data.Enabled.ValueBool()
+	}
+	if !data.Types.IsNull() && !data.Types.IsUnknown() {
+		data.Types.ElementsAs(context.Background(), &compression.Types, false)
+	}
+	if !data.MinSizeBytes.IsNull() && !data.MinSizeBytes.IsUnknown() {
+		compression.MinSizeBytes = data.MinSizeBytes.ValueInt64()
+	}
+	return compression
+}
+
+func basicAuthToAPI(data *BasicAuthModel) *client.BasicAuth {
+	auth := &client.BasicAuth{
+		Enabled:  true,
+		Username: data.Username.ValueString(),
+		Password: data.Password.ValueString(),
+	}
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
+		auth.Enabled = data.Enabled.ValueBool()
+	}
+	return auth
+}
+
+// basicAuthValueHash returns the hex SHA-256 of "username:password", matching
+// the value_hash reported by the API.
+func basicAuthValueHash(username, password string) string {
+	sum := sha256.Sum256([]byte(username + ":" + password))
+	return hex.EncodeToString(sum[:])
+}
+
+// detectBasicAuthDrift clears the stored password when the API reports a hash
+// that differs from the known credentials, so the next plan restores them.
+func detectBasicAuthDrift(app *client.Application, data *ApplicationResourceModel) {
+	if data.BasicAuth == nil || app.BasicAuth == nil || app.BasicAuth.ValueHash == "" {
+		return
+	}
+	if app.BasicAuth.ValueHash != data.BasicAuth.ValueHash.ValueString() {
+		data.BasicAuth.Password = types.StringNull()
+		data.BasicAuth.ValueHash = types.StringValue(app.BasicAuth.ValueHash)
+	}
+}
+
+// defaultDeploymentTimeout is the deploy wait used when the timeouts block
+// does not set one for the operation.
+func (r *ApplicationResource) defaultDeploymentTimeout() time.Duration {
+	if r.deploymentTimeout > 0 {
+		return r.deploymentTimeout
+	}
+	return defaultDeploymentTimeout
+}
+
+// refreshAfterDeploy re-reads the application, bypassing HTTP caches, after
+// a deployment was triggered to pick up the new status. With wait_for_deployment it instead
+// waits up to timeout until the application is running and reports an error
+// otherwise.
+func (r *ApplicationResource) refreshAfterDeploy(ctx context.Context, id int64, data *ApplicationResourceModel, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !data.WaitForDeployment.ValueBool() {
+		refreshed, err := r.client.RefreshApplication(ctx, id)
+		if err == nil && refreshed != nil {
+			r.fromAPIModel(refreshed, data)
+		}
+		return diags
+	}
+
+	app, err := r.client.WaitForApplicationStatus(ctx, id, "running", timeout)
+	if app != nil {
+		r.fromAPIModel(app, data)
+	}
+	if err != nil {
+		diags.Append(waitErrorDiagnostics("Deployment Failed", fmt.Sprintf("The deployment of application %d did not finish", id), err)...)
+	}
+	return diags
+}
+
+// waitErrorDiagnostics reports an error from one of the client's Wait
+// helpers. Timeouts name the last observed status and the time spent waiting
+// so the timeouts block can be tuned.
+func waitErrorDiagnostics(summary, detail string, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var timeoutErr *client.StatusTimeoutError
+	if errors.As(err, &timeoutErr) {
+		diags.AddError(summary, fmt.Sprintf("%s: still '%s' after waiting %s for '%s'. Increase the timeouts block if the operation needs more time.",
+			detail, timeoutErr.LastStatus, timeoutErr.Elapsed.Round(time.Second), timeoutErr.Target))
+		return diags
+	}
+
+	diags.AddError(summary, fmt.Sprintf("%s: %s", detail, err))
+	return diags
+}
+
+// followBuildLogs polls the build logs of a deployment and emits every new
+// line via tflog until the build finishes, the timeout passes or ctx is
+// cancelled. Problems while following the logs never fail the apply.
+func (r *ApplicationResource) followBuildLogs(ctx context.Context, applicationID, deploymentID int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	interval := r.buildLogPollInterval
+	if interval <= 0 {
+		interval = defaultBuildLogPollInterval
+	}
+	timeout := r.buildLogTimeout
+	if timeout <= 0 {
+		timeout = defaultBuildLogTimeout
+	}
+	deadline := time.Now().Add(timeout)
+
+	fields := map[string]interface{}{
+		"application_id": applicationID,
+		"deployment_id":  deploymentID,
+	}
+
+	seen := 0
+	for {
+		logs, err := r.client.GetBuildLogs(ctx, applicationID, deploymentID)
+		if err != nil {
+			diags.AddWarning("Build Log Warning", fmt.Sprintf("Unable to follow the build logs of deployment %d: %s", deploymentID, err))
+			return diags
+		}
+
+		if seen > len(logs.Lines) {
+			seen = len(logs.Lines)
+		}
+		for _, line := range logs.Lines[seen:] {
+			tflog.Info(ctx, "build: "+line, fields)
+		}
+		seen = len(logs.Lines)
+
+		if logs.Finished() {
+			if logs.Status == "failed" {
+				diags.AddWarning("Deploy Warning", fmt.Sprintf("The build of deployment %d failed, run with TF_LOG=INFO to see the build logs", deploymentID))
+			}
+			return diags
+		}
+
+		if time.Now().After(deadline) {
+			tflog.Info(ctx, "Stopped following build logs, the deployment continues in the background", fields)
+			return diags
+		}
+
+		select {
+		case <-ctx.Done():
+			return diags
+		case <-time.After(interval):
+		}
+	}
+}
+
+func egressToAPI(data *EgressModel) *client.Egress {
+	egress := &client.Egress{}
+	if !data.StaticIP.IsNull() && !data.StaticIP.IsUnknown() {
+		egress.StaticIP = data.StaticIP.ValueBool()
+	}
+	return egress
+}
+
+func maintenanceWindowToAPI(data *MaintenanceWindowModel) *client.MaintenanceWindow {
+	window := &client.MaintenanceWindow{
+		DayOfWeek:       data.DayOfWeek.ValueString(),
+		StartTime:       data.StartTime.ValueString(),
+		DurationMinutes: 60,
+		Timezone:        "UTC",
+	}
+	if !data.DurationMinutes.IsNull() && !data.DurationMinutes.IsUnknown() {
+		window.DurationMinutes = data.DurationMinutes.ValueInt64()
+	}
+	if !data.Timezone.IsNull() && !data.Timezone.IsUnknown() {
+		window.Timezone = data.Timezone.ValueString()
+	}
+	return window
+}
+
+func canaryToAPI(data *CanaryModel) *client.Canary {
+	canary := &client.Canary{
+		Enabled:           true,
+		TrafficPercentage: 10,
+	}
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
+		canary.Enabled = data.Enabled.ValueBool()
+	}
+	if !data.TrafficPercentage.IsNull() && !data.TrafficPercentage.IsUnknown() {
+		canary.TrafficPercentage = data.TrafficPercentage.ValueInt64()
+	}
+	if !data.PromoteAfterSeconds.IsNull() && !data.PromoteAfterSeconds.IsUnknown() {
+		canary.PromoteAfterSeconds = data.PromoteAfterSeconds.ValueInt64()
+	}
+	return canary
+}
+
+func autoSleepToAPI(data *AutoSleepModel) *client.AutoSleep {
+	autoSleep := &client.AutoSleep{
+		Enabled: true,
+	}
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
+		autoSleep.Enabled = data.Enabled.ValueBool()
+	}
+	if !data.IdleTimeoutMinutes.IsNull() && !data.IdleTimeoutMinutes.IsUnknown() {
+		autoSleep.IdleTimeoutMinutes = data.IdleTimeoutMinutes.ValueInt64()
+	}
+	return autoSleep
+}
+
+func sidecarsToAPI(data []SidecarModel) []client.Sidecar {
+	sidecars := make([]client.Sidecar, 0, len(data))
+	for _, sc := range data {
+		sidecar := client.Sidecar{
+			Name:  sc.Name.ValueString(),
+			Image: sc.Image.ValueString(),
+		}
+		if !sc.Command.IsNull() && sc.Command.ValueString() != "" {
+			sidecar.Command = sc.Command.ValueString()
+		}
+		if !sc.CPURequest.IsNull() && sc.CPURequest.ValueString() != "" {
+			sidecar.CPURequest = sc.CPURequest.ValueString()
+		}
+		if !sc.MemoryRequest.IsNull() && sc.MemoryRequest.ValueString() != "" {
+			sidecar.MemoryRequest = sc.MemoryRequest.ValueString()
+		}
+		sidecars = append(sidecars, sidecar)
+	}
+	return sidecars
+}
+
+func processesToAPI(data []ProcessModel) []client.Process {
+	processes := make([]client.Process, 0, len(data))
+	for _, p := range data {
+		process := client.Process{
+			Name:    p.Name.ValueString(),
+			Command: p.Command.ValueString(),
+		}
+		if !p.Replicas.IsNull() && !p.Replicas.IsUnknown() {
+			process.Replicas = p.Replicas.ValueInt64()
+		}
+		if !p.CPURequest.IsNull() && p.CPURequest.ValueString() != "" {
+			process.CPURequest = p.CPURequest.ValueString()
+		}
+		if !p.MemoryRequest.IsNull() && p.MemoryRequest.ValueString() != "" {
+			process.MemoryRequest = p.MemoryRequest.ValueString()
+		}
+		processes = append(processes, process)
+	}
+	return processes
+}
+
+func hostAliasesToAPI(data []HostAliasModel) []client.HostAlias {
+	hostAliases := make([]client.HostAlias, 0, len(data))
+	for _, alias := range data {
+		hostAliases = append(hostAliases, client.HostAlias{
+			Hostname: alias.Hostname.ValueString(),
+			IP:       alias.IP.ValueString(),
+		})
+	}
+	return hostAliases
+}
+
+// stringValueOrPlanned returns the API value, falling back to the planned value
+// (or null) when the API returns an empty string.
+func stringValueOrPlanned(value string, planned types.String) types.String {
+	if value != "" {
+		return types.StringValue(value)
+	}
+	if planned.IsNull() || planned.IsUnknown() {
+		return types.StringNull()
+	}
+	return planned
+}
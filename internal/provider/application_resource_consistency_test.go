@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -749,7 +750,7 @@ func TestIntegrationWorkflow(t *testing.T) {
 		}
 
 		apiModel := resource.toAPIModel(createData)
-		created, err := c.CreateApplication(apiModel)
+		created, err := c.CreateApplication(context.Background(), apiModel)
 		if err != nil {
 			t.Fatalf("Failed to create application: %v", err)
 		}
@@ -787,7 +788,7 @@ func TestIntegrationWorkflow(t *testing.T) {
 			}
 		}
 
-		updated, err := c.UpdateApplication(createdData.ID.ValueInt64(), updatePayload)
+		updated, err := c.UpdateApplication(context.Background(), createdData.ID.ValueInt64(), updatePayload)
 		if err != nil {
 			t.Fatalf("Failed to update application: %v", err)
 		}
@@ -901,4 +902,60 @@ func deepEqual(a, b interface{}) bool {
 	default:
 		return a == b
 	}
-}
\ No newline at end of file
+}
+// TestApplicationVersionConsistency ensures application_version does not flip
+// between null and empty string on refresh
+func TestApplicationVersionConsistency(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	tests := []struct {
+		name       string
+		planned    types.String
+		apiVersion string
+		expected   types.String
+	}{
+		{
+			name:       "null config with empty api response stays null",
+			planned:    types.StringNull(),
+			apiVersion: "",
+			expected:   types.StringNull(),
+		},
+		{
+			name:       "unknown value with empty api response becomes null",
+			planned:    types.StringUnknown(),
+			apiVersion: "",
+			expected:   types.StringNull(),
+		},
+		{
+			name:       "configured value preserved when api returns empty",
+			planned:    types.StringValue("11.x"),
+			apiVersion: "",
+			expected:   types.StringValue("11.x"),
+		},
+		{
+			name:       "api value takes precedence when present",
+			planned:    types.StringNull(),
+			apiVersion: "12.x",
+			expected:   types.StringValue("12.x"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &ApplicationResourceModel{ApplicationVersion: tt.planned}
+			app := &client.Application{ID: 1, Name: "app", Type: "laravel", ApplicationVersion: tt.apiVersion}
+
+			resource.fromAPIModel(app, data)
+			if !data.ApplicationVersion.Equal(tt.expected) {
+				t.Errorf("Expected ApplicationVersion %v, got %v", tt.expected, data.ApplicationVersion)
+			}
+
+			// A second refresh must not produce a diff
+			refreshed := *data
+			resource.fromAPIModel(app, &refreshed)
+			if !refreshed.ApplicationVersion.Equal(data.ApplicationVersion) {
+				t.Errorf("Expected no diff on refresh, got %v then %v", data.ApplicationVersion, refreshed.ApplicationVersion)
+			}
+		})
+	}
+}
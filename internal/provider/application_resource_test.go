@@ -1,16 +1,28 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 	"github.com/ploi/terraform-provider-ploicloud/internal/client"
 )
 
@@ -410,7 +422,7 @@ func TestApplicationResource_StartCommand_APIClientIntegration(t *testing.T) {
 		StartCommand: "npm run start:prod",
 	}
 	
-	created, err := c.CreateApplication(app)
+	created, err := c.CreateApplication(context.Background(), app)
 	if err != nil {
 		t.Fatalf("Failed to create application: %v", err)
 	}
@@ -683,6 +695,75 @@ func TestApplicationResource_AdditionalDomains_fromAPIModel(t *testing.T) {
 	}
 }
 
+func TestApplicationResource_AdditionalDomains_OrderStability(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	domainList := func(domains ...string) types.List {
+		elements := make([]attr.Value, len(domains))
+		for i, domain := range domains {
+			elements[i] = types.StringValue(domain)
+		}
+		return types.ListValueMust(types.StringType, elements)
+	}
+	apiDomains := func(domains ...string) []client.ApplicationDomain {
+		result := make([]client.ApplicationDomain, len(domains))
+		for i, domain := range domains {
+			result[i] = client.ApplicationDomain{Domain: domain}
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		planned  types.List
+		api      []client.ApplicationDomain
+		expected types.List
+	}{
+		{
+			name:     "reversed by API keeps planned order",
+			planned:  domainList("a.example.com", "b.example.com", "c.example.com"),
+			api:      apiDomains("c.example.com", "b.example.com", "a.example.com"),
+			expected: domainList("a.example.com", "b.example.com", "c.example.com"),
+		},
+		{
+			name:     "domain added outside of terraform is appended",
+			planned:  domainList("a.example.com", "b.example.com"),
+			api:      apiDomains("new.example.com", "b.example.com", "a.example.com"),
+			expected: domainList("a.example.com", "b.example.com", "new.example.com"),
+		},
+		{
+			name:     "domain removed outside of terraform is dropped",
+			planned:  domainList("a.example.com", "b.example.com"),
+			api:      apiDomains("b.example.com"),
+			expected: domainList("b.example.com"),
+		},
+		{
+			name:     "no prior state uses API order",
+			planned:  types.ListNull(types.StringType),
+			api:      apiDomains("b.example.com", "a.example.com"),
+			expected: domainList("b.example.com", "a.example.com"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := ApplicationResourceModel{AdditionalDomains: tt.planned}
+			app := &client.Application{ID: 1, Name: "app", Type: "laravel", Domains: tt.api}
+
+			resource.fromAPIModel(app, &data)
+			if !data.AdditionalDomains.Equal(tt.expected) {
+				t.Fatalf("Expected AdditionalDomains %v, got %v", tt.expected, data.AdditionalDomains)
+			}
+
+			// A second refresh must not change the state again
+			resource.fromAPIModel(app, &data)
+			if !data.AdditionalDomains.Equal(tt.expected) {
+				t.Errorf("Expected AdditionalDomains to stay %v on refresh, got %v", tt.expected, data.AdditionalDomains)
+			}
+		})
+	}
+}
+
 func TestApplicationResource_AdditionalDomains_UpdateAPIModel(t *testing.T) {
 	resource := &ApplicationResource{}
 	
@@ -873,4 +954,4075 @@ func TestApplicationResource_AdditionalDomains_WithOtherFields(t *testing.T) {
 	if len(result.BuildCommands) != 1 {
 		t.Errorf("Expected 1 build command, got %d", len(result.BuildCommands))
 	}
-}
\ No newline at end of file
+}
+func TestApplicationResource_LogLevel_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	tests := []struct {
+		name     string
+		logLevel types.String
+		expected string
+	}{
+		{"debug level", types.StringValue("debug"), "debug"},
+		{"error level", types.StringValue("error"), "error"},
+		{"null level", types.StringNull(), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &ApplicationResourceModel{
+				Name:     types.StringValue("log-app"),
+				Type:     types.StringValue("laravel"),
+				LogLevel: tt.logLevel,
+			}
+
+			app := resource.toAPIModel(data)
+			if app.LogLevel != tt.expected {
+				t.Errorf("Expected LogLevel '%s', got '%s'", tt.expected, app.LogLevel)
+			}
+
+			update := resource.toUpdateAPIModel(data)
+			value, ok := update["log_level"]
+			if tt.expected == "" && ok {
+				t.Errorf("Expected log_level to be omitted from update, got %v", value)
+			}
+			if tt.expected != "" && value != tt.expected {
+				t.Errorf("Expected update log_level '%s', got %v", tt.expected, value)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_LogLevel_fromAPIModel(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	// API value is read back into state
+	data := &ApplicationResourceModel{LogLevel: types.StringNull()}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel", LogLevel: "warning"}, data)
+	if !data.LogLevel.Equal(types.StringValue("warning")) {
+		t.Errorf("Expected LogLevel 'warning', got %v", data.LogLevel)
+	}
+
+	// Null config stays null when the API omits the field
+	data = &ApplicationResourceModel{LogLevel: types.StringNull()}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.LogLevel.IsNull() {
+		t.Errorf("Expected LogLevel to remain null, got %v", data.LogLevel)
+	}
+
+	// Planned value is preserved when the API omits the field
+	data = &ApplicationResourceModel{LogLevel: types.StringValue("info")}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.LogLevel.Equal(types.StringValue("info")) {
+		t.Errorf("Expected LogLevel 'info' to be preserved, got %v", data.LogLevel)
+	}
+}
+
+func TestApplicationResource_LogLevel_Validation(t *testing.T) {
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	attr, ok := resp.Schema.Attributes["log_level"].(schema.StringAttribute)
+	if !ok {
+		t.Fatal("Expected log_level to be a string attribute")
+	}
+
+	tests := []struct {
+		value       string
+		expectError bool
+	}{
+		{"debug", false},
+		{"info", false},
+		{"warning", false},
+		{"error", false},
+		{"trace", true},
+		{"WARNING", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			diags := runStringValidators(t, attr.Validators, tt.value)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for '%s', got diagnostics: %v", tt.expectError, tt.value, diags)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_BuildCache_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	tests := []struct {
+		name     string
+		cache    *BuildCacheModel
+		expected *client.BuildCache
+	}{
+		{
+			name:     "no build cache block",
+			cache:    nil,
+			expected: nil,
+		},
+		{
+			name:     "enabled with key",
+			cache:    &BuildCacheModel{Enabled: types.BoolValue(true), Key: types.StringValue("deps-v2")},
+			expected: &client.BuildCache{Enabled: true, Key: "deps-v2"},
+		},
+		{
+			name:     "disabled without key",
+			cache:    &BuildCacheModel{Enabled: types.BoolValue(false), Key: types.StringNull()},
+			expected: &client.BuildCache{Enabled: false},
+		},
+		{
+			name:     "enabled defaults to true",
+			cache:    &BuildCacheModel{Enabled: types.BoolNull(), Key: types.StringValue("main")},
+			expected: &client.BuildCache{Enabled: true, Key: "main"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &ApplicationResourceModel{
+				Name:       types.StringValue("cache-app"),
+				Type:       types.StringValue("laravel"),
+				BuildCache: tt.cache,
+			}
+
+			app := resource.toAPIModel(data)
+			if !reflect.DeepEqual(app.BuildCache, tt.expected) {
+				t.Errorf("Expected BuildCache %+v, got %+v", tt.expected, app.BuildCache)
+			}
+
+			update := resource.toUpdateAPIModel(data)
+			value, ok := update["build_cache"]
+			if tt.expected == nil && ok {
+				t.Errorf("Expected build_cache to be omitted from update, got %v", value)
+			}
+			if tt.expected != nil && !reflect.DeepEqual(value, tt.expected) {
+				t.Errorf("Expected update build_cache %+v, got %+v", tt.expected, value)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_BuildCache_fromAPIModel(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		BuildCache: &BuildCacheModel{Enabled: types.BoolValue(true), Key: types.StringValue("deps-v1")},
+	}
+	resource.fromAPIModel(&client.Application{
+		ID:         1,
+		Type:       "laravel",
+		BuildCache: &client.BuildCache{Enabled: false, Key: "deps-v1"},
+	}, data)
+
+	if !data.BuildCache.Enabled.Equal(types.BoolValue(false)) {
+		t.Errorf("Expected Enabled false from API, got %v", data.BuildCache.Enabled)
+	}
+	if !data.BuildCache.Key.Equal(types.StringValue("deps-v1")) {
+		t.Errorf("Expected Key 'deps-v1', got %v", data.BuildCache.Key)
+	}
+
+	// An unconfigured block stays unset even when the API reports cache settings
+	data = &ApplicationResourceModel{}
+	resource.fromAPIModel(&client.Application{
+		ID:         1,
+		Type:       "laravel",
+		BuildCache: &client.BuildCache{Enabled: true},
+	}, data)
+	if data.BuildCache != nil {
+		t.Errorf("Expected BuildCache to remain nil, got %+v", data.BuildCache)
+	}
+}
+
+func TestApplicationResource_BuildCache_KeyValidation(t *testing.T) {
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	block := resp.Schema.Blocks["build_cache"].(schema.SingleNestedBlock)
+	attr := block.Attributes["key"].(schema.StringAttribute)
+
+	tests := []struct {
+		value       string
+		expectError bool
+	}{
+		{"deps-v1", false},
+		{"composer.lock_2024", false},
+		{"", true},
+		{"has space", true},
+		{"slash/key", true},
+		{strings.Repeat("a", 129), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			diags := runStringValidators(t, attr.Validators, tt.value)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for '%s', got diagnostics: %v", tt.expectError, tt.value, diags)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_NetworkID_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Name:      types.StringValue("vpc-app"),
+		Type:      types.StringValue("laravel"),
+		NetworkID: types.Int64Value(42),
+	}
+
+	if app := resource.toAPIModel(data); app.NetworkID != 42 {
+		t.Errorf("Expected NetworkID 42, got %d", app.NetworkID)
+	}
+	if update := resource.toUpdateAPIModel(data); update["network_id"] != int64(42) {
+		t.Errorf("Expected update network_id 42, got %v", update["network_id"])
+	}
+
+	data.NetworkID = types.Int64Null()
+	if app := resource.toAPIModel(data); app.NetworkID != 0 {
+		t.Errorf("Expected NetworkID to be omitted, got %d", app.NetworkID)
+	}
+	if _, ok := resource.toUpdateAPIModel(data)["network_id"]; ok {
+		t.Error("Expected network_id to be omitted from update")
+	}
+
+	// Read back from the API
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel", NetworkID: 7}, data)
+	if !data.NetworkID.Equal(types.Int64Value(7)) {
+		t.Errorf("Expected NetworkID 7 from API, got %v", data.NetworkID)
+	}
+
+	// Null stays null when the API omits the network
+	data.NetworkID = types.Int64Null()
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.NetworkID.IsNull() {
+		t.Errorf("Expected NetworkID to remain null, got %v", data.NetworkID)
+	}
+}
+
+func TestApplicationResource_NetworkID_Validation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [{"id": 1, "name": "default"}, {"id": 2, "name": "peered"}]}`))
+	}))
+	defer server.Close()
+
+	resource := &ApplicationResource{client: client.NewClient("test-token", &server.URL)}
+
+	tests := []struct {
+		name        string
+		networkID   types.Int64
+		expectError bool
+	}{
+		{"known network", types.Int64Value(2), false},
+		{"unknown network", types.Int64Value(99), true},
+		{"null network skips lookup", types.Int64Null(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := resource.validateNetworkID(context.Background(), tt.networkID)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v, got diagnostics: %v", tt.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_Sidecar_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Name: types.StringValue("sidecar-app"),
+		Type: types.StringValue("laravel"),
+		Sidecars: []SidecarModel{
+			{
+				Name:          types.StringValue("exporter"),
+				Image:         types.StringValue("prom/node-exporter:latest"),
+				Command:       types.StringNull(),
+				CPURequest:    types.StringValue("100m"),
+				MemoryRequest: types.StringValue("64Mi"),
+			},
+			{
+				Name:          types.StringValue("shipper"),
+				Image:         types.StringValue("fluent/fluent-bit:3"),
+				Command:       types.StringValue("fluent-bit -c /etc/fluent.conf"),
+				CPURequest:    types.StringNull(),
+				MemoryRequest: types.StringNull(),
+			},
+		},
+	}
+
+	expected := []client.Sidecar{
+		{Name: "exporter", Image: "prom/node-exporter:latest", CPURequest: "100m", MemoryRequest: "64Mi"},
+		{Name: "shipper", Image: "fluent/fluent-bit:3", Command: "fluent-bit -c /etc/fluent.conf"},
+	}
+
+	app := resource.toAPIModel(data)
+	if !reflect.DeepEqual(app.Sidecars, expected) {
+		t.Errorf("Expected sidecars %+v, got %+v", expected, app.Sidecars)
+	}
+
+	update := resource.toUpdateAPIModel(data)
+	if !reflect.DeepEqual(update["sidecars"], expected) {
+		t.Errorf("Expected update sidecars %+v, got %+v", expected, update["sidecars"])
+	}
+
+	// Removing all sidecar blocks must send an empty list so the API clears them
+	data.Sidecars = []SidecarModel{}
+	if sidecars, ok := resource.toUpdateAPIModel(data)["sidecars"].([]client.Sidecar); !ok || len(sidecars) != 0 {
+		t.Errorf("Expected empty sidecars in update, got %v", resource.toUpdateAPIModel(data)["sidecars"])
+	}
+
+	data.Sidecars = nil
+	if _, ok := resource.toUpdateAPIModel(data)["sidecars"]; ok {
+		t.Error("Expected sidecars to be omitted from update when unset")
+	}
+	if app := resource.toAPIModel(data); app.Sidecars != nil {
+		t.Errorf("Expected sidecars to be omitted on create, got %+v", app.Sidecars)
+	}
+}
+
+func TestApplicationResource_Sidecar_fromAPIModel(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Sidecars: []SidecarModel{
+			{
+				Name:          types.StringValue("exporter"),
+				Image:         types.StringValue("prom/node-exporter:latest"),
+				Command:       types.StringValue("node_exporter"),
+				CPURequest:    types.StringNull(),
+				MemoryRequest: types.StringValue("64Mi"),
+			},
+		},
+	}
+
+	// API omits the command and memory request but reports a CPU request
+	resource.fromAPIModel(&client.Application{
+		ID:   1,
+		Type: "laravel",
+		Sidecars: []client.Sidecar{
+			{Name: "exporter", Image: "prom/node-exporter:latest", CPURequest: "50m"},
+		},
+	}, data)
+
+	if len(data.Sidecars) != 1 {
+		t.Fatalf("Expected 1 sidecar, got %d", len(data.Sidecars))
+	}
+	sc := data.Sidecars[0]
+	if !sc.Command.Equal(types.StringValue("node_exporter")) {
+		t.Errorf("Expected planned command to be preserved, got %v", sc.Command)
+	}
+	if !sc.CPURequest.Equal(types.StringValue("50m")) {
+		t.Errorf("Expected CPU request from API, got %v", sc.CPURequest)
+	}
+	if !sc.MemoryRequest.Equal(types.StringValue("64Mi")) {
+		t.Errorf("Expected planned memory request to be preserved, got %v", sc.MemoryRequest)
+	}
+
+	// Sidecars added outside Terraform are picked up on read
+	data = &ApplicationResourceModel{}
+	resource.fromAPIModel(&client.Application{
+		ID:       1,
+		Type:     "laravel",
+		Sidecars: []client.Sidecar{{Name: "manual", Image: "busybox"}},
+	}, data)
+	if len(data.Sidecars) != 1 || !data.Sidecars[0].Command.IsNull() {
+		t.Errorf("Expected one sidecar with null command, got %+v", data.Sidecars)
+	}
+}
+
+func TestApplicationResource_Sidecar_Validation(t *testing.T) {
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	block := resp.Schema.Blocks["sidecar"].(schema.ListNestedBlock)
+
+	tests := []struct {
+		attribute   string
+		value       string
+		expectError bool
+	}{
+		{"name", "exporter", false},
+		{"name", "", true},
+		{"image", "busybox:1.36", false},
+		{"image", "", true},
+		{"cpu_request", "100m", false},
+		{"cpu_request", "0.5", false},
+		{"cpu_request", "2", false},
+		{"cpu_request", "100mi", true},
+		{"cpu_request", "half", true},
+		{"memory_request", "64Mi", false},
+		{"memory_request", "1Gi", false},
+		{"memory_request", "512", true},
+		{"memory_request", "1.5Gi", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attribute+"="+tt.value, func(t *testing.T) {
+			attr := block.NestedObject.Attributes[tt.attribute].(schema.StringAttribute)
+			diags := runStringValidators(t, attr.Validators, tt.value)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for %s '%s', got diagnostics: %v", tt.expectError, tt.attribute, tt.value, diags)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_Processes_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Name: types.StringValue("procfile-app"),
+		Type: types.StringValue("laravel"),
+		Processes: []ProcessModel{
+			{
+				Name:          types.StringValue("web"),
+				Command:       types.StringValue("php artisan octane:start"),
+				Replicas:      types.Int64Value(3),
+				CPURequest:    types.StringValue("500m"),
+				MemoryRequest: types.StringValue("1Gi"),
+			},
+			{
+				Name:          types.StringValue("worker"),
+				Command:       types.StringValue("php artisan queue:work"),
+				Replicas:      types.Int64Null(),
+				CPURequest:    types.StringNull(),
+				MemoryRequest: types.StringNull(),
+			},
+		},
+	}
+
+	expected := []client.Process{
+		{Name: "web", Command: "php artisan octane:start", Replicas: 3, CPURequest: "500m", MemoryRequest: "1Gi"},
+		{Name: "worker", Command: "php artisan queue:work"},
+	}
+
+	app := resource.toAPIModel(data)
+	if !reflect.DeepEqual(app.Processes, expected) {
+		t.Errorf("Expected processes %+v, got %+v", expected, app.Processes)
+	}
+
+	update := resource.toUpdateAPIModel(data)
+	if !reflect.DeepEqual(update["processes"], expected) {
+		t.Errorf("Expected update processes %+v, got %+v", expected, update["processes"])
+	}
+
+	// Removing all process blocks must send an empty list so the API clears them
+	data.Processes = []ProcessModel{}
+	if processes, ok := resource.toUpdateAPIModel(data)["processes"].([]client.Process); !ok || len(processes) != 0 {
+		t.Errorf("Expected empty processes in update, got %v", resource.toUpdateAPIModel(data)["processes"])
+	}
+
+	data.Processes = nil
+	if _, ok := resource.toUpdateAPIModel(data)["processes"]; ok {
+		t.Error("Expected processes to be omitted from update when unset")
+	}
+	if app := resource.toAPIModel(data); app.Processes != nil {
+		t.Errorf("Expected processes to be omitted on create, got %+v", app.Processes)
+	}
+}
+
+func TestApplicationResource_Processes_fromAPIModel(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Processes: []ProcessModel{
+			{
+				Name:          types.StringValue("web"),
+				Command:       types.StringValue("php artisan octane:start"),
+				Replicas:      types.Int64Null(),
+				CPURequest:    types.StringValue("500m"),
+				MemoryRequest: types.StringNull(),
+			},
+		},
+	}
+
+	// API reports the replicas it scheduled but omits the CPU request
+	resource.fromAPIModel(&client.Application{
+		ID:        1,
+		Type:      "laravel",
+		Processes: []client.Process{{Name: "web", Command: "php artisan octane:start", Replicas: 2}},
+	}, data)
+
+	if len(data.Processes) != 1 {
+		t.Fatalf("Expected 1 process, got %d", len(data.Processes))
+	}
+	process := data.Processes[0]
+	if !process.Replicas.Equal(types.Int64Value(2)) {
+		t.Errorf("Expected replicas from API, got %v", process.Replicas)
+	}
+	if !process.CPURequest.Equal(types.StringValue("500m")) {
+		t.Errorf("Expected planned CPU request to be preserved, got %v", process.CPURequest)
+	}
+	if !process.MemoryRequest.IsNull() {
+		t.Errorf("Expected null memory request, got %v", process.MemoryRequest)
+	}
+
+	// Processes added outside Terraform are picked up on read
+	data = &ApplicationResourceModel{}
+	resource.fromAPIModel(&client.Application{
+		ID:        1,
+		Type:      "laravel",
+		Processes: []client.Process{{Name: "release", Command: "php artisan migrate --force"}},
+	}, data)
+	if len(data.Processes) != 1 || !data.Processes[0].Replicas.IsNull() {
+		t.Errorf("Expected one process with null replicas, got %+v", data.Processes)
+	}
+}
+
+func TestApplicationResource_Processes_Validation(t *testing.T) {
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	block := resp.Schema.Blocks["processes"].(schema.ListNestedBlock)
+
+	name := block.NestedObject.Attributes["name"].(schema.StringAttribute)
+	for value, expectError := range map[string]bool{
+		"web":        false,
+		"queue-high": false,
+		"worker2":    false,
+		"":           true,
+		"Web":        true,
+		"2web":       true,
+		"web_1":      true,
+	} {
+		if diags := runStringValidators(t, name.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for name %q, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+
+	replicas := block.NestedObject.Attributes["replicas"].(schema.Int64Attribute)
+	for value, expectError := range map[int64]bool{1: false, 10: false, 0: true} {
+		if diags := runInt64Validators(t, replicas.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for replicas %d, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+
+	process := func(name string) ProcessModel {
+		return ProcessModel{Name: types.StringValue(name), Command: types.StringValue("run " + name)}
+	}
+
+	tests := []struct {
+		name          string
+		processes     []ProcessModel
+		startCommand  types.String
+		expectedError string
+	}{
+		{"no processes", nil, types.StringValue("php artisan serve"), ""},
+		{"web and worker", []ProcessModel{process("web"), process("worker")}, types.StringNull(), ""},
+		{"missing web", []ProcessModel{process("worker")}, types.StringNull(), "Missing Web Process"},
+		{"duplicate name", []ProcessModel{process("web"), process("worker"), process("worker")}, types.StringNull(), "Duplicate Process Name"},
+		{"start command conflicts", []ProcessModel{process("web")}, types.StringValue("php artisan serve"), "Conflicting Start Command"},
+		{"unknown name skips web check", []ProcessModel{{Name: types.StringUnknown()}}, types.StringNull(), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateProcesses(tt.processes, tt.startCommand)
+			if tt.expectedError == "" {
+				if diags.HasError() {
+					t.Errorf("Expected no errors, got %v", diags)
+				}
+				return
+			}
+			if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != tt.expectedError {
+				t.Errorf("Expected a single %q error, got %v", tt.expectedError, diags)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_Lifecycle_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	tests := []struct {
+		name      string
+		preStop   types.String
+		postStart types.String
+		expected  *client.Lifecycle
+	}{
+		{"unset", types.StringNull(), types.StringNull(), nil},
+		{"unknown", types.StringUnknown(), types.StringUnknown(), nil},
+		{"pre stop only", types.StringValue("php artisan cache:clear"), types.StringNull(), &client.Lifecycle{PreStopCommand: "php artisan cache:clear"}},
+		{"both", types.StringValue("php artisan cache:clear"), types.StringValue("php artisan config:cache"), &client.Lifecycle{PreStopCommand: "php artisan cache:clear", PostStartCommand: "php artisan config:cache"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &ApplicationResourceModel{
+				Name:             types.StringValue("hooks-app"),
+				Type:             types.StringValue("laravel"),
+				PreStopCommand:   tt.preStop,
+				PostStartCommand: tt.postStart,
+			}
+
+			if app := resource.toAPIModel(data); !reflect.DeepEqual(app.Lifecycle, tt.expected) {
+				t.Errorf("Expected lifecycle %+v, got %+v", tt.expected, app.Lifecycle)
+			}
+
+			lifecycle, ok := resource.toUpdateAPIModel(data)["lifecycle"]
+			if tt.expected == nil {
+				if ok {
+					t.Errorf("Expected lifecycle to be omitted from update, got %+v", lifecycle)
+				}
+			} else if !reflect.DeepEqual(lifecycle, tt.expected) {
+				t.Errorf("Expected update lifecycle %+v, got %+v", tt.expected, lifecycle)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_Lifecycle_fromAPIModel(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	tests := []struct {
+		name              string
+		lifecycle         *client.Lifecycle
+		plannedPreStop    types.String
+		expectedPreStop   types.String
+		expectedPostStart types.String
+	}{
+		{"no lifecycle", nil, types.StringNull(), types.StringNull(), types.StringNull()},
+		{"empty hooks read as null", &client.Lifecycle{}, types.StringNull(), types.StringNull(), types.StringNull()},
+		{"hooks from API", &client.Lifecycle{PreStopCommand: "sleep 5", PostStartCommand: "warm"}, types.StringNull(), types.StringValue("sleep 5"), types.StringValue("warm")},
+		{"planned hook kept when not echoed", &client.Lifecycle{}, types.StringValue("sleep 5"), types.StringValue("sleep 5"), types.StringNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &ApplicationResourceModel{PreStopCommand: tt.plannedPreStop, PostStartCommand: types.StringNull()}
+			resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel", Lifecycle: tt.lifecycle}, data)
+
+			if !data.PreStopCommand.Equal(tt.expectedPreStop) {
+				t.Errorf("Expected pre_stop_command %v, got %v", tt.expectedPreStop, data.PreStopCommand)
+			}
+			if !data.PostStartCommand.Equal(tt.expectedPostStart) {
+				t.Errorf("Expected post_start_command %v, got %v", tt.expectedPostStart, data.PostStartCommand)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_Lifecycle_Validation(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewApplicationResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	for _, name := range []string{"pre_stop_command", "post_start_command"} {
+		attr := resp.Schema.Attributes[name].(schema.StringAttribute)
+		for value, expectError := range map[string]bool{
+			"php artisan cache:clear": false,
+			"sleep 5":                 false,
+			"":                        true,
+			"   ":                     true,
+			"\t\n":                    true,
+		} {
+			if diags := runStringValidators(t, attr.Validators, value); diags.HasError() != expectError {
+				t.Errorf("Expected error %v for %s %q, got diagnostics: %v", expectError, name, value, diags)
+			}
+		}
+	}
+}
+
+// TestApplicationResource_Lifecycle_UpdateClearsRemovedHooks tests that hooks
+// removed from the configuration are cleared, while unchanged unset hooks are
+// left out of the update
+func TestApplicationResource_Lifecycle_UpdateClearsRemovedHooks(t *testing.T) {
+	ctx := context.Background()
+
+	var lifecycle json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]json.RawMessage
+		json.NewDecoder(r.Body).Decode(&body)
+		lifecycle = body["lifecycle"]
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"id": 1, "name": "app", "application_type": "laravel", "status": "running"}}`))
+	}))
+	defer server.Close()
+
+	r := &ApplicationResource{client: client.NewClient("test-token", &server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	tests := []struct {
+		name          string
+		statePreStop  types.String
+		planPreStop   types.String
+		expectedField string
+	}{
+		{"removed hook is cleared", types.StringValue("sleep 5"), types.StringNull(), `{"post_start_command":"","pre_stop_command":""}`},
+		{"never set hook is omitted", types.StringNull(), types.StringNull(), ""},
+		{"changed hook is sent", types.StringValue("sleep 5"), types.StringValue("sleep 10"), `{"post_start_command":"","pre_stop_command":"sleep 10"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lifecycle = nil
+
+			stateModel := reconciliationTestModel("app", false)
+			stateModel.PreStopCommand = tt.statePreStop
+			planModel := reconciliationTestModel("app", false)
+			planModel.PreStopCommand = tt.planPreStop
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			plan.Set(ctx, planModel)
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			state.Set(ctx, stateModel)
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+			}
+
+			if string(lifecycle) != tt.expectedField {
+				t.Errorf("Expected lifecycle %s in the update, got %s", tt.expectedField, lifecycle)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_PathRouting_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+	prefix, strip := "/api", true
+
+	tests := []struct {
+		name        string
+		pathPrefix  types.String
+		stripPrefix types.Bool
+		expected    *client.IngressConfig
+	}{
+		{"unset", types.StringNull(), types.BoolNull(), nil},
+		{"unknown", types.StringUnknown(), types.BoolUnknown(), nil},
+		{"prefix only", types.StringValue("/api"), types.BoolNull(), &client.IngressConfig{PathPrefix: &prefix}},
+		{"prefix stripped", types.StringValue("/api"), types.BoolValue(true), &client.IngressConfig{PathPrefix: &prefix, StripPrefix: &strip}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &ApplicationResourceModel{
+				Name:        types.StringValue("routed-app"),
+				Type:        types.StringValue("laravel"),
+				PathPrefix:  tt.pathPrefix,
+				StripPrefix: tt.stripPrefix,
+			}
+
+			if app := resource.toAPIModel(data); !reflect.DeepEqual(app.Ingress, tt.expected) {
+				t.Errorf("Expected ingress %+v, got %+v", tt.expected, app.Ingress)
+			}
+
+			ingress, ok := resource.toUpdateAPIModel(data)["ingress"]
+			if tt.expected == nil {
+				if ok {
+					t.Errorf("Expected ingress to be omitted from update, got %+v", ingress)
+				}
+			} else if !reflect.DeepEqual(ingress, tt.expected) {
+				t.Errorf("Expected update ingress %+v, got %+v", tt.expected, ingress)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_PathRouting_fromAPIModel(t *testing.T) {
+	resource := &ApplicationResource{}
+	prefix, cleared, strip := "/api", "", false
+
+	tests := []struct {
+		name            string
+		ingress         *client.IngressConfig
+		plannedPrefix  types.String
+		plannedStrip   types.Bool
+		expectedPrefix types.String
+		expectedStrip  types.Bool
+	}{
+		{"no ingress", nil, types.StringNull(), types.BoolUnknown(), types.StringNull(), types.BoolNull()},
+		{"routing from API", &client.IngressConfig{PathPrefix: &prefix, StripPrefix: &strip}, types.StringNull(), types.BoolUnknown(), types.StringValue("/api"), types.BoolValue(false)},
+		{"cleared prefix reads as null", &client.IngressConfig{PathPrefix: &cleared}, types.StringNull(), types.BoolNull(), types.StringNull(), types.BoolNull()},
+		{"planned prefix kept when not echoed", &client.IngressConfig{}, types.StringValue("/api"), types.BoolValue(true), types.StringValue("/api"), types.BoolValue(true)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &ApplicationResourceModel{PathPrefix: tt.plannedPrefix, StripPrefix: tt.plannedStrip}
+			resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel", Ingress: tt.ingress}, data)
+
+			if !data.PathPrefix.Equal(tt.expectedPrefix) {
+				t.Errorf("Expected path_prefix %v, got %v", tt.expectedPrefix, data.PathPrefix)
+			}
+			if !data.StripPrefix.Equal(tt.expectedStrip) {
+				t.Errorf("Expected strip_prefix %v, got %v", tt.expectedStrip, data.StripPrefix)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_PathRouting_Validation(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewApplicationResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	attr := resp.Schema.Attributes["path_prefix"].(schema.StringAttribute)
+	for value, expectError := range map[string]bool{
+		"/":          false,
+		"/api":       false,
+		"/api/v1/":   false,
+		"api":        true,
+		"":           true,
+		"/api v1":    true,
+		"/api?x=1":   true,
+		"/api#top":   true,
+		"https://a/": true,
+	} {
+		if diags := runStringValidators(t, attr.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for path_prefix %q, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		pathPrefix  types.String
+		stripPrefix types.Bool
+		expectError bool
+	}{
+		{"strip with prefix", types.StringValue("/api"), types.BoolValue(true), false},
+		{"strip without prefix", types.StringNull(), types.BoolValue(true), true},
+		{"no strip without prefix", types.StringNull(), types.BoolValue(false), false},
+		{"unset", types.StringNull(), types.BoolNull(), false},
+		{"unknown prefix", types.StringUnknown(), types.BoolValue(true), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validatePathRouting(tt.pathPrefix, tt.stripPrefix)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v, got diagnostics: %v", tt.expectError, diags)
+			}
+		})
+	}
+}
+
+// TestApplicationResource_PathRouting_UpdateClearsRemovedPrefix tests that a
+// path_prefix removed from the configuration is cleared at the ingress
+func TestApplicationResource_PathRouting_UpdateClearsRemovedPrefix(t *testing.T) {
+	ctx := context.Background()
+
+	var ingress json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]json.RawMessage
+		json.NewDecoder(r.Body).Decode(&body)
+		ingress = body["ingress"]
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"id": 1, "name": "app", "application_type": "laravel", "status": "running"}}`))
+	}))
+	defer server.Close()
+
+	r := &ApplicationResource{client: client.NewClient("test-token", &server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	tests := []struct {
+		name          string
+		statePrefix   types.String
+		planPrefix    types.String
+		expectedField string
+	}{
+		{"removed prefix is cleared", types.StringValue("/api"), types.StringNull(), `{"path_prefix":""}`},
+		{"never set prefix is omitted", types.StringNull(), types.StringNull(), ""},
+		{"changed prefix is sent", types.StringValue("/api"), types.StringValue("/v2"), `{"path_prefix":"/v2"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingress = nil
+
+			stateModel := reconciliationTestModel("app", false)
+			stateModel.PathPrefix = tt.statePrefix
+			planModel := reconciliationTestModel("app", false)
+			planModel.PathPrefix = tt.planPrefix
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			plan.Set(ctx, planModel)
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			state.Set(ctx, stateModel)
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+			}
+
+			if string(ingress) != tt.expectedField {
+				t.Errorf("Expected ingress %s in the update, got %s", tt.expectedField, ingress)
+			}
+		})
+	}
+}
+
+// TestApplicationResource_ImportState_FullState tests that an import fills the
+// runtime and settings blocks right away, and that the refresh before the next
+// plan leaves the imported state unchanged
+func TestApplicationResource_ImportState_FullState(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/applications/7" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"id": 7, "name": "imported", "application_type": "laravel", "application_version": "11.x",
+			"php_version": "8.3", "health_check_path": "/health", "scheduler_enabled": true, "replicas": 2,
+			"cpu_request": "500m", "memory_request": "1Gi", "status": "running"}}`))
+	}))
+	defer server.Close()
+
+	r := &ApplicationResource{client: client.NewClient("test-token", &server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	importResp := &resource.ImportStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "7"}, importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected import errors: %v", importResp.Diagnostics)
+	}
+
+	var imported ApplicationResourceModel
+	importResp.State.Get(ctx, &imported)
+
+	if imported.Runtime == nil || imported.Runtime.PHPVersion.ValueString() != "8.3" || !imported.Runtime.NodeJSVersion.IsNull() {
+		t.Errorf("Expected the runtime block with php_version 8.3, got %+v", imported.Runtime)
+	}
+	if imported.Settings == nil {
+		t.Fatal("Expected the settings block to be populated")
+	}
+	// Matches a configuration declaring these settings, so the plan shows no diff
+	for name, check := range map[string]bool{
+		"name":                       imported.Name.ValueString() == "imported",
+		"type":                       imported.Type.ValueString() == "laravel",
+		"settings.health_check_path": imported.Settings.HealthCheckPath.ValueString() == "/health",
+		"settings.scheduler_enabled": imported.Settings.SchedulerEnabled.ValueBool(),
+		"settings.replicas":          imported.Settings.Replicas.ValueInt64() == 2,
+		"settings.cpu_request":       imported.Settings.CPURequest.ValueString() == "500m",
+		"settings.memory_request":    imported.Settings.MemoryRequest.ValueString() == "1Gi",
+		"reconciliation_paused":      !imported.ReconciliationPaused.IsNull() && !imported.ReconciliationPaused.ValueBool(),
+		"wait_for_deployment":        !imported.WaitForDeployment.IsNull() && !imported.WaitForDeployment.ValueBool(),
+	} {
+		if !check {
+			t.Errorf("Unexpected imported %s: %+v", name, imported)
+		}
+	}
+
+	readResp := &resource.ReadResponse{State: importResp.State}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected read errors: %v", readResp.Diagnostics)
+	}
+	if !readResp.State.Raw.Equal(importResp.State.Raw) {
+		diffs, _ := readResp.State.Raw.Diff(importResp.State.Raw)
+		t.Errorf("Expected the refresh after import to leave the state unchanged, got diffs %v", diffs)
+	}
+
+	// Missing applications fail the import instead of leaving an empty state
+	missingResp := &resource.ImportStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "8"}, missingResp)
+	if !missingResp.Diagnostics.HasError() || missingResp.Diagnostics[0].Summary() != "Application Not Found" {
+		t.Errorf("Expected an Application Not Found error, got %v", missingResp.Diagnostics)
+	}
+}
+
+func TestApplicationResource_DeployedCommit_fromAPIModel(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		DeployedCommitSHA:     types.StringUnknown(),
+		DeployedCommitMessage: types.StringUnknown(),
+	}
+	resource.fromAPIModel(&client.Application{
+		ID:                    1,
+		Type:                  "laravel",
+		DeployedCommitSHA:     "3f2c1ab9d0e4",
+		DeployedCommitMessage: "Fix checkout rounding",
+	}, data)
+
+	if !data.DeployedCommitSHA.Equal(types.StringValue("3f2c1ab9d0e4")) {
+		t.Errorf("Expected deployed commit SHA from API, got %v", data.DeployedCommitSHA)
+	}
+	if !data.DeployedCommitMessage.Equal(types.StringValue("Fix checkout rounding")) {
+		t.Errorf("Expected deployed commit message from API, got %v", data.DeployedCommitMessage)
+	}
+
+	// A response without commit info keeps the last known commit instead of drifting to null
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.DeployedCommitSHA.Equal(types.StringValue("3f2c1ab9d0e4")) || !data.DeployedCommitMessage.Equal(types.StringValue("Fix checkout rounding")) {
+		t.Errorf("Expected the known commit to be kept, got %v %v", data.DeployedCommitSHA, data.DeployedCommitMessage)
+	}
+
+	// Never deployed: unknown values resolve to null
+	data = &ApplicationResourceModel{
+		DeployedCommitSHA:     types.StringUnknown(),
+		DeployedCommitMessage: types.StringUnknown(),
+	}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.DeployedCommitSHA.IsNull() || !data.DeployedCommitMessage.IsNull() {
+		t.Errorf("Expected null commit attributes, got %v %v", data.DeployedCommitSHA, data.DeployedCommitMessage)
+	}
+}
+
+func TestApplicationResource_HostAliases_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Name: types.StringValue("aliased-app"),
+		Type: types.StringValue("laravel"),
+		HostAliases: []HostAliasModel{
+			{Hostname: types.StringValue("db.internal"), IP: types.StringValue("10.0.0.5")},
+			{Hostname: types.StringValue("ldap.corp"), IP: types.StringValue("2001:db8::5")},
+		},
+	}
+
+	expected := []client.HostAlias{
+		{Hostname: "db.internal", IP: "10.0.0.5"},
+		{Hostname: "ldap.corp", IP: "2001:db8::5"},
+	}
+
+	if app := resource.toAPIModel(data); !reflect.DeepEqual(app.HostAliases, expected) {
+		t.Errorf("Expected host aliases %+v, got %+v", expected, app.HostAliases)
+	}
+	if update := resource.toUpdateAPIModel(data); !reflect.DeepEqual(update["host_aliases"], expected) {
+		t.Errorf("Expected update host aliases %+v, got %+v", expected, update["host_aliases"])
+	}
+
+	// Removing all blocks must send an empty list so the API clears them
+	data.HostAliases = []HostAliasModel{}
+	if hostAliases, ok := resource.toUpdateAPIModel(data)["host_aliases"].([]client.HostAlias); !ok || len(hostAliases) != 0 {
+		t.Errorf("Expected empty host aliases in update, got %v", resource.toUpdateAPIModel(data)["host_aliases"])
+	}
+
+	data.HostAliases = nil
+	if _, ok := resource.toUpdateAPIModel(data)["host_aliases"]; ok {
+		t.Error("Expected host aliases to be omitted from update when unset")
+	}
+	if app := resource.toAPIModel(data); app.HostAliases != nil {
+		t.Errorf("Expected host aliases to be omitted on create, got %+v", app.HostAliases)
+	}
+
+	// Read back
+	resource.fromAPIModel(&client.Application{
+		ID:          1,
+		Type:        "laravel",
+		HostAliases: []client.HostAlias{{Hostname: "db.internal", IP: "10.0.0.6"}},
+	}, data)
+	expectedModel := []HostAliasModel{{Hostname: types.StringValue("db.internal"), IP: types.StringValue("10.0.0.6")}}
+	if !reflect.DeepEqual(data.HostAliases, expectedModel) {
+		t.Errorf("Expected host aliases from API %+v, got %+v", expectedModel, data.HostAliases)
+	}
+}
+
+func TestApplicationResource_HostAliases_Validation(t *testing.T) {
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	block := resp.Schema.Blocks["host_aliases"].(schema.ListNestedBlock)
+
+	tests := []struct {
+		attribute   string
+		value       string
+		expectError bool
+	}{
+		{"hostname", "db.internal", false},
+		{"hostname", "api-1", false},
+		{"hostname", "Mail.Example.COM", false},
+		{"hostname", "", true},
+		{"hostname", "-db.internal", true},
+		{"hostname", "db-.internal", true},
+		{"hostname", "db..internal", true},
+		{"hostname", "db_internal", true},
+		{"hostname", "db.internal.", true},
+		{"hostname", strings.Repeat("a", 64) + ".internal", true},
+		{"hostname", strings.Repeat(strings.Repeat("a", 63)+".", 4) + "internal", true},
+		{"ip", "10.0.0.5", false},
+		{"ip", "2001:db8::5", false},
+		{"ip", "10.0.0.0/8", true},
+		{"ip", "10.0.0.256", true},
+		{"ip", "db.internal", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attribute+"="+tt.value, func(t *testing.T) {
+			attr := block.NestedObject.Attributes[tt.attribute].(schema.StringAttribute)
+			diags := runStringValidators(t, attr.Validators, tt.value)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for %s '%s', got diagnostics: %v", tt.expectError, tt.attribute, tt.value, diags)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_ErrorPages_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Name: types.StringValue("branded-app"),
+		Type: types.StringValue("laravel"),
+		ErrorPages: map[string]ErrorPageModel{
+			"404": {Content: types.StringValue("<h1>Not found</h1>"), URL: types.StringNull()},
+			"503": {Content: types.StringNull(), URL: types.StringValue("https://status.example.com/503.html")},
+		},
+	}
+
+	expected := map[string]client.ErrorPage{
+		"404": {Content: "<h1>Not found</h1>"},
+		"503": {URL: "https://status.example.com/503.html"},
+	}
+
+	if app := resource.toAPIModel(data); app.Ingress == nil || !reflect.DeepEqual(app.Ingress.ErrorPages, expected) {
+		t.Errorf("Expected error pages %+v, got %+v", expected, app.Ingress)
+	}
+	if ingress, ok := resource.toUpdateAPIModel(data)["ingress"].(*client.IngressConfig); !ok || !reflect.DeepEqual(ingress.ErrorPages, expected) {
+		t.Errorf("Expected update error pages %+v, got %+v", expected, resource.toUpdateAPIModel(data)["ingress"])
+	}
+
+	data.ErrorPages = nil
+	if app := resource.toAPIModel(data); app.Ingress != nil {
+		t.Errorf("Expected ingress to be omitted without error pages, got %+v", app.Ingress)
+	}
+
+	// Read back
+	resource.fromAPIModel(&client.Application{
+		ID:   1,
+		Type: "laravel",
+		Ingress: &client.IngressConfig{ErrorPages: map[string]client.ErrorPage{
+			"500": {Content: "<h1>Oops</h1>"},
+		}},
+	}, data)
+	expectedModel := map[string]ErrorPageModel{
+		"500": {Content: types.StringValue("<h1>Oops</h1>"), URL: types.StringNull()},
+	}
+	if !reflect.DeepEqual(data.ErrorPages, expectedModel) {
+		t.Errorf("Expected error pages %+v from API, got %+v", expectedModel, data.ErrorPages)
+	}
+
+	// Unset stays unset when the API returns none
+	data.ErrorPages = nil
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if data.ErrorPages != nil {
+		t.Errorf("Expected error pages to remain unset, got %+v", data.ErrorPages)
+	}
+}
+
+func TestApplicationResource_ErrorPages_Validation(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewApplicationResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	errorPages := resp.Schema.Attributes["error_pages"].(schema.MapNestedAttribute)
+
+	for status, expectError := range map[string]bool{
+		"404": false,
+		"500": false,
+		"599": false,
+		"200": true,
+		"302": true,
+		"600": true,
+		"40":  true,
+		"4xx": true,
+	} {
+		var diags diag.Diagnostics
+		for _, v := range errorPages.Validators {
+			req := validator.MapRequest{
+				Path:        path.Root("error_pages"),
+				ConfigValue: types.MapValueMust(types.StringType, map[string]attr.Value{status: types.StringValue("page")}),
+			}
+			resp := &validator.MapResponse{}
+			v.ValidateMap(context.Background(), req, resp)
+			diags.Append(resp.Diagnostics...)
+		}
+		if diags.HasError() != expectError {
+			t.Errorf("Expected error %v for status code %q, got diagnostics: %v", expectError, status, diags)
+		}
+	}
+
+	url := errorPages.NestedObject.Attributes["url"].(schema.StringAttribute)
+	for value, expectError := range map[string]bool{
+		"https://status.example.com/503.html": false,
+		"http://errors.internal":              false,
+		"ftp://example.com/503.html":          true,
+		"/503.html":                           true,
+		"https://":                            true,
+	} {
+		if diags := runStringValidators(t, url.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for url %q, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		page        ErrorPageModel
+		expectError bool
+	}{
+		{"content", ErrorPageModel{Content: types.StringValue("<h1>Down</h1>"), URL: types.StringNull()}, false},
+		{"url", ErrorPageModel{Content: types.StringNull(), URL: types.StringValue("https://example.com/503.html")}, false},
+		{"unknown url", ErrorPageModel{Content: types.StringNull(), URL: types.StringUnknown()}, false},
+		{"both", ErrorPageModel{Content: types.StringValue("<h1>Down</h1>"), URL: types.StringValue("https://example.com/503.html")}, true},
+		{"neither", ErrorPageModel{Content: types.StringNull(), URL: types.StringNull()}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateErrorPages(map[string]ErrorPageModel{"503": tt.page})
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v, got diagnostics: %v", tt.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_Canary_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Name: types.StringValue("canary-app"),
+		Type: types.StringValue("laravel"),
+		Canary: &CanaryModel{
+			Enabled:             types.BoolValue(true),
+			TrafficPercentage:   types.Int64Value(25),
+			PromoteAfterSeconds: types.Int64Value(600),
+		},
+	}
+
+	expected := &client.Canary{Enabled: true, TrafficPercentage: 25, PromoteAfterSeconds: 600}
+
+	if app := resource.toAPIModel(data); !reflect.DeepEqual(app.Canary, expected) {
+		t.Errorf("Expected canary %+v, got %+v", expected, app.Canary)
+	}
+	if update := resource.toUpdateAPIModel(data); !reflect.DeepEqual(update["canary"], expected) {
+		t.Errorf("Expected update canary %+v, got %+v", expected, update["canary"])
+	}
+
+	// Zero percent is a valid, explicit value
+	data.Canary.TrafficPercentage = types.Int64Value(0)
+	data.Canary.PromoteAfterSeconds = types.Int64Null()
+	expected = &client.Canary{Enabled: true, TrafficPercentage: 0}
+	if app := resource.toAPIModel(data); !reflect.DeepEqual(app.Canary, expected) {
+		t.Errorf("Expected canary %+v, got %+v", expected, app.Canary)
+	}
+
+	data.Canary = nil
+	if app := resource.toAPIModel(data); app.Canary != nil {
+		t.Errorf("Expected canary to be omitted, got %+v", app.Canary)
+	}
+	if _, ok := resource.toUpdateAPIModel(data)["canary"]; ok {
+		t.Error("Expected canary to be omitted from update")
+	}
+}
+
+func TestApplicationResource_Canary_fromAPIModel(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Canary: &CanaryModel{
+			Enabled:             types.BoolValue(true),
+			TrafficPercentage:   types.Int64Value(10),
+			PromoteAfterSeconds: types.Int64Value(300),
+		},
+	}
+
+	resource.fromAPIModel(&client.Application{
+		ID:     1,
+		Type:   "laravel",
+		Canary: &client.Canary{Enabled: false, TrafficPercentage: 50},
+	}, data)
+
+	if !data.Canary.Enabled.Equal(types.BoolValue(false)) {
+		t.Errorf("Expected enabled false from API, got %v", data.Canary.Enabled)
+	}
+	if !data.Canary.TrafficPercentage.Equal(types.Int64Value(50)) {
+		t.Errorf("Expected traffic percentage 50 from API, got %v", data.Canary.TrafficPercentage)
+	}
+	if !data.Canary.PromoteAfterSeconds.Equal(types.Int64Value(300)) {
+		t.Errorf("Expected planned promote_after_seconds to be preserved, got %v", data.Canary.PromoteAfterSeconds)
+	}
+
+	// An unconfigured block is not populated from the API
+	data = &ApplicationResourceModel{}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel", Canary: &client.Canary{Enabled: true}}, data)
+	if data.Canary != nil {
+		t.Errorf("Expected canary to stay unset, got %+v", data.Canary)
+	}
+}
+
+func TestApplicationResource_Canary_Validation(t *testing.T) {
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	block := resp.Schema.Blocks["canary"].(schema.SingleNestedBlock)
+
+	tests := []struct {
+		attribute   string
+		value       int64
+		expectError bool
+	}{
+		{"traffic_percentage", 0, false},
+		{"traffic_percentage", 50, false},
+		{"traffic_percentage", 100, false},
+		{"traffic_percentage", -1, true},
+		{"traffic_percentage", 101, true},
+		{"promote_after_seconds", 0, false},
+		{"promote_after_seconds", 3600, false},
+		{"promote_after_seconds", -30, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s=%d", tt.attribute, tt.value), func(t *testing.T) {
+			attr := block.Attributes[tt.attribute].(schema.Int64Attribute)
+			diags := runInt64Validators(t, attr.Validators, tt.value)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for %s %d, got diagnostics: %v", tt.expectError, tt.attribute, tt.value, diags)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_AutoSleep_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Name: types.StringValue("preview-app"),
+		Type: types.StringValue("laravel"),
+		AutoSleep: &AutoSleepModel{
+			Enabled:            types.BoolValue(true),
+			IdleTimeoutMinutes: types.Int64Value(30),
+		},
+	}
+
+	expected := &client.AutoSleep{Enabled: true, IdleTimeoutMinutes: 30}
+	if app := resource.toAPIModel(data); !reflect.DeepEqual(app.AutoSleep, expected) {
+		t.Errorf("Expected auto sleep %+v, got %+v", expected, app.AutoSleep)
+	}
+	if update := resource.toUpdateAPIModel(data); !reflect.DeepEqual(update["auto_sleep"], expected) {
+		t.Errorf("Expected update auto sleep %+v, got %+v", expected, update["auto_sleep"])
+	}
+
+	// Disabling keeps the block so the API switches auto sleep off
+	data.AutoSleep = &AutoSleepModel{Enabled: types.BoolValue(false), IdleTimeoutMinutes: types.Int64Null()}
+	expected = &client.AutoSleep{Enabled: false}
+	if app := resource.toAPIModel(data); !reflect.DeepEqual(app.AutoSleep, expected) {
+		t.Errorf("Expected auto sleep %+v, got %+v", expected, app.AutoSleep)
+	}
+
+	data.AutoSleep = nil
+	if app := resource.toAPIModel(data); app.AutoSleep != nil {
+		t.Errorf("Expected auto sleep to be omitted, got %+v", app.AutoSleep)
+	}
+	if _, ok := resource.toUpdateAPIModel(data)["auto_sleep"]; ok {
+		t.Error("Expected auto sleep to be omitted from update")
+	}
+}
+
+func TestApplicationResource_AutoSleep_fromAPIModel(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		AutoSleep: &AutoSleepModel{
+			Enabled:            types.BoolValue(true),
+			IdleTimeoutMinutes: types.Int64Value(15),
+		},
+	}
+
+	resource.fromAPIModel(&client.Application{
+		ID:        1,
+		Type:      "laravel",
+		AutoSleep: &client.AutoSleep{Enabled: false, IdleTimeoutMinutes: 60},
+	}, data)
+
+	if !data.AutoSleep.Enabled.Equal(types.BoolValue(false)) {
+		t.Errorf("Expected enabled false from API, got %v", data.AutoSleep.Enabled)
+	}
+	if !data.AutoSleep.IdleTimeoutMinutes.Equal(types.Int64Value(60)) {
+		t.Errorf("Expected idle timeout 60 from API, got %v", data.AutoSleep.IdleTimeoutMinutes)
+	}
+
+	// An unconfigured block is not populated from the API
+	data = &ApplicationResourceModel{}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel", AutoSleep: &client.AutoSleep{Enabled: true, IdleTimeoutMinutes: 30}}, data)
+	if data.AutoSleep != nil {
+		t.Errorf("Expected auto sleep to stay unset, got %+v", data.AutoSleep)
+	}
+}
+
+func TestApplicationResource_AutoSleep_Validation(t *testing.T) {
+	tests := []struct {
+		name        string
+		autoSleep   *AutoSleepModel
+		expectError bool
+	}{
+		{"unset block", nil, false},
+		{"enabled with timeout", &AutoSleepModel{Enabled: types.BoolValue(true), IdleTimeoutMinutes: types.Int64Value(30)}, false},
+		{"default enabled with timeout", &AutoSleepModel{Enabled: types.BoolNull(), IdleTimeoutMinutes: types.Int64Value(30)}, false},
+		{"unknown timeout", &AutoSleepModel{Enabled: types.BoolValue(true), IdleTimeoutMinutes: types.Int64Unknown()}, false},
+		{"disabled without timeout", &AutoSleepModel{Enabled: types.BoolValue(false), IdleTimeoutMinutes: types.Int64Null()}, false},
+		{"enabled without timeout", &AutoSleepModel{Enabled: types.BoolValue(true), IdleTimeoutMinutes: types.Int64Null()}, true},
+		{"default enabled without timeout", &AutoSleepModel{Enabled: types.BoolNull(), IdleTimeoutMinutes: types.Int64Null()}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateAutoSleep(tt.autoSleep)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v, got diagnostics: %v", tt.expectError, diags)
+			}
+		})
+	}
+
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	attr := resp.Schema.Blocks["auto_sleep"].(schema.SingleNestedBlock).Attributes["idle_timeout_minutes"].(schema.Int64Attribute)
+	for value, expectError := range map[int64]bool{1: false, 60: false, 0: true, -5: true} {
+		if diags := runInt64Validators(t, attr.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for idle timeout %d, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+}
+
+func TestApplicationResource_Egress_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Name:   types.StringValue("egress-app"),
+		Type:   types.StringValue("laravel"),
+		Egress: &EgressModel{StaticIP: types.BoolValue(true)},
+	}
+
+	expected := &client.Egress{StaticIP: true}
+	if app := resource.toAPIModel(data); !reflect.DeepEqual(app.Egress, expected) {
+		t.Errorf("Expected egress %+v, got %+v", expected, app.Egress)
+	}
+	if update := resource.toUpdateAPIModel(data); !reflect.DeepEqual(update["egress"], expected) {
+		t.Errorf("Expected update egress %+v, got %+v", expected, update["egress"])
+	}
+
+	data.Egress = nil
+	if app := resource.toAPIModel(data); app.Egress != nil {
+		t.Errorf("Expected egress to be omitted, got %+v", app.Egress)
+	}
+}
+
+func TestApplicationResource_Egress_fromAPIModel(t *testing.T) {
+	tests := []struct {
+		name            string
+		apiApp          *client.Application
+		expectedIP      types.String
+		expectedWarning bool
+	}{
+		{
+			name:       "static IP assigned",
+			apiApp:     &client.Application{ID: 1, Type: "laravel", Egress: &client.Egress{StaticIP: true}, EgressIP: "203.0.113.10"},
+			expectedIP: types.StringValue("203.0.113.10"),
+		},
+		{
+			name:            "static egress unavailable on plan",
+			apiApp:          &client.Application{ID: 1, Type: "laravel", Egress: &client.Egress{StaticIP: false}},
+			expectedIP:      types.StringNull(),
+			expectedWarning: true,
+		},
+		{
+			name:            "egress omitted by API",
+			apiApp:          &client.Application{ID: 1, Type: "laravel"},
+			expectedIP:      types.StringNull(),
+			expectedWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := &ApplicationResource{}
+			data := &ApplicationResourceModel{
+				Egress:   &EgressModel{StaticIP: types.BoolValue(true)},
+				EgressIP: types.StringUnknown(),
+			}
+
+			resource.fromAPIModel(tt.apiApp, data)
+
+			if !data.EgressIP.Equal(tt.expectedIP) {
+				t.Errorf("Expected egress_ip %v, got %v", tt.expectedIP, data.EgressIP)
+			}
+			// The requested value is kept to avoid an inconsistent result after apply
+			if !data.Egress.StaticIP.Equal(types.BoolValue(true)) {
+				t.Errorf("Expected static_ip to stay true, got %v", data.Egress.StaticIP)
+			}
+
+			diags := egressDiagnostics(data)
+			if diags.HasError() {
+				t.Errorf("Expected no errors, got %v", diags)
+			}
+			if (diags.WarningsCount() > 0) != tt.expectedWarning {
+				t.Errorf("Expected warning %v, got diagnostics: %v", tt.expectedWarning, diags)
+			}
+		})
+	}
+
+	// No warning when static egress is not requested
+	data := &ApplicationResourceModel{Egress: &EgressModel{StaticIP: types.BoolValue(false)}, EgressIP: types.StringNull()}
+	if diags := egressDiagnostics(data); len(diags) != 0 {
+		t.Errorf("Expected no diagnostics, got %v", diags)
+	}
+}
+
+func TestApplicationResource_BasicAuth_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Name: types.StringValue("staging"),
+		Type: types.StringValue("laravel"),
+		BasicAuth: &BasicAuthModel{
+			Enabled:   types.BoolValue(true),
+			Username:  types.StringValue("preview"),
+			Password:  types.StringValue("s3cret"),
+			ValueHash: types.StringUnknown(),
+		},
+	}
+
+	expected := &client.BasicAuth{Enabled: true, Username: "preview", Password: "s3cret"}
+	if app := resource.toAPIModel(data); !reflect.DeepEqual(app.BasicAuth, expected) {
+		t.Errorf("Expected basic auth %+v, got %+v", expected, app.BasicAuth)
+	}
+	if update := resource.toUpdateAPIModel(data); !reflect.DeepEqual(update["basic_auth"], expected) {
+		t.Errorf("Expected update basic auth %+v, got %+v", expected, update["basic_auth"])
+	}
+
+	// Read back: password is not returned, the hash is derived locally
+	resource.fromAPIModel(&client.Application{
+		ID:        1,
+		Type:      "laravel",
+		BasicAuth: &client.BasicAuth{Enabled: true, Username: "preview"},
+	}, data)
+
+	if !data.BasicAuth.Password.Equal(types.StringValue("s3cret")) {
+		t.Errorf("Expected password to be preserved, got %v", data.BasicAuth.Password)
+	}
+	expectedHash := basicAuthValueHash("preview", "s3cret")
+	if !data.BasicAuth.ValueHash.Equal(types.StringValue(expectedHash)) {
+		t.Errorf("Expected value_hash %s, got %v", expectedHash, data.BasicAuth.ValueHash)
+	}
+	if basicAuthValueHash("preview", "other") == expectedHash {
+		t.Error("Expected different passwords to produce different hashes")
+	}
+
+	data.BasicAuth = nil
+	if app := resource.toAPIModel(data); app.BasicAuth != nil {
+		t.Errorf("Expected basic auth to be omitted, got %+v", app.BasicAuth)
+	}
+}
+
+func TestApplicationResource_BasicAuth_Drift(t *testing.T) {
+	newData := func() *ApplicationResourceModel {
+		return &ApplicationResourceModel{
+			BasicAuth: &BasicAuthModel{
+				Enabled:   types.BoolValue(true),
+				Username:  types.StringValue("preview"),
+				Password:  types.StringValue("s3cret"),
+				ValueHash: types.StringValue(basicAuthValueHash("preview", "s3cret")),
+			},
+		}
+	}
+
+	// Matching hash keeps the password
+	data := newData()
+	detectBasicAuthDrift(&client.Application{BasicAuth: &client.BasicAuth{ValueHash: basicAuthValueHash("preview", "s3cret")}}, data)
+	if data.BasicAuth.Password.IsNull() {
+		t.Error("Expected password to be kept when the hash matches")
+	}
+
+	// Password changed outside Terraform
+	data = newData()
+	detectBasicAuthDrift(&client.Application{BasicAuth: &client.BasicAuth{ValueHash: basicAuthValueHash("preview", "changed")}}, data)
+	if !data.BasicAuth.Password.IsNull() {
+		t.Errorf("Expected password to be cleared on drift, got %v", data.BasicAuth.Password)
+	}
+	if !data.BasicAuth.ValueHash.Equal(types.StringValue(basicAuthValueHash("preview", "changed"))) {
+		t.Errorf("Expected value_hash from API, got %v", data.BasicAuth.ValueHash)
+	}
+
+	// API without a hash cannot be compared
+	data = newData()
+	detectBasicAuthDrift(&client.Application{BasicAuth: &client.BasicAuth{Enabled: true}}, data)
+	if data.BasicAuth.Password.IsNull() {
+		t.Error("Expected password to be kept when the API returns no hash")
+	}
+}
+
+func TestApplicationResource_BasicAuth_Validation(t *testing.T) {
+	tests := []struct {
+		name           string
+		basicAuth      *BasicAuthModel
+		expectedErrors int
+	}{
+		{
+			name:      "block not configured",
+			basicAuth: nil,
+		},
+		{
+			name: "enabled with credentials",
+			basicAuth: &BasicAuthModel{
+				Enabled:  types.BoolValue(true),
+				Username: types.StringValue("preview"),
+				Password: types.StringValue("s3cret"),
+			},
+		},
+		{
+			name: "enabled by default without username",
+			basicAuth: &BasicAuthModel{
+				Enabled:  types.BoolNull(),
+				Username: types.StringNull(),
+				Password: types.StringValue("s3cret"),
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "enabled with empty credentials",
+			basicAuth: &BasicAuthModel{
+				Enabled:  types.BoolValue(true),
+				Username: types.StringValue(""),
+				Password: types.StringNull(),
+			},
+			expectedErrors: 2,
+		},
+		{
+			name: "credentials from unknown values",
+			basicAuth: &BasicAuthModel{
+				Enabled:  types.BoolValue(true),
+				Username: types.StringUnknown(),
+				Password: types.StringUnknown(),
+			},
+		},
+		{
+			name: "disabled without credentials",
+			basicAuth: &BasicAuthModel{
+				Enabled:  types.BoolValue(false),
+				Username: types.StringNull(),
+				Password: types.StringNull(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateBasicAuth(tt.basicAuth)
+			if diags.ErrorsCount() != tt.expectedErrors {
+				t.Errorf("Expected %d errors, got diagnostics: %v", tt.expectedErrors, diags)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_Headers_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+	ctx := context.Background()
+
+	add, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"X-Frame-Options": "DENY"})
+	remove, _ := types.ListValueFrom(ctx, types.StringType, []string{"X-Powered-By", "Server"})
+
+	data := &ApplicationResourceModel{
+		Name: types.StringValue("headers-app"),
+		Type: types.StringValue("laravel"),
+		Headers: &HeadersModel{
+			Add:    add,
+			Set:    types.MapNull(types.StringType),
+			Remove: remove,
+		},
+	}
+
+	expected := &client.HeaderRules{
+		Add:    map[string]string{"X-Frame-Options": "DENY"},
+		Remove: []string{"X-Powered-By", "Server"},
+	}
+	if app := resource.toAPIModel(data); !reflect.DeepEqual(app.Headers, expected) {
+		t.Errorf("Expected headers %+v, got %+v", expected, app.Headers)
+	}
+	if update := resource.toUpdateAPIModel(data); !reflect.DeepEqual(update["headers"], expected) {
+		t.Errorf("Expected update headers %+v, got %+v", expected, update["headers"])
+	}
+
+	// Read back: API values win, unset attributes stay null
+	resource.fromAPIModel(&client.Application{
+		ID:   1,
+		Type: "laravel",
+		Headers: &client.HeaderRules{
+			Add:    map[string]string{"X-Frame-Options": "SAMEORIGIN"},
+			Remove: []string{"X-Powered-By"},
+		},
+	}, data)
+
+	expectedAdd, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"X-Frame-Options": "SAMEORIGIN"})
+	if !data.Headers.Add.Equal(expectedAdd) {
+		t.Errorf("Expected add %v, got %v", expectedAdd, data.Headers.Add)
+	}
+	if !data.Headers.Set.IsNull() {
+		t.Errorf("Expected set to stay null, got %v", data.Headers.Set)
+	}
+	expectedRemove, _ := types.ListValueFrom(ctx, types.StringType, []string{"X-Powered-By"})
+	if !data.Headers.Remove.Equal(expectedRemove) {
+		t.Errorf("Expected remove %v, got %v", expectedRemove, data.Headers.Remove)
+	}
+
+	data.Headers = nil
+	if app := resource.toAPIModel(data); app.Headers != nil {
+		t.Errorf("Expected headers to be omitted, got %+v", app.Headers)
+	}
+}
+
+func TestApplicationResource_Headers_Validation(t *testing.T) {
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	block := resp.Schema.Blocks["headers"].(schema.SingleNestedBlock)
+
+	tests := []struct {
+		name        string
+		header      string
+		expectError bool
+	}{
+		{"standard header", "X-Frame-Options", false},
+		{"long header", "Strict-Transport-Security", false},
+		{"token characters", "X-Custom_Header.v2", false},
+		{"space", "Bad Header", true},
+		{"colon", "X-Header:", true},
+		{"empty", "", true},
+		{"non-ascii", "X-Ümlaut", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			for _, name := range []string{"add", "set"} {
+				attr := block.Attributes[name].(schema.MapAttribute)
+				value, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{tt.header: "value"})
+				var hasError bool
+				for _, v := range attr.Validators {
+					vResp := &validator.MapResponse{}
+					v.ValidateMap(ctx, validator.MapRequest{Path: path.Root(name), ConfigValue: value}, vResp)
+					hasError = hasError || vResp.Diagnostics.HasError()
+				}
+				if hasError != tt.expectError {
+					t.Errorf("Expected error %v for %s header %q", tt.expectError, name, tt.header)
+				}
+			}
+
+			attr := block.Attributes["remove"].(schema.ListAttribute)
+			value, _ := types.ListValueFrom(ctx, types.StringType, []string{tt.header})
+			var hasError bool
+			for _, v := range attr.Validators {
+				vResp := &validator.ListResponse{}
+				v.ValidateList(ctx, validator.ListRequest{Path: path.Root("remove"), ConfigValue: value}, vResp)
+				hasError = hasError || vResp.Diagnostics.HasError()
+			}
+			if hasError != tt.expectError {
+				t.Errorf("Expected error %v for remove header %q", tt.expectError, tt.header)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_SchedulerConcurrencyPolicy_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	tests := []struct {
+		name     string
+		policy   types.String
+		expected string
+	}{
+		{"forbid", types.StringValue("Forbid"), "Forbid"},
+		{"replace", types.StringValue("Replace"), "Replace"},
+		{"null policy", types.StringNull(), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &ApplicationResourceModel{
+				Name: types.StringValue("scheduler-app"),
+				Type: types.StringValue("laravel"),
+				Settings: &SettingsModel{
+					SchedulerEnabled:           types.BoolValue(true),
+					SchedulerConcurrencyPolicy: tt.policy,
+				},
+			}
+
+			app := resource.toAPIModel(data)
+			if app.SchedulerConcurrencyPolicy != tt.expected {
+				t.Errorf("Expected SchedulerConcurrencyPolicy '%s', got '%s'", tt.expected, app.SchedulerConcurrencyPolicy)
+			}
+
+			update := resource.toUpdateAPIModel(data)
+			value, ok := update["scheduler_concurrency_policy"]
+			if tt.expected == "" && ok {
+				t.Errorf("Expected scheduler_concurrency_policy to be omitted from update, got %v", value)
+			}
+			if tt.expected != "" && value != tt.expected {
+				t.Errorf("Expected update scheduler_concurrency_policy '%s', got %v", tt.expected, value)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_SchedulerConcurrencyPolicy_fromAPIModel(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	// API value is read back into state
+	data := &ApplicationResourceModel{Settings: &SettingsModel{SchedulerConcurrencyPolicy: types.StringNull()}}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel", SchedulerConcurrencyPolicy: "Forbid"}, data)
+	if !data.Settings.SchedulerConcurrencyPolicy.Equal(types.StringValue("Forbid")) {
+		t.Errorf("Expected SchedulerConcurrencyPolicy 'Forbid', got %v", data.Settings.SchedulerConcurrencyPolicy)
+	}
+
+	// Null config stays null when the API omits the field
+	data = &ApplicationResourceModel{Settings: &SettingsModel{SchedulerConcurrencyPolicy: types.StringNull()}}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.Settings.SchedulerConcurrencyPolicy.IsNull() {
+		t.Errorf("Expected SchedulerConcurrencyPolicy to remain null, got %v", data.Settings.SchedulerConcurrencyPolicy)
+	}
+
+	// Planned value is preserved when the API omits the field
+	data = &ApplicationResourceModel{Settings: &SettingsModel{SchedulerConcurrencyPolicy: types.StringValue("Replace")}}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.Settings.SchedulerConcurrencyPolicy.Equal(types.StringValue("Replace")) {
+		t.Errorf("Expected SchedulerConcurrencyPolicy 'Replace' to be preserved, got %v", data.Settings.SchedulerConcurrencyPolicy)
+	}
+}
+
+func TestApplicationResource_SchedulerConcurrencyPolicy_Validation(t *testing.T) {
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	settings, ok := resp.Schema.Blocks["settings"].(schema.SingleNestedBlock)
+	if !ok {
+		t.Fatal("Expected settings to be a single nested block")
+	}
+	attr, ok := settings.Attributes["scheduler_concurrency_policy"].(schema.StringAttribute)
+	if !ok {
+		t.Fatal("Expected scheduler_concurrency_policy to be a string attribute")
+	}
+
+	tests := []struct {
+		value       string
+		expectError bool
+	}{
+		{"Allow", false},
+		{"Forbid", false},
+		{"Replace", false},
+		{"forbid", true},
+		{"Skip", true},
+		{"", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			diags := runStringValidators(t, attr.Validators, tt.value)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for '%s', got diagnostics: %v", tt.expectError, tt.value, diags)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_OOM_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Name: types.StringValue("memory-hungry-app"),
+		Type: types.StringValue("laravel"),
+		Settings: &SettingsModel{
+			OOMRestartPolicy: types.StringValue("kill"),
+			OOMScoreAdjust:   types.Int64Value(0),
+		},
+	}
+
+	app := resource.toAPIModel(data)
+	if app.OOMRestartPolicy != "kill" {
+		t.Errorf("Expected OOMRestartPolicy 'kill', got '%s'", app.OOMRestartPolicy)
+	}
+	if app.OOMScoreAdjust == nil || *app.OOMScoreAdjust != 0 {
+		t.Errorf("Expected OOMScoreAdjust 0 to be sent, got %v", app.OOMScoreAdjust)
+	}
+
+	update := resource.toUpdateAPIModel(data)
+	if update["oom_restart_policy"] != "kill" {
+		t.Errorf("Expected update oom_restart_policy 'kill', got %v", update["oom_restart_policy"])
+	}
+	if update["oom_score_adjust"] != int64(0) {
+		t.Errorf("Expected update oom_score_adjust 0, got %v", update["oom_score_adjust"])
+	}
+
+	data.Settings.OOMRestartPolicy = types.StringNull()
+	data.Settings.OOMScoreAdjust = types.Int64Null()
+	if app := resource.toAPIModel(data); app.OOMRestartPolicy != "" || app.OOMScoreAdjust != nil {
+		t.Errorf("Expected OOM settings to be omitted, got '%s' / %v", app.OOMRestartPolicy, app.OOMScoreAdjust)
+	}
+	update = resource.toUpdateAPIModel(data)
+	if _, ok := update["oom_restart_policy"]; ok {
+		t.Error("Expected oom_restart_policy to be omitted from update")
+	}
+	if _, ok := update["oom_score_adjust"]; ok {
+		t.Error("Expected oom_score_adjust to be omitted from update")
+	}
+
+	// Read back from the API
+	var apiApp client.Application
+	body := `{"id": 1, "name": "memory-hungry-app", "application_type": "laravel", "oom_restart_policy": "restart", "oom_score_adjust": -500}`
+	if err := json.Unmarshal([]byte(body), &apiApp); err != nil {
+		t.Fatalf("Unable to decode application: %v", err)
+	}
+	resource.fromAPIModel(&apiApp, data)
+	if !data.Settings.OOMRestartPolicy.Equal(types.StringValue("restart")) {
+		t.Errorf("Expected OOMRestartPolicy 'restart' from API, got %v", data.Settings.OOMRestartPolicy)
+	}
+	if !data.Settings.OOMScoreAdjust.Equal(types.Int64Value(-500)) {
+		t.Errorf("Expected OOMScoreAdjust -500 from API, got %v", data.Settings.OOMScoreAdjust)
+	}
+
+	// Null stays null when the API omits the fields
+	data.Settings.OOMRestartPolicy = types.StringNull()
+	data.Settings.OOMScoreAdjust = types.Int64Null()
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.Settings.OOMRestartPolicy.IsNull() || !data.Settings.OOMScoreAdjust.IsNull() {
+		t.Errorf("Expected OOM settings to remain null, got %v / %v", data.Settings.OOMRestartPolicy, data.Settings.OOMScoreAdjust)
+	}
+}
+
+func TestApplicationResource_OOM_Validation(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewApplicationResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	settings := resp.Schema.Blocks["settings"].(schema.SingleNestedBlock)
+
+	policy := settings.Attributes["oom_restart_policy"].(schema.StringAttribute)
+	for value, expectError := range map[string]bool{
+		"restart": false,
+		"kill":    false,
+		"ignore":  false,
+		"Restart": true,
+		"always":  true,
+		"":        true,
+	} {
+		if diags := runStringValidators(t, policy.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for oom_restart_policy %q, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+
+	scoreAdjust := settings.Attributes["oom_score_adjust"].(schema.Int64Attribute)
+	for value, expectError := range map[int64]bool{
+		-1000: false,
+		0:     false,
+		1000:  false,
+		-1001: true,
+		1001:  true,
+	} {
+		if diags := runInt64Validators(t, scoreAdjust.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for oom_score_adjust %d, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+}
+
+func TestApplicationResource_InitResources_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Name:              types.StringValue("migrating-app"),
+		Type:              types.StringValue("laravel"),
+		InitCPURequest:    types.StringValue("500m"),
+		InitMemoryRequest: types.StringValue("2Gi"),
+	}
+
+	app := resource.toAPIModel(data)
+	if app.InitCPURequest != "500m" || app.InitMemoryRequest != "2Gi" {
+		t.Errorf("Expected init requests 500m/2Gi, got %q/%q", app.InitCPURequest, app.InitMemoryRequest)
+	}
+
+	update := resource.toUpdateAPIModel(data)
+	if update["init_cpu_request"] != "500m" || update["init_memory_request"] != "2Gi" {
+		t.Errorf("Expected update init requests 500m/2Gi, got %v/%v", update["init_cpu_request"], update["init_memory_request"])
+	}
+
+	data.InitCPURequest = types.StringNull()
+	data.InitMemoryRequest = types.StringUnknown()
+	app = resource.toAPIModel(data)
+	if app.InitCPURequest != "" || app.InitMemoryRequest != "" {
+		t.Errorf("Expected init requests to be omitted, got %q/%q", app.InitCPURequest, app.InitMemoryRequest)
+	}
+	update = resource.toUpdateAPIModel(data)
+	for _, field := range []string{"init_cpu_request", "init_memory_request"} {
+		if _, ok := update[field]; ok {
+			t.Errorf("Expected %s to be omitted from update", field)
+		}
+	}
+
+	// Read back
+	data = &ApplicationResourceModel{InitCPURequest: types.StringNull(), InitMemoryRequest: types.StringValue("2Gi")}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel", InitCPURequest: "1"}, data)
+	if !data.InitCPURequest.Equal(types.StringValue("1")) {
+		t.Errorf("Expected init CPU request from API, got %v", data.InitCPURequest)
+	}
+	if !data.InitMemoryRequest.Equal(types.StringValue("2Gi")) {
+		t.Errorf("Expected planned init memory request to be preserved, got %v", data.InitMemoryRequest)
+	}
+
+	data = &ApplicationResourceModel{InitCPURequest: types.StringUnknown(), InitMemoryRequest: types.StringUnknown()}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.InitCPURequest.IsNull() || !data.InitMemoryRequest.IsNull() {
+		t.Errorf("Expected init requests to be null when the API omits them, got %v/%v", data.InitCPURequest, data.InitMemoryRequest)
+	}
+}
+
+func TestApplicationResource_InitResources_Validation(t *testing.T) {
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	tests := []struct {
+		attribute   string
+		value       string
+		expectError bool
+	}{
+		{"init_cpu_request", "500m", false},
+		{"init_cpu_request", "1", false},
+		{"init_cpu_request", "1.5", false},
+		{"init_cpu_request", "500Mi", true},
+		{"init_cpu_request", "lots", true},
+		{"init_memory_request", "512Mi", false},
+		{"init_memory_request", "2Gi", false},
+		{"init_memory_request", "2048", true},
+		{"init_memory_request", "2GB", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attribute+"="+tt.value, func(t *testing.T) {
+			attr := resp.Schema.Attributes[tt.attribute].(schema.StringAttribute)
+			diags := runStringValidators(t, attr.Validators, tt.value)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for %s '%s', got diagnostics: %v", tt.expectError, tt.attribute, tt.value, diags)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_BuildTimeoutSeconds_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	tests := []struct {
+		name        string
+		timeout     types.Int64
+		expected    int64
+		expectField bool
+	}{
+		{"one hour", types.Int64Value(3600), 3600, true},
+		{"null timeout", types.Int64Null(), 0, false},
+		{"unknown timeout", types.Int64Unknown(), 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &ApplicationResourceModel{
+				Name:                types.StringValue("monorepo-app"),
+				Type:                types.StringValue("laravel"),
+				BuildTimeoutSeconds: tt.timeout,
+			}
+
+			app := resource.toAPIModel(data)
+			if app.BuildTimeoutSeconds != tt.expected {
+				t.Errorf("Expected BuildTimeoutSeconds %d, got %d", tt.expected, app.BuildTimeoutSeconds)
+			}
+
+			update := resource.toUpdateAPIModel(data)
+			value, ok := update["build_timeout_seconds"]
+			if ok != tt.expectField {
+				t.Fatalf("Expected build_timeout_seconds present %v, got %v", tt.expectField, ok)
+			}
+			if ok && value != tt.expected {
+				t.Errorf("Expected update build_timeout_seconds %d, got %v", tt.expected, value)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_BuildTimeoutSeconds_fromAPIModel(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{BuildTimeoutSeconds: types.Int64Null()}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel", BuildTimeoutSeconds: 5400}, data)
+	if !data.BuildTimeoutSeconds.Equal(types.Int64Value(5400)) {
+		t.Errorf("Expected BuildTimeoutSeconds 5400, got %v", data.BuildTimeoutSeconds)
+	}
+
+	data = &ApplicationResourceModel{BuildTimeoutSeconds: types.Int64Unknown()}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.BuildTimeoutSeconds.IsNull() {
+		t.Errorf("Expected BuildTimeoutSeconds to be null when the API omits it, got %v", data.BuildTimeoutSeconds)
+	}
+
+	data = &ApplicationResourceModel{BuildTimeoutSeconds: types.Int64Value(1800)}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.BuildTimeoutSeconds.Equal(types.Int64Value(1800)) {
+		t.Errorf("Expected planned BuildTimeoutSeconds to be preserved, got %v", data.BuildTimeoutSeconds)
+	}
+}
+
+func TestApplicationResource_BuildTimeoutSeconds_Validation(t *testing.T) {
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	attr, ok := resp.Schema.Attributes["build_timeout_seconds"].(schema.Int64Attribute)
+	if !ok {
+		t.Fatal("Expected build_timeout_seconds to be an int64 attribute")
+	}
+
+	tests := []struct {
+		value       int64
+		expectError bool
+	}{
+		{1, false},
+		{3600, false},
+		{maxBuildTimeoutSeconds, false},
+		{0, true},
+		{-60, true},
+		{maxBuildTimeoutSeconds + 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d", tt.value), func(t *testing.T) {
+			diags := runInt64Validators(t, attr.Validators, tt.value)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for %d, got diagnostics: %v", tt.expectError, tt.value, diags)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_ScaleDownDrainSeconds_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	tests := []struct {
+		name        string
+		drain       types.Int64
+		expected    string
+		expectField bool
+	}{
+		{"thirty seconds", types.Int64Value(30), "30", true},
+		{"explicit zero disables draining", types.Int64Value(0), "0", true},
+		{"null drain", types.Int64Null(), "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &ApplicationResourceModel{
+				Name:     types.StringValue("drain-app"),
+				Type:     types.StringValue("laravel"),
+				Settings: &SettingsModel{ScaleDownDrainSeconds: tt.drain},
+			}
+
+			// The create payload must carry an explicit 0 instead of omitting it
+			app := resource.toAPIModel(data)
+			payload, err := json.Marshal(app)
+			if err != nil {
+				t.Fatalf("Failed to encode application: %v", err)
+			}
+			var body map[string]json.RawMessage
+			json.Unmarshal(payload, &body)
+			value, ok := body["scale_down_drain_seconds"]
+			if ok != tt.expectField {
+				t.Fatalf("Expected scale_down_drain_seconds in the create payload %v, got %s", tt.expectField, payload)
+			}
+			if ok && string(value) != tt.expected {
+				t.Errorf("Expected create scale_down_drain_seconds %s, got %s", tt.expected, value)
+			}
+
+			update := resource.toUpdateAPIModel(data)
+			updateValue, ok := update["scale_down_drain_seconds"]
+			if ok != tt.expectField {
+				t.Fatalf("Expected scale_down_drain_seconds present %v, got %v", tt.expectField, ok)
+			}
+			if ok && fmt.Sprint(updateValue) != tt.expected {
+				t.Errorf("Expected update scale_down_drain_seconds %s, got %v", tt.expected, updateValue)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_ScaleDownDrainSeconds_fromAPIModel(t *testing.T) {
+	resource := &ApplicationResource{}
+	drain, zero := int64(45), int64(0)
+
+	data := &ApplicationResourceModel{Settings: &SettingsModel{ScaleDownDrainSeconds: types.Int64Null()}}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel", ScaleDownDrainSeconds: &drain}, data)
+	if !data.Settings.ScaleDownDrainSeconds.Equal(types.Int64Value(45)) {
+		t.Errorf("Expected ScaleDownDrainSeconds 45, got %v", data.Settings.ScaleDownDrainSeconds)
+	}
+
+	// A reported 0 is read back rather than treated as unset
+	data = &ApplicationResourceModel{Settings: &SettingsModel{ScaleDownDrainSeconds: types.Int64Value(30)}}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel", ScaleDownDrainSeconds: &zero}, data)
+	if !data.Settings.ScaleDownDrainSeconds.Equal(types.Int64Value(0)) {
+		t.Errorf("Expected a reported 0 to be read back, got %v", data.Settings.ScaleDownDrainSeconds)
+	}
+
+	// Responses without the field keep the planned value
+	data = &ApplicationResourceModel{Settings: &SettingsModel{ScaleDownDrainSeconds: types.Int64Value(0)}}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.Settings.ScaleDownDrainSeconds.Equal(types.Int64Value(0)) {
+		t.Errorf("Expected ScaleDownDrainSeconds 0 to be preserved, got %v", data.Settings.ScaleDownDrainSeconds)
+	}
+
+	data = &ApplicationResourceModel{Settings: &SettingsModel{ScaleDownDrainSeconds: types.Int64Null()}}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.Settings.ScaleDownDrainSeconds.IsNull() {
+		t.Errorf("Expected ScaleDownDrainSeconds to remain null, got %v", data.Settings.ScaleDownDrainSeconds)
+	}
+}
+
+func TestApplicationResource_ScaleDownDrainSeconds_Validation(t *testing.T) {
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	settings := resp.Schema.Blocks["settings"].(schema.SingleNestedBlock)
+	attr, ok := settings.Attributes["scale_down_drain_seconds"].(schema.Int64Attribute)
+	if !ok {
+		t.Fatal("Expected scale_down_drain_seconds to be an int64 attribute")
+	}
+
+	tests := []struct {
+		value       int64
+		expectError bool
+	}{
+		{0, false},
+		{30, false},
+		{600, false},
+		{-1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d", tt.value), func(t *testing.T) {
+			diags := runInt64Validators(t, attr.Validators, tt.value)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for %d, got diagnostics: %v", tt.expectError, tt.value, diags)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_MaxConcurrentRequests_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	tests := []struct {
+		name        string
+		limit       types.Int64
+		expected    int64
+		expectField bool
+	}{
+		{"hundred requests", types.Int64Value(100), 100, true},
+		{"null limit", types.Int64Null(), 0, false},
+		{"unknown limit", types.Int64Unknown(), 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &ApplicationResourceModel{
+				Name:     types.StringValue("limited-app"),
+				Type:     types.StringValue("laravel"),
+				Settings: &SettingsModel{MaxConcurrentRequests: tt.limit},
+			}
+
+			app := resource.toAPIModel(data)
+			if app.MaxConcurrentRequests != tt.expected {
+				t.Errorf("Expected MaxConcurrentRequests %d, got %d", tt.expected, app.MaxConcurrentRequests)
+			}
+
+			update := resource.toUpdateAPIModel(data)
+			value, ok := update["max_concurrent_requests"]
+			if ok != tt.expectField {
+				t.Fatalf("Expected max_concurrent_requests present %v, got %v", tt.expectField, ok)
+			}
+			if ok && value != tt.expected {
+				t.Errorf("Expected update max_concurrent_requests %d, got %v", tt.expected, value)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_MaxConcurrentRequests_fromAPIModel(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{Settings: &SettingsModel{MaxConcurrentRequests: types.Int64Null()}}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel", MaxConcurrentRequests: 250}, data)
+	if !data.Settings.MaxConcurrentRequests.Equal(types.Int64Value(250)) {
+		t.Errorf("Expected MaxConcurrentRequests 250, got %v", data.Settings.MaxConcurrentRequests)
+	}
+
+	data = &ApplicationResourceModel{Settings: &SettingsModel{MaxConcurrentRequests: types.Int64Unknown()}}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.Settings.MaxConcurrentRequests.IsNull() {
+		t.Errorf("Expected an unreported MaxConcurrentRequests to be null, got %v", data.Settings.MaxConcurrentRequests)
+	}
+}
+
+func TestApplicationResource_MaxConcurrentRequests_Validation(t *testing.T) {
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	settings := resp.Schema.Blocks["settings"].(schema.SingleNestedBlock)
+	attr, ok := settings.Attributes["max_concurrent_requests"].(schema.Int64Attribute)
+	if !ok {
+		t.Fatal("Expected max_concurrent_requests to be an int64 attribute")
+	}
+
+	tests := []struct {
+		value       int64
+		expectError bool
+	}{
+		{1, false},
+		{100, false},
+		{0, true},
+		{-5, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d", tt.value), func(t *testing.T) {
+			diags := runInt64Validators(t, attr.Validators, tt.value)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for %d, got diagnostics: %v", tt.expectError, tt.value, diags)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_SigtermTimeout_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	tests := []struct {
+		name        string
+		timeout     types.Int64
+		expectField bool
+	}{
+		{"thirty seconds", types.Int64Value(30), true},
+		{"immediate kill", types.Int64Value(0), true},
+		{"null timeout", types.Int64Null(), false},
+		{"unknown timeout", types.Int64Unknown(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &ApplicationResourceModel{
+				Name:     types.StringValue("graceful-app"),
+				Type:     types.StringValue("laravel"),
+				Settings: &SettingsModel{SigtermTimeoutSeconds: tt.timeout},
+			}
+
+			app := resource.toAPIModel(data)
+			if (app.SigtermTimeoutSeconds != nil) != tt.expectField {
+				t.Fatalf("Expected SigtermTimeoutSeconds set %v, got %v", tt.expectField, app.SigtermTimeoutSeconds)
+			}
+			if tt.expectField && *app.SigtermTimeoutSeconds != tt.timeout.ValueInt64() {
+				t.Errorf("Expected SigtermTimeoutSeconds %d, got %d", tt.timeout.ValueInt64(), *app.SigtermTimeoutSeconds)
+			}
+
+			update := resource.toUpdateAPIModel(data)
+			value, ok := update["sigterm_timeout_seconds"]
+			if ok != tt.expectField {
+				t.Fatalf("Expected sigterm_timeout_seconds present %v, got %v", tt.expectField, ok)
+			}
+			if ok && value != tt.timeout.ValueInt64() {
+				t.Errorf("Expected update sigterm_timeout_seconds %d, got %v", tt.timeout.ValueInt64(), value)
+			}
+		})
+	}
+
+	zero := int64(0)
+	data := &ApplicationResourceModel{Settings: &SettingsModel{SigtermTimeoutSeconds: types.Int64Value(30)}}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel", SigtermTimeoutSeconds: &zero}, data)
+	if !data.Settings.SigtermTimeoutSeconds.Equal(types.Int64Value(0)) {
+		t.Errorf("Expected a reported 0 to be read back, got %v", data.Settings.SigtermTimeoutSeconds)
+	}
+
+	data = &ApplicationResourceModel{Settings: &SettingsModel{SigtermTimeoutSeconds: types.Int64Unknown()}}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.Settings.SigtermTimeoutSeconds.IsNull() {
+		t.Errorf("Expected an unreported SigtermTimeoutSeconds to be null, got %v", data.Settings.SigtermTimeoutSeconds)
+	}
+}
+
+func TestApplicationResource_SigtermTimeout_Validation(t *testing.T) {
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	settings := resp.Schema.Blocks["settings"].(schema.SingleNestedBlock)
+	attr, ok := settings.Attributes["sigterm_timeout_seconds"].(schema.Int64Attribute)
+	if !ok {
+		t.Fatal("Expected sigterm_timeout_seconds to be an int64 attribute")
+	}
+	for value, expectError := range map[int64]bool{0: false, 30: false, -1: true} {
+		if diags := runInt64Validators(t, attr.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for sigterm_timeout_seconds %d, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		timeout     types.Int64
+		drain       types.Int64
+		expectError bool
+	}{
+		{"within drain period", types.Int64Value(20), types.Int64Value(30), false},
+		{"equal to drain period", types.Int64Value(30), types.Int64Value(30), false},
+		{"exceeds drain period", types.Int64Value(45), types.Int64Value(30), true},
+		{"no drain period", types.Int64Value(45), types.Int64Null(), false},
+		{"unknown drain period", types.Int64Value(45), types.Int64Unknown(), false},
+		{"unset", types.Int64Null(), types.Int64Value(30), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateSigtermTimeout(&SettingsModel{SigtermTimeoutSeconds: tt.timeout, ScaleDownDrainSeconds: tt.drain})
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v, got diagnostics: %v", tt.expectError, diags)
+			}
+		})
+	}
+
+	if diags := validateSigtermTimeout(nil); diags.HasError() {
+		t.Errorf("Expected no error without settings, got %v", diags)
+	}
+}
+
+func TestApplicationResource_HealthCheckProbe_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Name: types.StringValue("probed-app"),
+		Type: types.StringValue("laravel"),
+		Settings: &SettingsModel{
+			HealthCheckPath:     types.StringValue("/up"),
+			HealthCheckInterval: types.Int64Value(10),
+			HealthCheckTimeout:  types.Int64Value(3),
+			HealthCheckFailures: types.Int64Value(5),
+		},
+	}
+
+	app := resource.toAPIModel(data)
+	if app.HealthCheckInterval != 10 || app.HealthCheckTimeout != 3 || app.HealthCheckFailures != 5 {
+		t.Errorf("Expected probe settings 10/3/5 in create payload, got %d/%d/%d", app.HealthCheckInterval, app.HealthCheckTimeout, app.HealthCheckFailures)
+	}
+
+	update := resource.toUpdateAPIModel(data)
+	for field, expected := range map[string]int64{"health_check_interval": 10, "health_check_timeout": 3, "health_check_failure_threshold": 5} {
+		if update[field] != expected {
+			t.Errorf("Expected update %s %d, got %v", field, expected, update[field])
+		}
+	}
+
+	// Unset and unknown values are left to the API
+	data.Settings.HealthCheckInterval = types.Int64Null()
+	data.Settings.HealthCheckTimeout = types.Int64Unknown()
+	data.Settings.HealthCheckFailures = types.Int64Null()
+	if app := resource.toAPIModel(data); app.HealthCheckInterval != 0 || app.HealthCheckTimeout != 0 || app.HealthCheckFailures != 0 {
+		t.Errorf("Expected probe settings to be omitted, got %d/%d/%d", app.HealthCheckInterval, app.HealthCheckTimeout, app.HealthCheckFailures)
+	}
+	update = resource.toUpdateAPIModel(data)
+	for _, field := range []string{"health_check_interval", "health_check_timeout", "health_check_failure_threshold"} {
+		if _, ok := update[field]; ok {
+			t.Errorf("Expected %s to be omitted from the update, got %v", field, update[field])
+		}
+	}
+
+	// Round-trip through the API response
+	data = &ApplicationResourceModel{Settings: &SettingsModel{
+		HealthCheckInterval: types.Int64Unknown(),
+		HealthCheckTimeout:  types.Int64Unknown(),
+		HealthCheckFailures: types.Int64Unknown(),
+	}}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel", HealthCheckInterval: 10, HealthCheckTimeout: 3}, data)
+	if !data.Settings.HealthCheckInterval.Equal(types.Int64Value(10)) || !data.Settings.HealthCheckTimeout.Equal(types.Int64Value(3)) {
+		t.Errorf("Expected probe settings 10/3 from API, got %v/%v", data.Settings.HealthCheckInterval, data.Settings.HealthCheckTimeout)
+	}
+	if !data.Settings.HealthCheckFailures.IsNull() {
+		t.Errorf("Expected an unreported failure threshold to be null, got %v", data.Settings.HealthCheckFailures)
+	}
+}
+
+func TestApplicationResource_HealthCheckProbe_Validation(t *testing.T) {
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	settings := resp.Schema.Blocks["settings"].(schema.SingleNestedBlock)
+	for _, name := range []string{"health_check_interval", "health_check_timeout", "health_check_failure_threshold"} {
+		attr, ok := settings.Attributes[name].(schema.Int64Attribute)
+		if !ok {
+			t.Fatalf("Expected %s to be an int64 attribute", name)
+		}
+		for value, expectError := range map[int64]bool{1: false, 30: false, 0: true, -1: true} {
+			if diags := runInt64Validators(t, attr.Validators, value); diags.HasError() != expectError {
+				t.Errorf("Expected error %v for %s %d, got diagnostics: %v", expectError, name, value, diags)
+			}
+		}
+	}
+
+	tests := []struct {
+		name        string
+		interval    types.Int64
+		timeout     types.Int64
+		expectError bool
+	}{
+		{"timeout below interval", types.Int64Value(10), types.Int64Value(3), false},
+		{"timeout equal to interval", types.Int64Value(10), types.Int64Value(10), true},
+		{"timeout above interval", types.Int64Value(5), types.Int64Value(8), true},
+		{"no interval", types.Int64Null(), types.Int64Value(8), false},
+		{"unknown interval", types.Int64Unknown(), types.Int64Value(8), false},
+		{"no timeout", types.Int64Value(5), types.Int64Null(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateHealthCheckTiming(&SettingsModel{HealthCheckInterval: tt.interval, HealthCheckTimeout: tt.timeout})
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v, got diagnostics: %v", tt.expectError, diags)
+			}
+		})
+	}
+
+	if diags := validateHealthCheckTiming(nil); diags.HasError() {
+		t.Errorf("Expected no error without settings, got %v", diags)
+	}
+}
+
+func TestApplicationResource_MaintenanceWindow_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Name: types.StringValue("maintenance-app"),
+		Type: types.StringValue("laravel"),
+		MaintenanceWindow: &MaintenanceWindowModel{
+			DayOfWeek:       types.StringValue("sunday"),
+			StartTime:       types.StringValue("03:30"),
+			DurationMinutes: types.Int64Value(120),
+			Timezone:        types.StringValue("Europe/Amsterdam"),
+		},
+	}
+
+	app := resource.toAPIModel(data)
+	expected := client.MaintenanceWindow{DayOfWeek: "sunday", StartTime: "03:30", DurationMinutes: 120, Timezone: "Europe/Amsterdam"}
+	if app.MaintenanceWindow == nil || *app.MaintenanceWindow != expected {
+		t.Fatalf("Expected maintenance window %+v, got %+v", expected, app.MaintenanceWindow)
+	}
+
+	update := resource.toUpdateAPIModel(data)
+	window, ok := update["maintenance_window"].(*client.MaintenanceWindow)
+	if !ok || *window != expected {
+		t.Errorf("Expected update maintenance_window %+v, got %v", expected, update["maintenance_window"])
+	}
+
+	// Defaults apply when optional values are unknown
+	data.MaintenanceWindow.DurationMinutes = types.Int64Unknown()
+	data.MaintenanceWindow.Timezone = types.StringUnknown()
+	app = resource.toAPIModel(data)
+	if app.MaintenanceWindow.DurationMinutes != 60 || app.MaintenanceWindow.Timezone != "UTC" {
+		t.Errorf("Expected default duration 60 and timezone UTC, got %+v", app.MaintenanceWindow)
+	}
+
+	// Omitted when not configured
+	data.MaintenanceWindow = nil
+	if app := resource.toAPIModel(data); app.MaintenanceWindow != nil {
+		t.Errorf("Expected no maintenance window, got %+v", app.MaintenanceWindow)
+	}
+	if _, ok := resource.toUpdateAPIModel(data)["maintenance_window"]; ok {
+		t.Error("Expected maintenance_window to be omitted from update")
+	}
+}
+
+func TestApplicationResource_MaintenanceWindow_fromAPIModel(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		MaintenanceWindow: &MaintenanceWindowModel{
+			DayOfWeek:       types.StringValue("sunday"),
+			StartTime:       types.StringValue("03:30"),
+			DurationMinutes: types.Int64Value(60),
+			Timezone:        types.StringValue("UTC"),
+		},
+	}
+	resource.fromAPIModel(&client.Application{
+		ID:   1,
+		Type: "laravel",
+		MaintenanceWindow: &client.MaintenanceWindow{
+			DayOfWeek:       "saturday",
+			StartTime:       "22:00",
+			DurationMinutes: 90,
+			Timezone:        "America/New_York",
+		},
+	}, data)
+
+	if !data.MaintenanceWindow.DayOfWeek.Equal(types.StringValue("saturday")) ||
+		!data.MaintenanceWindow.StartTime.Equal(types.StringValue("22:00")) ||
+		!data.MaintenanceWindow.DurationMinutes.Equal(types.Int64Value(90)) ||
+		!data.MaintenanceWindow.Timezone.Equal(types.StringValue("America/New_York")) {
+		t.Errorf("Expected maintenance window to be read back, got %+v", data.MaintenanceWindow)
+	}
+
+	// Unconfigured block stays unset even when the API reports a platform default
+	data = &ApplicationResourceModel{}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel", MaintenanceWindow: &client.MaintenanceWindow{DayOfWeek: "monday"}}, data)
+	if data.MaintenanceWindow != nil {
+		t.Errorf("Expected maintenance window to remain unset, got %+v", data.MaintenanceWindow)
+	}
+}
+
+func TestApplicationResource_MaintenanceWindow_Validation(t *testing.T) {
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	block := resp.Schema.Blocks["maintenance_window"].(schema.SingleNestedBlock)
+
+	stringTests := []struct {
+		attr        string
+		value       string
+		expectError bool
+	}{
+		{"day_of_week", "monday", false},
+		{"day_of_week", "sunday", false},
+		{"day_of_week", "Monday", true},
+		{"day_of_week", "mon", true},
+		{"start_time", "00:00", false},
+		{"start_time", "23:59", false},
+		{"start_time", "03:30", false},
+		{"start_time", "24:00", true},
+		{"start_time", "3:30", true},
+		{"start_time", "03:60", true},
+		{"start_time", "03:30:00", true},
+		{"timezone", "UTC", false},
+		{"timezone", "Europe/Amsterdam", false},
+		{"timezone", "America/New_York", false},
+		{"timezone", "Mars/Olympus_Mons", true},
+		{"timezone", "Local", true},
+		{"timezone", "", true},
+	}
+
+	for _, tt := range stringTests {
+		t.Run(tt.attr+"/"+tt.value, func(t *testing.T) {
+			attr := block.Attributes[tt.attr].(schema.StringAttribute)
+			diags := runStringValidators(t, attr.Validators, tt.value)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for %s '%s', got diagnostics: %v", tt.expectError, tt.attr, tt.value, diags)
+			}
+		})
+	}
+
+	duration := block.Attributes["duration_minutes"].(schema.Int64Attribute)
+	durationTests := []struct {
+		value       int64
+		expectError bool
+	}{
+		{30, false},
+		{60, false},
+		{1440, false},
+		{29, true},
+		{1441, true},
+	}
+
+	for _, tt := range durationTests {
+		t.Run(fmt.Sprintf("duration_minutes/%d", tt.value), func(t *testing.T) {
+			diags := runInt64Validators(t, duration.Validators, tt.value)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for duration_minutes %d, got diagnostics: %v", tt.expectError, tt.value, diags)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_RefreshAfterDeploy(t *testing.T) {
+	tests := []struct {
+		name              string
+		waitForDeployment types.Bool
+		status            string
+		expectError       bool
+		expectedStatus    string
+	}{
+		{"refresh only", types.BoolValue(false), "deploying", false, "deploying"},
+		{"wait until running", types.BoolValue(true), "running", false, "running"},
+		{"wait reports failed deployment", types.BoolValue(true), "failed", true, "failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/applications/1" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"data": {"id": 1, "name": "app", "application_type": "laravel", "status": "%s"}}`, tt.status)
+			}))
+			defer server.Close()
+
+			r := &ApplicationResource{client: client.NewClient("test-token", &server.URL)}
+			data := &ApplicationResourceModel{
+				ID:                types.Int64Value(1),
+				Status:            types.StringValue("creating"),
+				WaitForDeployment: tt.waitForDeployment,
+			}
+
+			diags := r.refreshAfterDeploy(context.Background(), 1, data, time.Second)
+			if diags.HasError() != tt.expectError {
+				t.Fatalf("Expected error %v, got diagnostics: %v", tt.expectError, diags)
+			}
+			if !data.Status.Equal(types.StringValue(tt.expectedStatus)) {
+				t.Errorf("Expected status %q in state, got %v", tt.expectedStatus, data.Status)
+			}
+		})
+	}
+
+	t.Run("timeout names last status and elapsed time", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"data": {"id": 1, "name": "app", "application_type": "laravel", "status": "deploying"}}`)
+		}))
+		defer server.Close()
+
+		r := &ApplicationResource{client: client.NewClient("test-token", &server.URL)}
+		data := &ApplicationResourceModel{
+			ID:                types.Int64Value(1),
+			WaitForDeployment: types.BoolValue(true),
+		}
+
+		diags := r.refreshAfterDeploy(context.Background(), 1, data, 10*time.Millisecond)
+		if !diags.HasError() {
+			t.Fatal("Expected a timeout error")
+		}
+		detail := diags.Errors()[0].Detail()
+		if !strings.Contains(detail, "still 'deploying'") || !strings.Contains(detail, "after waiting 0s") {
+			t.Errorf("Expected the last status and elapsed time in the diagnostic, got %q", detail)
+		}
+	})
+}
+
+func TestApplicationResource_Timeouts(t *testing.T) {
+	ctx := context.Background()
+	r := &ApplicationResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	block, ok := schemaResp.Schema.Blocks["timeouts"]
+	if !ok {
+		t.Fatal("Expected a timeouts block")
+	}
+	timeoutsType := block.Type().(timeouts.Type)
+	for _, op := range []string{"create", "update", "delete"} {
+		if _, ok := timeoutsType.AttrTypes[op]; !ok {
+			t.Errorf("Expected timeouts block to support %q", op)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		create         types.String
+		expectError    bool
+		expectedCreate time.Duration
+	}{
+		{"configured", types.StringValue("20m"), false, 20 * time.Minute},
+		{"unset uses default", types.StringNull(), false, defaultDeploymentTimeout},
+		{"invalid duration", types.StringValue("twenty minutes"), true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := ApplicationResourceModel{
+				Timeouts: timeouts.Value{
+					Object: types.ObjectValueMust(timeoutsType.AttrTypes, map[string]attr.Value{
+						"create": tt.create,
+						"update": types.StringNull(),
+						"delete": types.StringNull(),
+					}),
+				},
+			}
+
+			createTimeout, diags := data.Timeouts.Create(ctx, r.defaultDeploymentTimeout())
+			if diags.HasError() != tt.expectError {
+				t.Fatalf("Expected error %v, got diagnostics: %v", tt.expectError, diags)
+			}
+			if !tt.expectError && createTimeout != tt.expectedCreate {
+				t.Errorf("Expected create timeout %v, got %v", tt.expectedCreate, createTimeout)
+			}
+
+			updateTimeout, _ := data.Timeouts.Update(ctx, r.defaultDeploymentTimeout())
+			if updateTimeout != defaultDeploymentTimeout {
+				t.Errorf("Expected default update timeout %v, got %v", defaultDeploymentTimeout, updateTimeout)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_FollowBuildLogs_Incremental(t *testing.T) {
+	// Each poll returns the full log so far; the build finishes on the third poll
+	polls := [][]string{
+		{"Cloning repository"},
+		{"Cloning repository", "Installing dependencies", "Running build commands"},
+		{"Cloning repository", "Installing dependencies", "Running build commands", "Build finished"},
+	}
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/applications/1/deployments/42/build-logs" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		lines := polls[requestCount]
+		status := "building"
+		if requestCount == len(polls)-1 {
+			status = "success"
+		}
+		requestCount++
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data": {"status": "%s", "lines": ["%s"]}}`, status, strings.Join(lines, `", "`))
+	}))
+	defer server.Close()
+
+	r := &ApplicationResource{
+		client:               client.NewClient("test-token", &server.URL),
+		buildLogPollInterval: time.Millisecond,
+		buildLogTimeout:      time.Second,
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	if diags := r.followBuildLogs(ctx, 1, 42); diags.HasError() || diags.WarningsCount() > 0 {
+		t.Fatalf("Expected no diagnostics, got %v", diags)
+	}
+
+	if requestCount != 3 {
+		t.Errorf("Expected 3 build log requests, got %d", requestCount)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("Unable to decode log output: %v", err)
+	}
+
+	var messages []string
+	for _, entry := range entries {
+		messages = append(messages, entry["@message"].(string))
+	}
+	expected := []string{
+		"build: Cloning repository",
+		"build: Installing dependencies",
+		"build: Running build commands",
+		"build: Build finished",
+	}
+	if !reflect.DeepEqual(messages, expected) {
+		t.Errorf("Expected each line to be emitted once in order, got %v", messages)
+	}
+}
+
+func TestApplicationResource_FollowBuildLogs_Bounds(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/applications/1/deployments/1/build-logs":
+			w.Write([]byte(`{"data": {"status": "building", "lines": ["still building"]}}`))
+		case "/applications/1/deployments/2/build-logs":
+			w.Write([]byte(`{"data": {"status": "failed", "lines": ["composer install failed"]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "Not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	r := &ApplicationResource{
+		client:               client.NewClient("test-token", &server.URL),
+		buildLogPollInterval: time.Millisecond,
+		buildLogTimeout:      20 * time.Millisecond,
+	}
+
+	t.Run("stops at the timeout", func(t *testing.T) {
+		start := time.Now()
+		if diags := r.followBuildLogs(context.Background(), 1, 1); diags.HasError() {
+			t.Fatalf("Expected no errors, got %v", diags)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("Expected following to stop at the timeout, took %s", elapsed)
+		}
+	})
+
+	t.Run("respects context cancellation", func(t *testing.T) {
+		slow := &ApplicationResource{
+			client:               r.client,
+			buildLogPollInterval: time.Hour,
+			buildLogTimeout:      time.Hour,
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		done := make(chan struct{})
+		go func() {
+			slow.followBuildLogs(ctx, 1, 1)
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Expected following to stop when the context is cancelled")
+		}
+	})
+
+	t.Run("failed build warns", func(t *testing.T) {
+		diags := r.followBuildLogs(context.Background(), 1, 2)
+		if diags.HasError() || diags.WarningsCount() != 1 || diags[0].Summary() != "Deploy Warning" {
+			t.Errorf("Expected a single Deploy Warning, got %v", diags)
+		}
+	})
+
+	t.Run("log errors warn", func(t *testing.T) {
+		diags := r.followBuildLogs(context.Background(), 1, 3)
+		if diags.HasError() || diags.WarningsCount() != 1 || diags[0].Summary() != "Build Log Warning" {
+			t.Errorf("Expected a single Build Log Warning, got %v", diags)
+		}
+	})
+}
+
+func TestApplicationResource_Compression_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+	ctx := context.Background()
+
+	mimeTypes, _ := types.ListValueFrom(ctx, types.StringType, []string{"text/css", "application/json"})
+
+	data := &ApplicationResourceModel{
+		Name: types.StringValue("compression-app"),
+		Type: types.StringValue("laravel"),
+		Compression: &CompressionModel{
+			Enabled:      types.BoolValue(true),
+			Types:        mimeTypes,
+			MinSizeBytes: types.Int64Value(1024),
+		},
+	}
+
+	expected := &client.Compression{Enabled: true, Types: []string{"text/css", "application/json"}, MinSizeBytes: 1024}
+	if app := resource.toAPIModel(data); !reflect.DeepEqual(app.Compression, expected) {
+		t.Errorf("Expected compression %+v, got %+v", expected, app.Compression)
+	}
+	if update := resource.toUpdateAPIModel(data); !reflect.DeepEqual(update["compression"], expected) {
+		t.Errorf("Expected update compression %+v, got %+v", expected, update["compression"])
+	}
+
+	// Only the defaults are sent when optional values are unset
+	data.Compression = &CompressionModel{
+		Enabled:      types.BoolUnknown(),
+		Types:        types.ListNull(types.StringType),
+		MinSizeBytes: types.Int64Null(),
+	}
+	expected = &client.Compression{Enabled: true}
+	if app := resource.toAPIModel(data); !reflect.DeepEqual(app.Compression, expected) {
+		t.Errorf("Expected compression defaults %+v, got %+v", expected, app.Compression)
+	}
+
+	// Read back: API values win, unset optional values stay null
+	resource.fromAPIModel(&client.Application{
+		ID:          1,
+		Type:        "laravel",
+		Compression: &client.Compression{Enabled: false, Types: []string{"text/html"}},
+	}, data)
+
+	if !data.Compression.Enabled.Equal(types.BoolValue(false)) {
+		t.Errorf("Expected enabled false from API, got %v", data.Compression.Enabled)
+	}
+	expectedTypes, _ := types.ListValueFrom(ctx, types.StringType, []string{"text/html"})
+	if !data.Compression.Types.Equal(expectedTypes) {
+		t.Errorf("Expected types %v, got %v", expectedTypes, data.Compression.Types)
+	}
+	if !data.Compression.MinSizeBytes.IsNull() {
+		t.Errorf("Expected min_size_bytes to stay null, got %v", data.Compression.MinSizeBytes)
+	}
+
+	data.Compression = nil
+	if app := resource.toAPIModel(data); app.Compression != nil {
+		t.Errorf("Expected compression to be omitted, got %+v", app.Compression)
+	}
+	if _, ok := resource.toUpdateAPIModel(data)["compression"]; ok {
+		t.Error("Expected compression to be omitted from update")
+	}
+}
+
+func TestApplicationResource_Compression_Validation(t *testing.T) {
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	block := resp.Schema.Blocks["compression"].(schema.SingleNestedBlock)
+
+	typeTests := []struct {
+		name        string
+		values      []string
+		expectError bool
+	}{
+		{"single type", []string{"text/css"}, false},
+		{"vendor type", []string{"application/vnd.api+json"}, false},
+		{"wildcard subtype", []string{"text/*"}, false},
+		{"multiple types", []string{"text/html", "image/svg+xml"}, false},
+		{"missing subtype", []string{"text"}, true},
+		{"empty subtype", []string{"text/"}, true},
+		{"wildcard type", []string{"*/*"}, true},
+		{"parameters", []string{"text/html; charset=utf-8"}, true},
+		{"duplicates", []string{"text/css", "text/css"}, true},
+	}
+
+	attr := block.Attributes["types"].(schema.ListAttribute)
+	for _, tt := range typeTests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			value, _ := types.ListValueFrom(ctx, types.StringType, tt.values)
+			var hasError bool
+			for _, v := range attr.Validators {
+				vResp := &validator.ListResponse{}
+				v.ValidateList(ctx, validator.ListRequest{Path: path.Root("compression").AtName("types"), ConfigValue: value}, vResp)
+				hasError = hasError || vResp.Diagnostics.HasError()
+			}
+			if hasError != tt.expectError {
+				t.Errorf("Expected error %v for types %v", tt.expectError, tt.values)
+			}
+		})
+	}
+
+	minSize := block.Attributes["min_size_bytes"].(schema.Int64Attribute)
+	for value, expectError := range map[int64]bool{1: false, 1024: false, 0: true, -1: true} {
+		if diags := runInt64Validators(t, minSize.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for min_size_bytes %d, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+}
+
+// reconciliationTestModel returns a minimal application model that can be stored in a plan or state
+func reconciliationTestModel(name string, paused bool) *ApplicationResourceModel {
+	return &ApplicationResourceModel{
+		ID:                   types.Int64Value(1),
+		Name:                 types.StringValue(name),
+		Type:                 types.StringValue("laravel"),
+		BuildCommands:        types.ListNull(types.StringType),
+		InitCommands:         types.ListNull(types.StringType),
+		PHPExtensions:        types.ListNull(types.StringType),
+		PHPSettings:          types.ListNull(types.StringType),
+		AdditionalDomains:    types.ListNull(types.StringType),
+		IngressAllowCIDRs:    types.ListNull(types.StringType),
+		IngressDenyCIDRs:     types.ListNull(types.StringType),
+		Environment:          types.MapNull(types.StringType),
+		Status:               types.StringValue("running"),
+		ReconciliationPaused: types.BoolValue(paused),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			}),
+		},
+	}
+}
+
+// TestApplicationResource_Create_DeployFailureKeepsID checks that an
+// application whose deployment fails after creation stays tracked in state,
+// so it can be destroyed instead of being orphaned
+func TestApplicationResource_Create_DeployFailureKeepsID(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/applications":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data": {"id": 42, "name": "app", "application_type": "laravel", "status": "created", "needs_deployment": true}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/applications/42/deploy":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"message": "deploy failed"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/applications/42":
+			w.Write([]byte(`{"data": {"id": 42, "name": "app", "application_type": "laravel", "status": "failed"}}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &ApplicationResource{client: client.NewClient("test-token", &server.URL, client.WithRetriesDisabled())}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	planned := reconciliationTestModel("app", false)
+	planned.ID = types.Int64Unknown()
+	planned.Status = types.StringUnknown()
+	planned.WaitForDeployment = types.BoolValue(true)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	plan.Set(ctx, planned)
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Deployment Failed" {
+		t.Fatalf("Expected a Deployment Failed error, got %v", resp.Diagnostics)
+	}
+
+	var result ApplicationResourceModel
+	resp.State.Get(ctx, &result)
+	if result.ID.ValueInt64() != 42 {
+		t.Errorf("Expected the created application ID in state, got %v", result.ID)
+	}
+	if result.Status.ValueString() != "failed" {
+		t.Errorf("Expected the last read status in state, got %v", result.Status)
+	}
+}
+
+func TestApplicationResource_ReconciliationPaused_Update(t *testing.T) {
+	ctx := context.Background()
+
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"id": 1, "name": "renamed", "application_type": "laravel", "status": "running"}}`))
+	}))
+	defer server.Close()
+
+	r := &ApplicationResource{client: client.NewClient("test-token", &server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	for _, paused := range []bool{true, false} {
+		t.Run(fmt.Sprintf("paused=%v", paused), func(t *testing.T) {
+			requests = nil
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			plan.Set(ctx, reconciliationTestModel("renamed", paused))
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			state.Set(ctx, reconciliationTestModel("original", false))
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+			}
+
+			if paused {
+				if len(requests) != 0 {
+					t.Errorf("Expected no API calls while paused, got %v", requests)
+				}
+				if resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics[0].Summary() != "Reconciliation Paused" {
+					t.Errorf("Expected a Reconciliation Paused warning, got %v", resp.Diagnostics)
+				}
+			} else if len(requests) == 0 || requests[0] != "PUT /applications/1" {
+				t.Errorf("Expected the update to reach the API, got %v", requests)
+			}
+
+			var result ApplicationResourceModel
+			resp.State.Get(ctx, &result)
+			if !result.ReconciliationPaused.Equal(types.BoolValue(paused)) {
+				t.Errorf("Expected reconciliation_paused %v in state, got %v", paused, result.ReconciliationPaused)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_ReconciliationPaused_ModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := &ApplicationResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	for _, paused := range []bool{true, false} {
+		t.Run(fmt.Sprintf("paused=%v", paused), func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			plan.Set(ctx, reconciliationTestModel("renamed", paused))
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			state.Set(ctx, reconciliationTestModel("original", false))
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+			}
+
+			var result ApplicationResourceModel
+			resp.Plan.Get(ctx, &result)
+
+			expectedName := "renamed"
+			if paused {
+				expectedName = "original"
+			}
+			if !result.Name.Equal(types.StringValue(expectedName)) {
+				t.Errorf("Expected planned name %q, got %v", expectedName, result.Name)
+			}
+			if !result.ReconciliationPaused.Equal(types.BoolValue(paused)) {
+				t.Errorf("Expected planned reconciliation_paused %v, got %v", paused, result.ReconciliationPaused)
+			}
+			if paused != (resp.Diagnostics.WarningsCount() == 1) {
+				t.Errorf("Expected warning only while paused, got %v", resp.Diagnostics)
+			}
+		})
+	}
+
+	// Creates are never paused
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	plan.Set(ctx, reconciliationTestModel("new", true))
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Plan:  plan,
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}, resp)
+	if resp.Diagnostics.WarningsCount() != 0 {
+		t.Errorf("Expected no warning on create, got %v", resp.Diagnostics)
+	}
+}
+
+func TestApplicationResource_IngressCIDRs_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+	ctx := context.Background()
+
+	allow, _ := types.ListValueFrom(ctx, types.StringType, []string{"10.0.0.0/8", "203.0.113.0/24"})
+
+	data := &ApplicationResourceModel{
+		Name:              types.StringValue("ingress-app"),
+		Type:              types.StringValue("laravel"),
+		IngressAllowCIDRs: allow,
+		IngressDenyCIDRs:  types.ListNull(types.StringType),
+	}
+
+	expected := &client.IngressConfig{AllowCIDRs: []string{"10.0.0.0/8", "203.0.113.0/24"}}
+	if app := resource.toAPIModel(data); !reflect.DeepEqual(app.Ingress, expected) {
+		t.Errorf("Expected ingress %+v, got %+v", expected, app.Ingress)
+	}
+	if update := resource.toUpdateAPIModel(data); !reflect.DeepEqual(update["ingress"], expected) {
+		t.Errorf("Expected update ingress %+v, got %+v", expected, update["ingress"])
+	}
+
+	// Omitted when neither list is configured
+	data.IngressAllowCIDRs = types.ListNull(types.StringType)
+	if app := resource.toAPIModel(data); app.Ingress != nil {
+		t.Errorf("Expected ingress to be omitted, got %+v", app.Ingress)
+	}
+	if _, ok := resource.toUpdateAPIModel(data)["ingress"]; ok {
+		t.Error("Expected ingress to be omitted from update")
+	}
+
+	// Read back: API lists win, an unreported list keeps its planned value
+	deny, _ := types.ListValueFrom(ctx, types.StringType, []string{"198.51.100.7/32"})
+	data.IngressAllowCIDRs = allow
+	data.IngressDenyCIDRs = deny
+	resource.fromAPIModel(&client.Application{
+		ID:      1,
+		Type:    "laravel",
+		Ingress: &client.IngressConfig{AllowCIDRs: []string{"10.0.0.0/8"}},
+	}, data)
+
+	expectedAllow, _ := types.ListValueFrom(ctx, types.StringType, []string{"10.0.0.0/8"})
+	if !data.IngressAllowCIDRs.Equal(expectedAllow) {
+		t.Errorf("Expected allow list %v, got %v", expectedAllow, data.IngressAllowCIDRs)
+	}
+	if !data.IngressDenyCIDRs.Equal(deny) {
+		t.Errorf("Expected planned deny list to be preserved, got %v", data.IngressDenyCIDRs)
+	}
+
+	data.IngressAllowCIDRs = types.ListNull(types.StringType)
+	data.IngressDenyCIDRs = types.ListUnknown(types.StringType)
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.IngressAllowCIDRs.IsNull() || !data.IngressDenyCIDRs.IsNull() {
+		t.Errorf("Expected null lists, got %v / %v", data.IngressAllowCIDRs, data.IngressDenyCIDRs)
+	}
+}
+
+func TestApplicationResource_HTTPProtocols_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+	enabled, disabled := true, false
+
+	tests := []struct {
+		name     string
+		http2    types.Bool
+		http3    types.Bool
+		expected *client.IngressConfig
+	}{
+		{
+			name:     "unset uses platform defaults",
+			http2:    types.BoolUnknown(),
+			http3:    types.BoolNull(),
+			expected: nil,
+		},
+		{
+			name:     "http3 enabled",
+			http2:    types.BoolUnknown(),
+			http3:    types.BoolValue(true),
+			expected: &client.IngressConfig{HTTP3Enabled: &enabled},
+		},
+		{
+			name:     "http2 disabled is sent explicitly",
+			http2:    types.BoolValue(false),
+			http3:    types.BoolNull(),
+			expected: &client.IngressConfig{HTTP2Enabled: &disabled},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &ApplicationResourceModel{
+				Name:              types.StringValue("protocol-app"),
+				Type:              types.StringValue("laravel"),
+				IngressAllowCIDRs: types.ListNull(types.StringType),
+				IngressDenyCIDRs:  types.ListNull(types.StringType),
+				HTTP2Enabled:      tt.http2,
+				HTTP3Enabled:      tt.http3,
+			}
+
+			if app := resource.toAPIModel(data); !reflect.DeepEqual(app.Ingress, tt.expected) {
+				t.Errorf("Expected ingress %+v, got %+v", tt.expected, app.Ingress)
+			}
+			update, ok := resource.toUpdateAPIModel(data)["ingress"]
+			if tt.expected == nil && ok {
+				t.Errorf("Expected ingress to be omitted from update, got %+v", update)
+			}
+			if tt.expected != nil && !reflect.DeepEqual(update, tt.expected) {
+				t.Errorf("Expected update ingress %+v, got %+v", tt.expected, update)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_HTTPProtocols_fromAPIModel(t *testing.T) {
+	resource := &ApplicationResource{}
+	enabled, disabled := true, false
+
+	// Unset toggles pick up the platform defaults reported by the API, so the
+	// next plan (which keeps state for unknown values) shows no drift
+	data := &ApplicationResourceModel{HTTP2Enabled: types.BoolUnknown(), HTTP3Enabled: types.BoolUnknown()}
+	resource.fromAPIModel(&client.Application{
+		ID:      1,
+		Type:    "laravel",
+		Ingress: &client.IngressConfig{HTTP2Enabled: &enabled, HTTP3Enabled: &disabled},
+	}, data)
+	if !data.HTTP2Enabled.Equal(types.BoolValue(true)) {
+		t.Errorf("Expected http2_enabled true, got %v", data.HTTP2Enabled)
+	}
+	if !data.HTTP3Enabled.Equal(types.BoolValue(false)) {
+		t.Errorf("Expected http3_enabled false, got %v", data.HTTP3Enabled)
+	}
+
+	// An API that does not report the toggles keeps configured values and
+	// resolves unknown ones to null
+	data = &ApplicationResourceModel{HTTP2Enabled: types.BoolValue(false), HTTP3Enabled: types.BoolUnknown()}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.HTTP2Enabled.Equal(types.BoolValue(false)) {
+		t.Errorf("Expected configured http2_enabled to be preserved, got %v", data.HTTP2Enabled)
+	}
+	if !data.HTTP3Enabled.IsNull() {
+		t.Errorf("Expected null http3_enabled, got %v", data.HTTP3Enabled)
+	}
+}
+
+func TestApplicationResource_IngressCIDRs_Validation(t *testing.T) {
+	t.Run("cidr syntax", func(t *testing.T) {
+		tests := []struct {
+			value       string
+			expectError bool
+		}{
+			{"10.0.0.0/8", false},
+			{"203.0.113.7/32", false},
+			{"0.0.0.0/0", false},
+			{"2001:db8::/32", false},
+			{"10.0.0.1", true},
+			{"10.0.0.0/33", true},
+			{"256.0.0.0/8", true},
+			{"example.com/24", true},
+			{"", true},
+		}
+
+		for _, tt := range tests {
+			diags := runStringValidators(t, []validator.String{cidrBlock()}, tt.value)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for %q, got diagnostics: %v", tt.expectError, tt.value, diags)
+			}
+		}
+	})
+
+	t.Run("contradictory rules", func(t *testing.T) {
+		ctx := context.Background()
+		tests := []struct {
+			name          string
+			allow         []string
+			deny          []string
+			expectedPaths []path.Path
+		}{
+			{"disjoint", []string{"10.0.0.0/8"}, []string{"192.168.0.0/16"}, nil},
+			{"nested is not a conflict", []string{"10.0.0.0/8"}, []string{"10.1.0.0/16"}, nil},
+			{"same block", []string{"10.0.0.0/8", "172.16.0.0/12"}, []string{"192.168.0.0/16", "172.16.0.0/12"}, []path.Path{path.Root("ingress_deny_cidrs").AtListIndex(1)}},
+			{"same network different host bits", []string{"10.0.0.0/8"}, []string{"10.0.0.1/8"}, []path.Path{path.Root("ingress_deny_cidrs").AtListIndex(0)}},
+			{"ipv6", []string{"2001:db8::/32"}, []string{"2001:0db8::/32"}, []path.Path{path.Root("ingress_deny_cidrs").AtListIndex(0)}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				allow, _ := types.ListValueFrom(ctx, types.StringType, tt.allow)
+				deny, _ := types.ListValueFrom(ctx, types.StringType, tt.deny)
+
+				diags := validateIngressCIDRs(ctx, allow, deny)
+				if diags.ErrorsCount() != len(tt.expectedPaths) {
+					t.Fatalf("Expected %d errors, got %v", len(tt.expectedPaths), diags)
+				}
+				for i, expected := range tt.expectedPaths {
+					withPath, ok := diags[i].(diag.DiagnosticWithPath)
+					if !ok || !withPath.Path().Equal(expected) {
+						t.Errorf("Expected error at %s, got %v", expected, diags[i])
+					}
+				}
+			})
+		}
+
+		// Unset or unknown lists are not compared
+		allow, _ := types.ListValueFrom(ctx, types.StringType, []string{"10.0.0.0/8"})
+		if diags := validateIngressCIDRs(ctx, allow, types.ListUnknown(types.StringType)); diags.HasError() {
+			t.Errorf("Expected no errors for unknown deny list, got %v", diags)
+		}
+		if diags := validateIngressCIDRs(ctx, types.ListNull(types.StringType), allow); diags.HasError() {
+			t.Errorf("Expected no errors for null allow list, got %v", diags)
+		}
+	})
+}
+
+func TestApplicationResource_ClusterZone_fromAPIModel(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	var app client.Application
+	body := `{"id": 1, "name": "placed-app", "application_type": "laravel", "cluster": "ams-prod-2", "zone": "eu-west-1b"}`
+	if err := json.Unmarshal([]byte(body), &app); err != nil {
+		t.Fatalf("Unable to decode application: %v", err)
+	}
+
+	data := &ApplicationResourceModel{Cluster: types.StringUnknown(), Zone: types.StringUnknown()}
+	resource.fromAPIModel(&app, data)
+
+	if !data.Cluster.Equal(types.StringValue("ams-prod-2")) {
+		t.Errorf("Expected cluster 'ams-prod-2', got %v", data.Cluster)
+	}
+	if !data.Zone.Equal(types.StringValue("eu-west-1b")) {
+		t.Errorf("Expected zone 'eu-west-1b', got %v", data.Zone)
+	}
+
+	// Unknown placement becomes null rather than staying unknown
+	data = &ApplicationResourceModel{Cluster: types.StringUnknown(), Zone: types.StringUnknown()}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.Cluster.IsNull() || !data.Zone.IsNull() {
+		t.Errorf("Expected null cluster and zone, got %v / %v", data.Cluster, data.Zone)
+	}
+}
+
+func TestApplicationResource_InternalHostname_fromAPIModel(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	var app client.Application
+	body := `{"id": 1, "name": "api", "application_type": "laravel", "url": "https://api.example.com", "internal_hostname": "api.app-1.svc.cluster.local"}`
+	if err := json.Unmarshal([]byte(body), &app); err != nil {
+		t.Fatalf("Unable to decode application: %v", err)
+	}
+
+	data := &ApplicationResourceModel{InternalHostname: types.StringUnknown()}
+	resource.fromAPIModel(&app, data)
+	if !data.InternalHostname.Equal(types.StringValue("api.app-1.svc.cluster.local")) {
+		t.Errorf("Expected internal_hostname 'api.app-1.svc.cluster.local', got %v", data.InternalHostname)
+	}
+
+	// Null rather than unknown when the API omits it
+	data = &ApplicationResourceModel{InternalHostname: types.StringUnknown()}
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.InternalHostname.IsNull() {
+		t.Errorf("Expected null internal_hostname, got %v", data.InternalHostname)
+	}
+}
+
+func TestApplicationResource_DeregistrationDelay_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Name: types.StringValue("rolling-app"),
+		Type: types.StringValue("laravel"),
+		Settings: &SettingsModel{
+			DeregistrationDelaySeconds: types.Int64Value(0),
+		},
+	}
+
+	app := resource.toAPIModel(data)
+	if app.Ingress == nil || app.Ingress.DeregistrationDelaySeconds == nil || *app.Ingress.DeregistrationDelaySeconds != 0 {
+		t.Fatalf("Expected ingress deregistration delay 0 to be sent, got %+v", app.Ingress)
+	}
+
+	data.Settings.DeregistrationDelaySeconds = types.Int64Value(30)
+	update := resource.toUpdateAPIModel(data)
+	ingress, ok := update["ingress"].(*client.IngressConfig)
+	if !ok || ingress.DeregistrationDelaySeconds == nil || *ingress.DeregistrationDelaySeconds != 30 {
+		t.Fatalf("Expected update ingress deregistration delay 30, got %v", update["ingress"])
+	}
+
+	data.Settings.DeregistrationDelaySeconds = types.Int64Null()
+	if app := resource.toAPIModel(data); app.Ingress != nil {
+		t.Errorf("Expected ingress to be omitted, got %+v", app.Ingress)
+	}
+	if _, ok := resource.toUpdateAPIModel(data)["ingress"]; ok {
+		t.Error("Expected ingress to be omitted from update")
+	}
+
+	// Read back from the API
+	var apiApp client.Application
+	body := `{"id": 1, "name": "rolling-app", "application_type": "laravel", "ingress": {"deregistration_delay_seconds": 45}}`
+	if err := json.Unmarshal([]byte(body), &apiApp); err != nil {
+		t.Fatalf("Unable to decode application: %v", err)
+	}
+	resource.fromAPIModel(&apiApp, data)
+	if !data.Settings.DeregistrationDelaySeconds.Equal(types.Int64Value(45)) {
+		t.Errorf("Expected DeregistrationDelaySeconds 45 from API, got %v", data.Settings.DeregistrationDelaySeconds)
+	}
+
+	// Unknown becomes null when the API omits the field
+	data.Settings.DeregistrationDelaySeconds = types.Int64Unknown()
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.Settings.DeregistrationDelaySeconds.IsNull() {
+		t.Errorf("Expected DeregistrationDelaySeconds to be null, got %v", data.Settings.DeregistrationDelaySeconds)
+	}
+}
+
+func TestApplicationResource_DeregistrationDelay_Validation(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewApplicationResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	settings := resp.Schema.Blocks["settings"].(schema.SingleNestedBlock)
+	delay := settings.Attributes["deregistration_delay_seconds"].(schema.Int64Attribute)
+	for value, expectError := range map[int64]bool{
+		0:   false,
+		30:  false,
+		300: false,
+		-1:  true,
+	} {
+		if diags := runInt64Validators(t, delay.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for deregistration_delay_seconds %d, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+}
+
+func TestApplicationResource_Template_Precedence(t *testing.T) {
+	enabled := true
+	template := &client.ApplicationTemplate{
+		ID:               2,
+		Name:             "laravel-large",
+		HealthCheckPath:  "/up",
+		SchedulerEnabled: &enabled,
+		Replicas:         4,
+		MemoryRequest:    "2Gi",
+	}
+
+	// The plan holds the schema defaults for settings left unset in the configuration
+	defaults := func() *SettingsModel {
+		return &SettingsModel{
+			HealthCheckPath:  types.StringValue("/"),
+			SchedulerEnabled: types.BoolValue(false),
+			Replicas:         types.Int64Value(1),
+			CPURequest:       types.StringValue("250m"),
+			MemoryRequest:    types.StringValue("512Mi"),
+		}
+	}
+
+	t.Run("template fills unset settings", func(t *testing.T) {
+		plan := defaults()
+		applyApplicationTemplate(template, &SettingsModel{}, plan)
+
+		expected := &SettingsModel{
+			HealthCheckPath:  types.StringValue("/up"),
+			SchedulerEnabled: types.BoolValue(true),
+			Replicas:         types.Int64Value(4),
+			CPURequest:       types.StringValue("250m"),
+			MemoryRequest:    types.StringValue("2Gi"),
+		}
+		if !reflect.DeepEqual(plan, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, plan)
+		}
+	})
+
+	t.Run("explicit configuration wins", func(t *testing.T) {
+		config := &SettingsModel{
+			Replicas:      types.Int64Value(1),
+			MemoryRequest: types.StringValue("1Gi"),
+		}
+		plan := defaults()
+		plan.MemoryRequest = types.StringValue("1Gi")
+		applyApplicationTemplate(template, config, plan)
+
+		if !plan.Replicas.Equal(types.Int64Value(1)) {
+			t.Errorf("Expected configured replicas 1 to win over the template, got %v", plan.Replicas)
+		}
+		if !plan.MemoryRequest.Equal(types.StringValue("1Gi")) {
+			t.Errorf("Expected configured memory_request 1Gi to win over the template, got %v", plan.MemoryRequest)
+		}
+		if !plan.HealthCheckPath.Equal(types.StringValue("/up")) {
+			t.Errorf("Expected health_check_path inherited from the template, got %v", plan.HealthCheckPath)
+		}
+	})
+
+	t.Run("empty template values keep the plan", func(t *testing.T) {
+		plan := defaults()
+		applyApplicationTemplate(&client.ApplicationTemplate{ID: 1, Name: "empty"}, nil, plan)
+
+		if !reflect.DeepEqual(plan, defaults()) {
+			t.Errorf("Expected the planned defaults to be kept, got %+v", plan)
+		}
+	})
+}
+
+func TestApplicationResource_Template_ModifyPlan(t *testing.T) {
+	ctx := context.Background()
+
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/application-templates/2" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "Application template not found."}`))
+			return
+		}
+		w.Write([]byte(`{"data": {"id": 2, "name": "laravel-large", "health_check_path": "/up", "replicas": 4, "cpu_request": "1", "memory_request": "2Gi"}}`))
+	}))
+	defer server.Close()
+
+	r := &ApplicationResource{client: client.NewClient("test-token", &server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	modifyPlan := func(templateID types.Int64) (*resource.ModifyPlanResponse, *ApplicationResourceModel) {
+		config := reconciliationTestModel("templated", false)
+		config.TemplateID = templateID
+		config.Settings = &SettingsModel{CPURequest: types.StringValue("500m")}
+
+		planned := reconciliationTestModel("templated", false)
+		planned.TemplateID = templateID
+		planned.Settings = &SettingsModel{
+			HealthCheckPath:  types.StringValue("/"),
+			SchedulerEnabled: types.BoolValue(false),
+			Replicas:         types.Int64Value(1),
+			CPURequest:       types.StringValue("500m"),
+			MemoryRequest:    types.StringValue("512Mi"),
+		}
+
+		configValue := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+		configValue.Set(ctx, config)
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+		plan.Set(ctx, planned)
+
+		resp := &resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configValue.Raw},
+			Plan:   plan,
+			State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+		}, resp)
+
+		var result ApplicationResourceModel
+		resp.Plan.Get(ctx, &result)
+		return resp, &result
+	}
+
+	resp, result := modifyPlan(types.Int64Value(2))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+	expected := &SettingsModel{
+		HealthCheckPath:  types.StringValue("/up"),
+		SchedulerEnabled: types.BoolValue(false),
+		Replicas:         types.Int64Value(4),
+		CPURequest:       types.StringValue("500m"),
+		MemoryRequest:    types.StringValue("2Gi"),
+	}
+	if !reflect.DeepEqual(result.Settings, expected) {
+		t.Errorf("Expected planned settings %+v, got %+v", expected, result.Settings)
+	}
+
+	// No template, no lookup
+	requests = nil
+	resp, result = modifyPlan(types.Int64Null())
+	if resp.Diagnostics.HasError() || len(requests) != 0 {
+		t.Fatalf("Expected no template lookup, got %v requests and %v", requests, resp.Diagnostics)
+	}
+	if !result.Settings.Replicas.Equal(types.Int64Value(1)) {
+		t.Errorf("Expected the planned replicas to be kept, got %v", result.Settings.Replicas)
+	}
+
+	resp, _ = modifyPlan(types.Int64Value(9))
+	if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Unknown Application Template" {
+		t.Errorf("Expected an Unknown Application Template error, got %v", resp.Diagnostics)
+	}
+
+	resp, _ = modifyPlan(types.Int64Unknown())
+	if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Unknown Application Template" {
+		t.Errorf("Expected an error for an unknown template_id, got %v", resp.Diagnostics)
+	}
+}
+
+func TestApplicationResource_TemplateID_Mapping(t *testing.T) {
+	resource := &ApplicationResource{}
+
+	data := &ApplicationResourceModel{
+		Name:       types.StringValue("templated"),
+		Type:       types.StringValue("laravel"),
+		TemplateID: types.Int64Value(2),
+	}
+
+	if app := resource.toAPIModel(data); app.TemplateID != 2 {
+		t.Errorf("Expected TemplateID 2, got %d", app.TemplateID)
+	}
+	if update := resource.toUpdateAPIModel(data); update["template_id"] != int64(2) {
+		t.Errorf("Expected update template_id 2, got %v", update["template_id"])
+	}
+
+	data.TemplateID = types.Int64Null()
+	if app := resource.toAPIModel(data); app.TemplateID != 0 {
+		t.Errorf("Expected TemplateID to be omitted, got %d", app.TemplateID)
+	}
+	if _, ok := resource.toUpdateAPIModel(data)["template_id"]; ok {
+		t.Error("Expected template_id to be omitted from update")
+	}
+
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel", TemplateID: 3}, data)
+	if !data.TemplateID.Equal(types.Int64Value(3)) {
+		t.Errorf("Expected TemplateID 3 from API, got %v", data.TemplateID)
+	}
+
+	data.TemplateID = types.Int64Unknown()
+	resource.fromAPIModel(&client.Application{ID: 1, Type: "laravel"}, data)
+	if !data.TemplateID.IsNull() {
+		t.Errorf("Expected TemplateID to be null, got %v", data.TemplateID)
+	}
+}
+
+func TestApplicationResource_Environment_PlanSecretChanges(t *testing.T) {
+	existing := []client.ApplicationSecret{
+		{Key: "APP_KEY", Value: "base64:abc"},
+		{Key: "DB_PASSWORD", Value: "old"},
+		{Key: "MAIL_PASSWORD", Value: "********"},
+		{Key: "STRIPE_SECRET", Value: "sk_live"},
+		{Key: "LEGACY_TOKEN", Value: "token"},
+	}
+
+	tests := []struct {
+		name     string
+		previous map[string]string
+		desired  map[string]string
+		expected secretChanges
+	}{
+		{
+			name:     "new keys are created",
+			previous: nil,
+			desired:  map[string]string{"APP_KEY": "base64:abc", "REDIS_PASSWORD": "secret"},
+			expected: secretChanges{Create: map[string]string{"REDIS_PASSWORD": "secret"}, Update: map[string]string{}},
+		},
+		{
+			name:     "changed values are updated",
+			previous: map[string]string{"APP_KEY": "base64:abc", "DB_PASSWORD": "old"},
+			desired:  map[string]string{"APP_KEY": "base64:abc", "DB_PASSWORD": "new"},
+			expected: secretChanges{Create: map[string]string{}, Update: map[string]string{"DB_PASSWORD": "new"}},
+		},
+		{
+			name:     "removed keys are deleted",
+			previous: map[string]string{"APP_KEY": "base64:abc", "LEGACY_TOKEN": "token", "DB_PASSWORD": "old"},
+			desired:  map[string]string{"APP_KEY": "base64:abc"},
+			expected: secretChanges{Create: map[string]string{}, Update: map[string]string{}, Delete: []string{"DB_PASSWORD", "LEGACY_TOKEN"}},
+		},
+		{
+			name:     "emptied map deletes only its own keys",
+			previous: map[string]string{"APP_KEY": "base64:abc"},
+			desired:  map[string]string{},
+			expected: secretChanges{Create: map[string]string{}, Update: map[string]string{}, Delete: []string{"APP_KEY"}},
+		},
+		{
+			name:     "removed keys already gone are not deleted",
+			previous: map[string]string{"QUEUE_PASSWORD": "secret"},
+			desired:  nil,
+			expected: secretChanges{Create: map[string]string{}, Update: map[string]string{}},
+		},
+		{
+			name:     "masked values are compared with the previous value",
+			previous: map[string]string{"MAIL_PASSWORD": "smtp"},
+			desired:  map[string]string{"MAIL_PASSWORD": "smtp"},
+			expected: secretChanges{Create: map[string]string{}, Update: map[string]string{}},
+		},
+		{
+			name:     "masked values that changed are updated",
+			previous: map[string]string{"MAIL_PASSWORD": "smtp"},
+			desired:  map[string]string{"MAIL_PASSWORD": "rotated"},
+			expected: secretChanges{Create: map[string]string{}, Update: map[string]string{"MAIL_PASSWORD": "rotated"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := planSecretChanges(existing, tt.previous, tt.desired)
+			if !reflect.DeepEqual(changes, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, changes)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_Environment_FromAPI(t *testing.T) {
+	secrets := []client.ApplicationSecret{
+		{Key: "APP_KEY", Value: "base64:changed"},
+		{Key: "MAIL_PASSWORD", Value: "********"},
+		{Key: "STRIPE_SECRET", Value: "sk_live"},
+	}
+	state := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"APP_KEY":        types.StringValue("base64:abc"),
+		"MAIL_PASSWORD":  types.StringValue("smtp"),
+		"REDIS_PASSWORD": types.StringValue("secret"),
+	})
+
+	expected := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"APP_KEY":       types.StringValue("base64:changed"),
+		"MAIL_PASSWORD": types.StringValue("smtp"),
+	})
+
+	environment := environmentFromAPI(secrets, state)
+	if !environment.Equal(expected) {
+		t.Errorf("Expected environment %v, got %v", expected, environment)
+	}
+}
+
+func TestApplicationResource_Environment_Reconcile(t *testing.T) {
+	ctx := context.Background()
+
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{"data": [{"application_id": 1, "key": "APP_KEY", "value": "base64:abc"}, {"application_id": 1, "key": "DB_PASSWORD", "value": "old"}, {"application_id": 1, "key": "LEGACY_TOKEN", "value": "token"}]}`))
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Write([]byte(`{"data": {"application_id": 1, "key": "KEY", "value": "value"}}`))
+		}
+	}))
+	defer server.Close()
+
+	r := &ApplicationResource{client: client.NewClient("test-token", &server.URL)}
+
+	previous := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"APP_KEY":      types.StringValue("base64:abc"),
+		"DB_PASSWORD":  types.StringValue("old"),
+		"LEGACY_TOKEN": types.StringValue("token"),
+	})
+	desired := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"APP_KEY":        types.StringValue("base64:abc"),
+		"DB_PASSWORD":    types.StringValue("new"),
+		"REDIS_PASSWORD": types.StringValue("secret"),
+	})
+
+	applied, diags := r.reconcileEnvironment(ctx, 1, previous, desired)
+	if diags.HasError() {
+		t.Fatalf("Unexpected errors: %v", diags)
+	}
+	if !applied.Equal(desired) {
+		t.Errorf("Expected the desired environment to be applied, got %v", applied)
+	}
+
+	expected := []string{
+		"GET /applications/1/secrets",
+		"POST /applications/1/secrets",
+		"PUT /applications/1/secrets/DB_PASSWORD",
+		"DELETE /applications/1/secrets/LEGACY_TOKEN",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("Expected requests %v, got %v", expected, requests)
+	}
+
+	// Nothing is requested when the map is not used
+	requests = nil
+	if applied, diags := r.reconcileEnvironment(ctx, 1, types.MapNull(types.StringType), types.MapNull(types.StringType)); diags.HasError() || !applied.IsNull() {
+		t.Fatalf("Expected a null environment without errors, got %v and %v", applied, diags)
+	}
+	if len(requests) != 0 {
+		t.Errorf("Expected no requests without an environment map, got %v", requests)
+	}
+}
+
+// secretFailureServer serves the secrets of application 1 and fails the
+// create, update or delete of the keys in failing
+func secretFailureServer(t *testing.T, failing map[string]bool) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		key := strings.TrimPrefix(r.URL.Path, "/applications/1/secrets/")
+		if r.Method == http.MethodPost {
+			var secret client.ApplicationSecret
+			json.NewDecoder(r.Body).Decode(&secret)
+			key = secret.Key
+		}
+		if failing[key] {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"message": "The value is invalid."}`))
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/applications/1/secrets":
+			w.Write([]byte(`{"data": [{"application_id": 1, "key": "APP_KEY", "value": "base64:abc"}, {"application_id": 1, "key": "DB_PASSWORD", "value": "old"}, {"application_id": 1, "key": "MAIL_PASSWORD", "value": "old"}, {"application_id": 1, "key": "LEGACY_TOKEN", "value": "token"}, {"application_id": 1, "key": "OLD_TOKEN", "value": "token"}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/applications":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data": {"id": 1, "name": "app", "application_type": "laravel", "status": "running"}}`))
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Write([]byte(`{"data": {"application_id": 1, "key": "` + key + `", "value": "value"}}`))
+		}
+	}))
+}
+
+func TestApplicationResource_Environment_PartialFailure(t *testing.T) {
+	ctx := context.Background()
+
+	server := secretFailureServer(t, map[string]bool{"REDIS_PASSWORD": true, "DB_PASSWORD": true, "LEGACY_TOKEN": true})
+	defer server.Close()
+
+	r := &ApplicationResource{client: client.NewClient("test-token", &server.URL, client.WithRetriesDisabled())}
+
+	previous := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"APP_KEY":       types.StringValue("base64:abc"),
+		"DB_PASSWORD":   types.StringValue("old"),
+		"MAIL_PASSWORD": types.StringValue("old"),
+		"LEGACY_TOKEN":  types.StringValue("token"),
+		"OLD_TOKEN":     types.StringValue("token"),
+	})
+	desired := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"APP_KEY":        types.StringValue("base64:abc"),
+		"DB_PASSWORD":    types.StringValue("new"),
+		"MAIL_PASSWORD":  types.StringValue("new"),
+		"REDIS_PASSWORD": types.StringValue("secret"),
+		"QUEUE_PASSWORD": types.StringValue("secret"),
+	})
+
+	applied, diags := r.reconcileEnvironment(ctx, 1, previous, desired)
+
+	// Every failing key gets its own error, succeeded keys none
+	failedKeys := map[string]bool{}
+	summaries := 0
+	for _, d := range diags.Errors() {
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if !ok {
+			t.Fatalf("Expected an attribute error, got %v", d)
+		}
+		if withPath.Path().Equal(path.Root("environment")) {
+			summaries++
+			for _, key := range []string{"DB_PASSWORD", "LEGACY_TOKEN", "REDIS_PASSWORD"} {
+				if !strings.Contains(d.Detail(), key) {
+					t.Errorf("Expected the summary to name %s, got %q", key, d.Detail())
+				}
+			}
+			for _, key := range []string{"APP_KEY", "MAIL_PASSWORD", "QUEUE_PASSWORD", "OLD_TOKEN"} {
+				if strings.Contains(d.Detail(), key) {
+					t.Errorf("Expected the summary not to name %s, got %q", key, d.Detail())
+				}
+			}
+			continue
+		}
+		for _, key := range []string{"APP_KEY", "DB_PASSWORD", "MAIL_PASSWORD", "REDIS_PASSWORD", "QUEUE_PASSWORD", "LEGACY_TOKEN", "OLD_TOKEN"} {
+			if withPath.Path().Equal(path.Root("environment").AtMapKey(key)) {
+				failedKeys[key] = true
+			}
+		}
+	}
+	expectedFailed := map[string]bool{"DB_PASSWORD": true, "LEGACY_TOKEN": true, "REDIS_PASSWORD": true}
+	if !reflect.DeepEqual(failedKeys, expectedFailed) {
+		t.Errorf("Expected errors for exactly %v, got %v", expectedFailed, failedKeys)
+	}
+	if summaries != 1 {
+		t.Errorf("Expected one summary error, got %d", summaries)
+	}
+
+	// Failed keys keep the value the API still has
+	expected := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"APP_KEY":        types.StringValue("base64:abc"),
+		"DB_PASSWORD":    types.StringValue("old"),
+		"MAIL_PASSWORD":  types.StringValue("new"),
+		"QUEUE_PASSWORD": types.StringValue("secret"),
+		"LEGACY_TOKEN":   types.StringValue("token"),
+	})
+	if !applied.Equal(expected) {
+		t.Errorf("Expected applied environment %v, got %v", expected, applied)
+	}
+}
+
+// TestApplicationResource_Create_EnvironmentPartialFailure tests that a
+// create whose secrets partly fail only records the applied keys in state
+func TestApplicationResource_Create_EnvironmentPartialFailure(t *testing.T) {
+	ctx := context.Background()
+
+	server := secretFailureServer(t, map[string]bool{"REDIS_PASSWORD": true})
+	defer server.Close()
+
+	r := &ApplicationResource{client: client.NewClient("test-token", &server.URL, client.WithRetriesDisabled())}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	planned := reconciliationTestModel("app", false)
+	planned.ID = types.Int64Unknown()
+	planned.Status = types.StringUnknown()
+	planned.WaitForDeployment = types.BoolValue(false)
+	planned.Environment = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"APP_KEY":        types.StringValue("base64:abc"),
+		"REDIS_PASSWORD": types.StringValue("secret"),
+		"QUEUE_PASSWORD": types.StringValue("secret"),
+	})
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	plan.Set(ctx, planned)
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected the failed secret to be reported")
+	}
+
+	var result ApplicationResourceModel
+	resp.State.Get(ctx, &result)
+	if result.ID.ValueInt64() != 1 {
+		t.Errorf("Expected the created application ID in state, got %v", result.ID)
+	}
+	expected := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"APP_KEY":        types.StringValue("base64:abc"),
+		"QUEUE_PASSWORD": types.StringValue("secret"),
+	})
+	if !result.Environment.Equal(expected) {
+		t.Errorf("Expected only the applied secrets in state, got %v", result.Environment)
+	}
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+var _ datasource.DataSource = &ApplicationTemplatesDataSource{}
+
+func NewApplicationTemplatesDataSource() datasource.DataSource {
+	return &ApplicationTemplatesDataSource{}
+}
+
+type ApplicationTemplatesDataSource struct {
+	client *client.Client
+}
+
+type ApplicationTemplatesDataSourceModel struct {
+	Templates []ApplicationTemplateModel `tfsdk:"templates"`
+}
+
+type ApplicationTemplateModel struct {
+	ID               types.Int64  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	HealthCheckPath  types.String `tfsdk:"health_check_path"`
+	SchedulerEnabled types.Bool   `tfsdk:"scheduler_enabled"`
+	Replicas         types.Int64  `tfsdk:"replicas"`
+	CPURequest       types.String `tfsdk:"cpu_request"`
+	MemoryRequest    types.String `tfsdk:"memory_request"`
+}
+
+func (d *ApplicationTemplatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_templates"
+}
+
+func (d *ApplicationTemplatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the application templates defined by the Ploi Cloud platform, for use as an application's `template_id`",
+
+		Attributes: map[string]schema.Attribute{
+			"templates": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Available application templates",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Template ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Template name",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Template description",
+						},
+						"health_check_path": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Inherited health check path, null when the template leaves it unset",
+						},
+						"scheduler_enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Inherited Laravel scheduler toggle, null when the template leaves it unset",
+						},
+						"replicas": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Inherited number of replicas, null when the template leaves it unset",
+						},
+						"cpu_request": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Inherited CPU request, null when the template leaves it unset",
+						},
+						"memory_request": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Inherited memory request, null when the template leaves it unset",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ApplicationTemplatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ApplicationTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, d.client, &resp.Diagnostics)
+
+	var data ApplicationTemplatesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templates, err := d.client.ListApplicationTemplates(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list application templates, got error: %s", err))
+		return
+	}
+
+	d.fromAPIModel(templates, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ApplicationTemplatesDataSource) fromAPIModel(templates []client.ApplicationTemplate, data *ApplicationTemplatesDataSourceModel) {
+	// Always an empty list rather than null so the result can be iterated
+	data.Templates = make([]ApplicationTemplateModel, 0, len(templates))
+
+	for _, template := range templates {
+		model := ApplicationTemplateModel{
+			ID:               types.Int64Value(template.ID),
+			Name:             types.StringValue(template.Name),
+			Description:      stringValueOrPlanned(template.Description, types.StringNull()),
+			HealthCheckPath:  stringValueOrPlanned(template.HealthCheckPath, types.StringNull()),
+			SchedulerEnabled: types.BoolPointerValue(template.SchedulerEnabled),
+			Replicas:         types.Int64Null(),
+			CPURequest:       stringValueOrPlanned(template.CPURequest, types.StringNull()),
+			MemoryRequest:    stringValueOrPlanned(template.MemoryRequest, types.StringNull()),
+		}
+		if template.Replicas != 0 {
+			model.Replicas = types.Int64Value(template.Replicas)
+		}
+		data.Templates = append(data.Templates, model)
+	}
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+func TestApplicationTemplatesDataSource_fromAPIModel(t *testing.T) {
+	d := &ApplicationTemplatesDataSource{}
+	enabled := true
+
+	var data ApplicationTemplatesDataSourceModel
+	d.fromAPIModel([]client.ApplicationTemplate{
+		{ID: 1, Name: "laravel-small"},
+		{ID: 2, Name: "laravel-large", Description: "High traffic Laravel", HealthCheckPath: "/up", SchedulerEnabled: &enabled, Replicas: 4, CPURequest: "1", MemoryRequest: "2Gi"},
+	}, &data)
+
+	if len(data.Templates) != 2 {
+		t.Fatalf("Expected 2 templates, got %d", len(data.Templates))
+	}
+
+	small := data.Templates[0]
+	if !small.Name.Equal(types.StringValue("laravel-small")) {
+		t.Errorf("Expected name laravel-small, got %v", small.Name)
+	}
+	if !small.Description.IsNull() || !small.HealthCheckPath.IsNull() || !small.SchedulerEnabled.IsNull() || !small.Replicas.IsNull() || !small.CPURequest.IsNull() || !small.MemoryRequest.IsNull() {
+		t.Errorf("Expected unset template values to be null, got %+v", small)
+	}
+
+	large := data.Templates[1]
+	expected := ApplicationTemplateModel{
+		ID:               types.Int64Value(2),
+		Name:             types.StringValue("laravel-large"),
+		Description:      types.StringValue("High traffic Laravel"),
+		HealthCheckPath:  types.StringValue("/up"),
+		SchedulerEnabled: types.BoolValue(true),
+		Replicas:         types.Int64Value(4),
+		CPURequest:       types.StringValue("1"),
+		MemoryRequest:    types.StringValue("2Gi"),
+	}
+	if large != expected {
+		t.Errorf("Expected %+v, got %+v", expected, large)
+	}
+
+	// No templates is an empty list rather than null
+	data = ApplicationTemplatesDataSourceModel{}
+	d.fromAPIModel(nil, &data)
+	if data.Templates == nil || len(data.Templates) != 0 {
+		t.Errorf("Expected empty templates, got %v", data.Templates)
+	}
+}
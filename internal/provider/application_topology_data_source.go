@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+var _ datasource.DataSource = &ApplicationTopologyDataSource{}
+
+func NewApplicationTopologyDataSource() datasource.DataSource {
+	return &ApplicationTopologyDataSource{}
+}
+
+type ApplicationTopologyDataSource struct {
+	client *client.Client
+}
+
+type ApplicationTopologyDataSourceModel struct {
+	ApplicationID types.Int64             `tfsdk:"application_id"`
+	Name          types.String            `tfsdk:"name"`
+	Services      []TopologyServiceModel  `tfsdk:"services"`
+	Volumes       []TopologyVolumeModel   `tfsdk:"volumes"`
+	Domains       []TopologyDomainModel   `tfsdk:"domains"`
+	Relationships []TopologyRelationModel `tfsdk:"relationships"`
+}
+
+type TopologyServiceModel struct {
+	ID      types.Int64  `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Version types.String `tfsdk:"version"`
+	Status  types.String `tfsdk:"status"`
+}
+
+type TopologyVolumeModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	MountPath types.String `tfsdk:"mount_path"`
+	Size      types.Int64  `tfsdk:"size"`
+}
+
+type TopologyDomainModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Domain    types.String `tfsdk:"domain"`
+	SSLStatus types.String `tfsdk:"ssl_status"`
+}
+
+type TopologyRelationModel struct {
+	From types.String `tfsdk:"from"`
+	To   types.String `tfsdk:"to"`
+	Type types.String `tfsdk:"type"`
+}
+
+// Relationship types between the application and its linked resources
+const (
+	topologyUsesService  = "uses_service"
+	topologyMountsVolume = "mounts_volume"
+	topologyServedAt     = "served_at"
+)
+
+func (d *ApplicationTopologyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_topology"
+}
+
+func (d *ApplicationTopologyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Services, volumes and domains linked to an application and how they relate to it, e.g. for generating architecture documentation",
+
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Application identifier",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Application name",
+			},
+			"services": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Services used by the application",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Service ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Service name",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Service type (mysql, postgresql, redis, ...)",
+						},
+						"version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Service version",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Service status",
+						},
+					},
+				},
+			},
+			"volumes": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Volumes mounted into the application",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Volume ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Volume name",
+						},
+						"mount_path": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Path the volume is mounted at",
+						},
+						"size": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Volume size in GB",
+						},
+					},
+				},
+			},
+			"domains": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Custom domains the application is served at",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Domain ID",
+						},
+						"domain": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Domain name",
+						},
+						"ssl_status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Certificate status of the domain",
+						},
+					},
+				},
+			},
+			"relationships": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Edges of the topology graph. Nodes are referenced as `application.<id>`, `service.<id>`, `volume.<id>` and `domain.<id>`",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"from": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Node the relationship starts at",
+						},
+						"to": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Node the relationship points to",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Relationship type (uses_service, mounts_volume, served_at)",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ApplicationTopologyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ApplicationTopologyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, d.client, &resp.Diagnostics)
+
+	var data ApplicationTopologyDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := d.client.GetApplication(ctx, data.ApplicationID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application, got error: %s", err))
+		return
+	}
+
+	if app == nil {
+		resp.Diagnostics.AddError("Application Not Found", fmt.Sprintf("Application with ID %d not found", data.ApplicationID.ValueInt64()))
+		return
+	}
+
+	d.fromAPIModel(app, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ApplicationTopologyDataSource) fromAPIModel(app *client.Application, data *ApplicationTopologyDataSourceModel) {
+	data.ApplicationID = types.Int64Value(app.ID)
+	data.Name = types.StringValue(app.Name)
+
+	root := fmt.Sprintf("application.%d", app.ID)
+
+	// Always empty lists rather than null so the result can be iterated
+	data.Services = make([]TopologyServiceModel, 0, len(app.Services))
+	data.Volumes = make([]TopologyVolumeModel, 0, len(app.Volumes))
+	data.Domains = make([]TopologyDomainModel, 0, len(app.Domains))
+	data.Relationships = make([]TopologyRelationModel, 0, len(app.Services)+len(app.Volumes)+len(app.Domains))
+
+	for _, service := range app.Services {
+		data.Services = append(data.Services, TopologyServiceModel{
+			ID:      types.Int64Value(service.ID),
+			Name:    types.StringValue(service.Name),
+			Type:    types.StringValue(service.Type),
+			Version: types.StringValue(service.Version),
+			Status:  types.StringValue(service.Status),
+		})
+		data.Relationships = append(data.Relationships, topologyRelation(root, fmt.Sprintf("service.%d", service.ID), topologyUsesService))
+	}
+
+	for _, volume := range app.Volumes {
+		data.Volumes = append(data.Volumes, TopologyVolumeModel{
+			ID:        types.Int64Value(volume.ID),
+			Name:      types.StringValue(volume.Name),
+			MountPath: types.StringValue(volume.MountPath),
+			Size:      types.Int64Value(volume.Size),
+		})
+		data.Relationships = append(data.Relationships, topologyRelation(root, fmt.Sprintf("volume.%d", volume.ID), topologyMountsVolume))
+	}
+
+	for _, domain := range app.Domains {
+		data.Domains = append(data.Domains, TopologyDomainModel{
+			ID:        types.Int64Value(domain.ID),
+			Domain:    types.StringValue(domain.Domain),
+			SSLStatus: types.StringValue(domain.SSLStatus),
+		})
+		data.Relationships = append(data.Relationships, topologyRelation(root, fmt.Sprintf("domain.%d", domain.ID), topologyServedAt))
+	}
+}
+
+func topologyRelation(from, to, relationType string) TopologyRelationModel {
+	return TopologyRelationModel{
+		From: types.StringValue(from),
+		To:   types.StringValue(to),
+		Type: types.StringValue(relationType),
+	}
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+func TestApplicationTopologyDataSource_fromAPIModel(t *testing.T) {
+	d := &ApplicationTopologyDataSource{}
+
+	var data ApplicationTopologyDataSourceModel
+	d.fromAPIModel(&client.Application{
+		ID:   12,
+		Name: "shop",
+		Type: "laravel",
+		Services: []client.ApplicationService{
+			{ID: 30, Name: "db", Type: "mysql", Version: "8.0", Status: "running"},
+			{ID: 31, Name: "cache", Type: "redis", Version: "7", Status: "creating"},
+		},
+		Volumes: []client.ApplicationVolume{
+			{ID: 40, Name: "storage", MountPath: "/var/www/html/storage", Size: 10},
+		},
+		Domains: []client.ApplicationDomain{
+			{ID: 50, Domain: "shop.example.com", SSLStatus: "active"},
+			{ID: 51, Domain: "www.shop.example.com", SSLStatus: "pending"},
+		},
+		Secrets: []client.ApplicationSecret{{Key: "APP_KEY"}},
+	}, &data)
+
+	expected := ApplicationTopologyDataSourceModel{
+		ApplicationID: types.Int64Value(12),
+		Name:          types.StringValue("shop"),
+		Services: []TopologyServiceModel{
+			{ID: types.Int64Value(30), Name: types.StringValue("db"), Type: types.StringValue("mysql"), Version: types.StringValue("8.0"), Status: types.StringValue("running")},
+			{ID: types.Int64Value(31), Name: types.StringValue("cache"), Type: types.StringValue("redis"), Version: types.StringValue("7"), Status: types.StringValue("creating")},
+		},
+		Volumes: []TopologyVolumeModel{
+			{ID: types.Int64Value(40), Name: types.StringValue("storage"), MountPath: types.StringValue("/var/www/html/storage"), Size: types.Int64Value(10)},
+		},
+		Domains: []TopologyDomainModel{
+			{ID: types.Int64Value(50), Domain: types.StringValue("shop.example.com"), SSLStatus: types.StringValue("active")},
+			{ID: types.Int64Value(51), Domain: types.StringValue("www.shop.example.com"), SSLStatus: types.StringValue("pending")},
+		},
+		Relationships: []TopologyRelationModel{
+			topologyRelation("application.12", "service.30", "uses_service"),
+			topologyRelation("application.12", "service.31", "uses_service"),
+			topologyRelation("application.12", "volume.40", "mounts_volume"),
+			topologyRelation("application.12", "domain.50", "served_at"),
+			topologyRelation("application.12", "domain.51", "served_at"),
+		},
+	}
+
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Expected topology %+v, got %+v", expected, data)
+	}
+
+	// An application without linked resources has empty, not null, collections
+	data = ApplicationTopologyDataSourceModel{}
+	d.fromAPIModel(&client.Application{ID: 13, Name: "empty"}, &data)
+	if data.Services == nil || data.Volumes == nil || data.Domains == nil || data.Relationships == nil {
+		t.Errorf("Expected empty collections, got %+v", data)
+	}
+	if len(data.Services)+len(data.Volumes)+len(data.Domains)+len(data.Relationships) != 0 {
+		t.Errorf("Expected no topology entries, got %+v", data)
+	}
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+var _ datasource.DataSource = &ApplicationsDataSource{}
+
+// applicationsPageSize is the number of applications requested per page
+const applicationsPageSize = 100
+
+func NewApplicationsDataSource() datasource.DataSource {
+	return &ApplicationsDataSource{}
+}
+
+type ApplicationsDataSource struct {
+	client *client.Client
+}
+
+type ApplicationsDataSourceModel struct {
+	Type         types.String               `tfsdk:"type"`
+	Region       types.String               `tfsdk:"region"`
+	Applications []ApplicationsSummaryModel `tfsdk:"applications"`
+}
+
+type ApplicationsSummaryModel struct {
+	ID     types.Int64  `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Type   types.String `tfsdk:"type"`
+	Status types.String `tfsdk:"status"`
+	URL    types.String `tfsdk:"url"`
+}
+
+func (d *ApplicationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_applications"
+}
+
+func (d *ApplicationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the applications visible to the API token, optionally filtered by type and region",
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return applications of this type (laravel, wordpress, statamic, craftcms, nodejs)",
+				Validators: []validator.String{
+					stringvalidator.OneOf("laravel", "wordpress", "statamic", "craftcms", "nodejs"),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return applications deployed in this region",
+			},
+			"applications": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Applications matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Application ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Application name",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Application type",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Application status",
+						},
+						"url": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Application URL",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ApplicationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, d.client, &resp.Diagnostics)
+
+	var data ApplicationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apps, err := listAllApplications(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list applications, got error: %s", err))
+		return
+	}
+
+	d.fromAPIModel(filterApplications(apps, data.Type.ValueString(), data.Region.ValueString()), &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listAllApplications pages through ListApplications until the last page.
+// Responses without pagination metadata are treated as the only page.
+func listAllApplications(ctx context.Context, c *client.Client) ([]client.Application, error) {
+	var apps []client.Application
+
+	for page := 1; ; page++ {
+		pageApps, pagination, err := c.ListApplications(ctx, page, applicationsPageSize)
+		if err != nil {
+			return nil, err
+		}
+		apps = append(apps, pageApps...)
+
+		if pagination == nil || len(pageApps) == 0 || pagination.CurrentPage >= pagination.LastPage {
+			return apps, nil
+		}
+	}
+}
+
+// filterApplications returns the applications matching appType and region.
+// Empty filters match every application.
+func filterApplications(apps []client.Application, appType, region string) []client.Application {
+	var filtered []client.Application
+	for _, app := range apps {
+		if appType != "" && app.Type != appType {
+			continue
+		}
+		if region != "" && app.Region != region {
+			continue
+		}
+		filtered = append(filtered, app)
+	}
+	return filtered
+}
+
+func (d *ApplicationsDataSource) fromAPIModel(apps []client.Application, data *ApplicationsDataSourceModel) {
+	// Always a list, so for_each over the result works without a null check
+	data.Applications = make([]ApplicationsSummaryModel, 0, len(apps))
+	for _, app := range apps {
+		data.Applications = append(data.Applications, ApplicationsSummaryModel{
+			ID:     types.Int64Value(app.ID),
+			Name:   types.StringValue(app.Name),
+			Type:   types.StringValue(app.Type),
+			Status: types.StringValue(app.Status),
+			URL:    types.StringValue(app.URL),
+		})
+	}
+}
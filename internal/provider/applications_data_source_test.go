@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+func TestListAllApplications(t *testing.T) {
+	pages := []string{
+		`{"id": 1, "name": "api", "application_type": "laravel", "region": "eu-west"}, {"id": 2, "name": "blog", "application_type": "wordpress", "region": "eu-west"}`,
+		`{"id": 3, "name": "shop", "application_type": "laravel", "region": "us-east"}`,
+	}
+	var requestedPages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/applications" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		page := r.URL.Query().Get("page")
+		requestedPages = append(requestedPages, page)
+
+		var current int
+		fmt.Sscanf(page, "%d", &current)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data": [%s], "meta": {"current_page": %d, "last_page": %d, "total": 3}}`, pages[current-1], current, len(pages))
+	}))
+	defer server.Close()
+
+	apps, err := listAllApplications(context.Background(), client.NewClient("test-token", &server.URL))
+	if err != nil {
+		t.Fatalf("Expected success but got error: %v", err)
+	}
+	if len(apps) != 3 || apps[2].Name != "shop" {
+		t.Errorf("Expected the applications of every page, got %+v", apps)
+	}
+	if !reflect.DeepEqual(requestedPages, []string{"1", "2"}) {
+		t.Errorf("Expected pages 1 and 2 to be requested, got %v", requestedPages)
+	}
+}
+
+func TestListAllApplications_WithoutPagination(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [{"id": 1, "name": "api", "application_type": "laravel"}]}`))
+	}))
+	defer server.Close()
+
+	apps, err := listAllApplications(context.Background(), client.NewClient("test-token", &server.URL))
+	if err != nil {
+		t.Fatalf("Expected success but got error: %v", err)
+	}
+	if len(apps) != 1 || requests != 1 {
+		t.Errorf("Expected a single page with 1 application, got %d applications in %d requests", len(apps), requests)
+	}
+}
+
+func TestFilterApplications(t *testing.T) {
+	apps := []client.Application{
+		{ID: 1, Name: "api", Type: "laravel", Region: "eu-west"},
+		{ID: 2, Name: "blog", Type: "wordpress", Region: "eu-west"},
+		{ID: 3, Name: "shop", Type: "laravel", Region: "us-east"},
+	}
+
+	tests := []struct {
+		name        string
+		appType     string
+		region      string
+		expectedIDs []int64
+	}{
+		{"no filters", "", "", []int64{1, 2, 3}},
+		{"type", "laravel", "", []int64{1, 3}},
+		{"region", "", "eu-west", []int64{1, 2}},
+		{"type and region", "laravel", "us-east", []int64{3}},
+		{"no match", "nodejs", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ids []int64
+			for _, app := range filterApplications(apps, tt.appType, tt.region) {
+				ids = append(ids, app.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.expectedIDs) {
+				t.Errorf("Expected applications %v, got %v", tt.expectedIDs, ids)
+			}
+		})
+	}
+}
+
+func TestApplicationsDataSource_fromAPIModel(t *testing.T) {
+	d := &ApplicationsDataSource{}
+
+	var data ApplicationsDataSourceModel
+	d.fromAPIModel([]client.Application{
+		{ID: 1, Name: "api", Type: "laravel", Status: "running", URL: "https://api.example.com"},
+	}, &data)
+
+	expected := []ApplicationsSummaryModel{
+		{
+			ID:     types.Int64Value(1),
+			Name:   types.StringValue("api"),
+			Type:   types.StringValue("laravel"),
+			Status: types.StringValue("running"),
+			URL:    types.StringValue("https://api.example.com"),
+		},
+	}
+	if !reflect.DeepEqual(data.Applications, expected) {
+		t.Errorf("Expected applications %+v, got %+v", expected, data.Applications)
+	}
+
+	// No matches is an empty list rather than null
+	d.fromAPIModel(nil, &data)
+	if data.Applications == nil || len(data.Applications) != 0 {
+		t.Errorf("Expected an empty application list, got %+v", data.Applications)
+	}
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = cidrBlockValidator{}
+
+// cidrBlockValidator checks that a value is an IPv4 or IPv6 CIDR block such
+// as "10.0.0.0/8" or "2001:db8::/32".
+type cidrBlockValidator struct{}
+
+func cidrBlock() validator.String {
+	return cidrBlockValidator{}
+}
+
+func (v cidrBlockValidator) Description(ctx context.Context) string {
+	return "value must be a CIDR block such as 10.0.0.0/8 or 2001:db8::/32"
+}
+
+func (v cidrBlockValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrBlockValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, _, err := net.ParseCIDR(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR Block",
+			fmt.Sprintf("%q is not a valid CIDR block (e.g. 10.0.0.0/8 or 2001:db8::/32)", value),
+		)
+	}
+}
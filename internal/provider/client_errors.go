@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+// clientErrorDiagnostics reports a failed client call as a "Client Error".
+// When the API rejected individual fields, each field listed in fieldPaths is
+// also reported against its attribute so Terraform points at the offending
+// configuration.
+func clientErrorDiagnostics(action string, err error, fieldPaths map[string]path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) && len(apiErr.Errors) > 0 {
+		fields := make([]string, 0, len(apiErr.Errors))
+		for field := range apiErr.Errors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+
+		for _, field := range fields {
+			attrPath, ok := fieldPaths[field]
+			if !ok {
+				continue
+			}
+			diags.AddAttributeError(attrPath, "Invalid Attribute Value", strings.Join(apiErr.Errors[field], " "))
+		}
+	}
+
+	diags.AddError("Client Error", fmt.Sprintf("Unable to %s, got error: %s", action, err))
+
+	return diags
+}
+
+// appendDeprecationWarnings logs and reports as warnings the deprecation
+// notices the client received, so users learn about deprecated API behaviour
+// before it is removed. Each deprecated endpoint is only reported once.
+func appendDeprecationWarnings(ctx context.Context, c *client.Client, diags *diag.Diagnostics) {
+	for _, notice := range c.DeprecationNotices() {
+		tflog.Warn(ctx, "Deprecated Ploi Cloud API endpoint", map[string]interface{}{
+			"method":      notice.Method,
+			"path":        notice.Path,
+			"deprecation": notice.Deprecation,
+			"sunset":      notice.Sunset,
+			"link":        notice.Link,
+		})
+		diags.AddWarning("Deprecated API Endpoint", fmt.Sprintf("%s. Upgrade the provider before the endpoint is removed.", notice))
+	}
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+func TestClientErrorDiagnostics(t *testing.T) {
+	validationErr := &client.APIError{
+		DetailedError: &client.DetailedError{
+			StatusCode: 422,
+			Message:    "The given data was invalid.",
+			Errors: map[string][]string{
+				"storage_size": {"The storage size must be at least 1Gi."},
+				"unknown":      {"Not mapped to an attribute."},
+			},
+			Suggestion: "Storage size must be specified with units (e.g., '1Gi', '10Gi')",
+			DocsLink:   "https://docs.ploi.io/cloud",
+		},
+		Operation: "create service",
+	}
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedPaths  []path.Path
+		expectedDetail string
+	}{
+		{
+			name:           "validation error maps known fields",
+			err:            validationErr,
+			expectedPaths:  []path.Path{path.Root("storage_size")},
+			expectedDetail: "The storage size must be at least 1Gi.",
+		},
+		{
+			name:           "wrapped validation error",
+			err:            fmt.Errorf("creating: %w", validationErr),
+			expectedPaths:  []path.Path{path.Root("storage_size")},
+			expectedDetail: "The storage size must be at least 1Gi.",
+		},
+		{
+			name: "plain error",
+			err:  fmt.Errorf("connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := clientErrorDiagnostics("create service", tt.err, serviceAPIFieldPaths)
+
+			var attrDiags []diag.DiagnosticWithPath
+			var general []diag.Diagnostic
+			for _, d := range diags {
+				if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+					attrDiags = append(attrDiags, withPath)
+				} else {
+					general = append(general, d)
+				}
+			}
+
+			if len(attrDiags) != len(tt.expectedPaths) {
+				t.Fatalf("Expected %d attribute diagnostics, got %d: %v", len(tt.expectedPaths), len(attrDiags), diags)
+			}
+			for i, expected := range tt.expectedPaths {
+				if !attrDiags[i].Path().Equal(expected) {
+					t.Errorf("Expected diagnostic path %s, got %s", expected, attrDiags[i].Path())
+				}
+				if attrDiags[i].Detail() != tt.expectedDetail {
+					t.Errorf("Expected detail '%s', got '%s'", tt.expectedDetail, attrDiags[i].Detail())
+				}
+			}
+
+			if len(general) != 1 || general[0].Summary() != "Client Error" {
+				t.Fatalf("Expected a single Client Error diagnostic, got %v", general)
+			}
+			if !strings.Contains(general[0].Detail(), "Unable to create service, got error: ") {
+				t.Errorf("Unexpected Client Error detail: %s", general[0].Detail())
+			}
+		})
+	}
+}
+
+func TestApplicationAPIFieldPaths_NestedSettings(t *testing.T) {
+	err := &client.APIError{
+		DetailedError: &client.DetailedError{
+			StatusCode: 422,
+			Message:    "The given data was invalid.",
+			Errors:     map[string][]string{"memory_request": {"The memory request is too large."}},
+		},
+		Operation: "update application",
+	}
+
+	diags := clientErrorDiagnostics("update application", err, applicationAPIFieldPaths)
+
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	if !ok {
+		t.Fatalf("Expected first diagnostic to have a path, got %v", diags)
+	}
+	expected := path.Root("settings").AtName("memory_request")
+	if !withPath.Path().Equal(expected) {
+		t.Errorf("Expected path %s, got %s", expected, withPath.Path())
+	}
+}
+
+func TestAppendDeprecationWarnings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Sunset", "Wed, 31 Dec 2025 23:59:59 GMT")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"id": 1, "name": "shop", "application_type": "laravel"}}`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient("test-token", &server.URL, client.WithRetriesDisabled())
+	if _, err := c.GetApplication(context.Background(), 1); err != nil {
+		t.Fatalf("Expected the deprecated request to succeed, got: %v", err)
+	}
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+
+	var diags diag.Diagnostics
+	appendDeprecationWarnings(ctx, c, &diags)
+
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("Expected a single warning, got: %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail(), "GET /applications/1 is deprecated") {
+		t.Errorf("Expected warning to name the endpoint, got %q", diags[0].Detail())
+	}
+	if !strings.Contains(logs.String(), `"@level":"warn"`) || !strings.Contains(logs.String(), `"path":"/applications/1"`) {
+		t.Errorf("Expected a tflog warning for the endpoint, got: %s", logs.String())
+	}
+
+	// The warning is only surfaced once
+	diags = nil
+	appendDeprecationWarnings(ctx, c, &diags)
+	if len(diags) != 0 {
+		t.Errorf("Expected no repeated warning, got: %v", diags)
+	}
+
+	// A resource that was never configured has no client
+	appendDeprecationWarnings(ctx, nil, &diags)
+	if len(diags) != 0 {
+		t.Errorf("Expected no warnings without a client, got: %v", diags)
+	}
+}
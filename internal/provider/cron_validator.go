@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = cronExpressionValidator{}
+
+// cronField describes one position of a cron expression.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	cronSecondField = cronField{name: "second", min: 0, max: 59}
+	cronMinuteField = cronField{name: "minute", min: 0, max: 59}
+	cronHourField   = cronField{name: "hour", min: 0, max: 23}
+	cronDayField    = cronField{name: "day-of-month", min: 1, max: 31}
+	cronMonthField  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	// Both 0 and 7 mean Sunday
+	cronWeekdayField = cronField{name: "day-of-week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}}
+)
+
+// cronMacros are the predefined schedules accepted in place of a field list.
+var cronMacros = map[string]bool{
+	"@yearly":   true,
+	"@annually": true,
+	"@monthly":  true,
+	"@weekly":   true,
+	"@daily":    true,
+	"@midnight": true,
+	"@hourly":   true,
+}
+
+// cronExpressionValidator validates standard 5-field cron expressions and,
+// when allowSeconds is set, 6-field expressions with a leading seconds field.
+type cronExpressionValidator struct {
+	allowSeconds bool
+}
+
+// cronExpression returns a validator for 5-field cron expressions.
+func cronExpression() validator.String {
+	return cronExpressionValidator{}
+}
+
+// cronExpressionWithSeconds returns a validator that also accepts 6-field
+// cron expressions with a leading seconds field.
+func cronExpressionWithSeconds() validator.String {
+	return cronExpressionValidator{allowSeconds: true}
+}
+
+func (v cronExpressionValidator) Description(ctx context.Context) string {
+	if v.allowSeconds {
+		return "value must be a valid 5-field or 6-field (with seconds) cron expression"
+	}
+	return "value must be a valid 5-field cron expression"
+}
+
+func (v cronExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronExpressionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := parseCronExpression(req.ConfigValue.ValueString(), v.allowSeconds); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Cron Expression",
+			fmt.Sprintf("%q is not a valid cron expression: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// parseCronExpression checks every field of expr and returns an error naming
+// the first offending field.
+func parseCronExpression(expr string, allowSeconds bool) error {
+	expr = strings.TrimSpace(expr)
+	if cronMacros[strings.ToLower(expr)] {
+		return nil
+	}
+
+	parts := strings.Fields(expr)
+	fields := []cronField{cronMinuteField, cronHourField, cronDayField, cronMonthField, cronWeekdayField}
+
+	switch {
+	case len(parts) == 6 && allowSeconds:
+		fields = append([]cronField{cronSecondField}, fields...)
+	case len(parts) != 5:
+		if allowSeconds {
+			return fmt.Errorf("expected 5 or 6 fields, got %d", len(parts))
+		}
+		return fmt.Errorf("expected 5 fields, got %d", len(parts))
+	}
+
+	for i, part := range parts {
+		if err := fields[i].parse(part); err != nil {
+			return fmt.Errorf("%s field (position %d) %q: %s", fields[i].name, i+1, part, err)
+		}
+	}
+
+	return nil
+}
+
+// parse validates a comma-separated list of `*`, values, ranges and steps.
+func (f cronField) parse(value string) error {
+	for _, item := range strings.Split(value, ",") {
+		if item == "" {
+			return fmt.Errorf("empty list element")
+		}
+
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			step, err := strconv.Atoi(stepPart)
+			if err != nil || step < 1 {
+				return fmt.Errorf("step %q must be a positive integer", stepPart)
+			}
+			if step > f.max {
+				return fmt.Errorf("step %d exceeds the maximum of %d", step, f.max)
+			}
+		}
+
+		if rangePart == "*" {
+			continue
+		}
+
+		startPart, endPart, isRange := strings.Cut(rangePart, "-")
+		start, err := f.value(startPart)
+		if err != nil {
+			return err
+		}
+
+		if isRange {
+			end, err := f.value(endPart)
+			if err != nil {
+				return err
+			}
+			if start > end {
+				return fmt.Errorf("range start %d is greater than end %d", start, end)
+			}
+		}
+	}
+
+	return nil
+}
+
+// value parses a single number or name and checks it is within bounds.
+func (f cronField) value(s string) (int, error) {
+	if n, ok := f.names[strings.ToUpper(s)]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", n, f.min, f.max)
+	}
+
+	return n, nil
+}
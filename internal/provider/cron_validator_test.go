@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func TestCronExpressionValidator(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		allowSeconds  bool
+		expectError   bool
+		errorContains string
+	}{
+		{name: "every minute", value: "* * * * *"},
+		{name: "every 15 minutes", value: "*/15 * * * *"},
+		{name: "range with step", value: "0 9-17/2 * * 1-5"},
+		{name: "lists", value: "0,30 8,12,18 * * *"},
+		{name: "month and weekday names", value: "0 3 * jan-jun MON-FRI"},
+		{name: "sunday as 7", value: "0 0 * * 7"},
+		{name: "last bounds", value: "59 23 31 12 6"},
+		{name: "value with step", value: "5/10 * * * *"},
+		{name: "macro", value: "@daily"},
+		{name: "surrounding whitespace", value: "  0 2 * * *  "},
+		{name: "six fields with seconds allowed", value: "30 */5 * * * *", allowSeconds: true},
+		{name: "five fields with seconds allowed", value: "0 2 * * *", allowSeconds: true},
+
+		{name: "empty", value: "", expectError: true, errorContains: "expected 5 fields, got 0"},
+		{name: "too few fields", value: "* * * *", expectError: true, errorContains: "expected 5 fields, got 4"},
+		{name: "six fields without seconds", value: "0 0 2 * * *", expectError: true, errorContains: "expected 5 fields, got 6"},
+		{name: "seven fields with seconds", value: "0 0 0 2 * * *", allowSeconds: true, expectError: true, errorContains: "expected 5 or 6 fields, got 7"},
+		{name: "minute out of range", value: "60 * * * *", expectError: true, errorContains: "minute field (position 1)"},
+		{name: "hour out of range", value: "0 24 * * *", expectError: true, errorContains: "hour field (position 2)"},
+		{name: "day of month zero", value: "0 0 0 * *", expectError: true, errorContains: "day-of-month field (position 3)"},
+		{name: "month out of range", value: "0 0 1 13 *", expectError: true, errorContains: "month field (position 4)"},
+		{name: "weekday out of range", value: "0 0 * * 8", expectError: true, errorContains: "day-of-week field (position 5)"},
+		{name: "unknown name", value: "0 0 * FOO *", expectError: true, errorContains: "invalid value \"FOO\""},
+		{name: "zero step", value: "*/0 * * * *", expectError: true, errorContains: "step \"0\" must be a positive integer"},
+		{name: "step too large", value: "*/61 * * * *", expectError: true, errorContains: "step 61 exceeds"},
+		{name: "reversed range", value: "0 17-9 * * *", expectError: true, errorContains: "range start 17 is greater than end 9"},
+		{name: "empty list element", value: "0,,30 * * * *", expectError: true, errorContains: "empty list element"},
+		{name: "seconds out of range", value: "60 * * * * *", allowSeconds: true, expectError: true, errorContains: "second field (position 1)"},
+		{name: "unknown macro", value: "@fortnightly", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := cronExpression()
+			if tt.allowSeconds {
+				v = cronExpressionWithSeconds()
+			}
+
+			diags := runStringValidators(t, []validator.String{v}, tt.value)
+			if diags.HasError() != tt.expectError {
+				t.Fatalf("Expected error %v for %q, got diagnostics: %v", tt.expectError, tt.value, diags)
+			}
+			if tt.errorContains != "" && !strings.Contains(diags[0].Detail(), tt.errorContains) {
+				t.Errorf("Expected error detail to contain %q, got %q", tt.errorContains, diags[0].Detail())
+			}
+		})
+	}
+}
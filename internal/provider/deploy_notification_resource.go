@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+var _ resource.Resource = &DeployNotificationResource{}
+var _ resource.ResourceWithImportState = &DeployNotificationResource{}
+
+// deployNotificationEvents are the deployment events a webhook can subscribe to
+var deployNotificationEvents = []string{"deploy.started", "deploy.success", "deploy.failed"}
+
+func NewDeployNotificationResource() resource.Resource {
+	return &DeployNotificationResource{}
+}
+
+type DeployNotificationResource struct {
+	client *client.Client
+}
+
+type DeployNotificationResourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	ApplicationID types.Int64  `tfsdk:"application_id"`
+	URL           types.String `tfsdk:"url"`
+	Events        types.List   `tfsdk:"events"`
+	Secret        types.String `tfsdk:"secret"`
+}
+
+func (r *DeployNotificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deploy_notification"
+}
+
+func (r *DeployNotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a webhook that is notified about deployments of a Ploi Cloud application (e.g. Slack, Teams or any HTTP endpoint)",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Notification ID",
+			},
+			"application_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Application ID this notification belongs to",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Webhook URL (http or https)",
+				Validators: []validator.String{
+					webhookURL(),
+				},
+			},
+			"events": schema.ListAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Events to notify about (deploy.started, deploy.success, deploy.failed)",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(deployNotificationEvents...)),
+				},
+			},
+			"secret": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Secret used to sign webhook payloads",
+			},
+		},
+	}
+}
+
+func (r *DeployNotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeployNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
+	var data DeployNotificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateDeployNotification(ctx, r.toAPIModel(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create deploy notification, got error: %s", err))
+		return
+	}
+
+	r.fromAPIModel(created, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeployNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
+	var data DeployNotificationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, err := r.client.GetDeployNotification(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deploy notification, got error: %s", err))
+		return
+	}
+
+	if notification == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.fromAPIModel(notification, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeployNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
+	var data DeployNotificationResourceModel
+	var state DeployNotificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+
+	updated, err := r.client.UpdateDeployNotification(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64(), r.toAPIModel(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update deploy notification, got error: %s", err))
+		return
+	}
+
+	r.fromAPIModel(updated, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeployNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
+	var data DeployNotificationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDeployNotification(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
+	// A notification that is already gone counts as deleted
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete deploy notification, got error: %s", err))
+		return
+	}
+}
+
+func (r *DeployNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	applicationID, notificationID, err := parseImportID(req.ID, "Notification")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), applicationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), notificationID)...)
+}
+
+func (r *DeployNotificationResource) toAPIModel(data *DeployNotificationResourceModel) *client.DeployNotification {
+	notification := &client.DeployNotification{
+		ApplicationID: data.ApplicationID.ValueInt64(),
+		URL:           data.URL.ValueString(),
+		Secret:        data.Secret.ValueString(),
+	}
+
+	if !data.ID.IsNull() && !data.ID.IsUnknown() {
+		notification.ID = data.ID.ValueInt64()
+	}
+
+	if !data.Events.IsNull() && !data.Events.IsUnknown() {
+		data.Events.ElementsAs(context.Background(), &notification.Events, false)
+	}
+
+	return notification
+}
+
+func (r *DeployNotificationResource) fromAPIModel(notification *client.DeployNotification, data *DeployNotificationResourceModel) {
+	data.ID = types.Int64Value(notification.ID)
+
+	// Only update ApplicationID if it's not zero, otherwise preserve the planned value
+	if notification.ApplicationID != 0 {
+		data.ApplicationID = types.Int64Value(notification.ApplicationID)
+	}
+
+	data.URL = types.StringValue(notification.URL)
+
+	if len(notification.Events) > 0 {
+		data.Events, _ = types.ListValueFrom(context.Background(), types.StringType, notification.Events)
+	}
+
+	// The API masks the signing secret, keep the configured value
+	if notification.Secret != "" && notification.Secret != "********" {
+		data.Secret = types.StringValue(notification.Secret)
+	} else if data.Secret.IsUnknown() {
+		data.Secret = types.StringNull()
+	}
+}
+
+var _ validator.String = webhookURLValidator{}
+
+// webhookURLValidator checks that a value is an absolute http(s) URL with a host.
+type webhookURLValidator struct{}
+
+func webhookURL() validator.String {
+	return webhookURLValidator{}
+}
+
+func (v webhookURLValidator) Description(ctx context.Context) string {
+	return "value must be an absolute http or https URL"
+}
+
+func (v webhookURLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v webhookURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Webhook URL",
+			fmt.Sprintf("%q must be an absolute http or https URL", value),
+		)
+	}
+}
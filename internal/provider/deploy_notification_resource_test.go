@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+func TestDeployNotificationResource_Schema(t *testing.T) {
+	r := NewDeployNotificationResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Schema attributes should not be nil")
+	}
+
+	secret := resp.Schema.Attributes["secret"].(schema.StringAttribute)
+	if !secret.Sensitive {
+		t.Error("Expected secret to be sensitive")
+	}
+}
+
+func TestDeployNotificationResource_Mapping(t *testing.T) {
+	r := &DeployNotificationResource{}
+
+	events, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"deploy.success", "deploy.failed"})
+	data := &DeployNotificationResourceModel{
+		ID:            types.Int64Unknown(),
+		ApplicationID: types.Int64Value(7),
+		URL:           types.StringValue("https://hooks.slack.com/services/T000/B000/XXX"),
+		Events:        events,
+		Secret:        types.StringValue("signing-key"),
+	}
+
+	expected := &client.DeployNotification{
+		ApplicationID: 7,
+		URL:           "https://hooks.slack.com/services/T000/B000/XXX",
+		Events:        []string{"deploy.success", "deploy.failed"},
+		Secret:        "signing-key",
+	}
+	if notification := r.toAPIModel(data); !reflect.DeepEqual(notification, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, notification)
+	}
+
+	// The API masks the secret and omits the application ID
+	r.fromAPIModel(&client.DeployNotification{
+		ID:     3,
+		URL:    "https://hooks.slack.com/services/T000/B000/XXX",
+		Events: []string{"deploy.success", "deploy.failed"},
+		Secret: "********",
+	}, data)
+
+	if !data.ID.Equal(types.Int64Value(3)) {
+		t.Errorf("Expected ID 3, got %v", data.ID)
+	}
+	if !data.ApplicationID.Equal(types.Int64Value(7)) {
+		t.Errorf("Expected application ID to be preserved, got %v", data.ApplicationID)
+	}
+	if !data.Secret.Equal(types.StringValue("signing-key")) {
+		t.Errorf("Expected configured secret to be preserved, got %v", data.Secret)
+	}
+	if !data.Events.Equal(events) {
+		t.Errorf("Expected events %v, got %v", events, data.Events)
+	}
+}
+
+func TestDeployNotificationResource_Validation(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewDeployNotificationResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	t.Run("url", func(t *testing.T) {
+		attr := resp.Schema.Attributes["url"].(schema.StringAttribute)
+		tests := []struct {
+			value       string
+			expectError bool
+		}{
+			{"https://hooks.slack.com/services/T000/B000/XXX", false},
+			{"http://internal.example.com:8080/deploy", false},
+			{"ftp://example.com/hook", true},
+			{"hooks.slack.com/services", true},
+			{"https://", true},
+			{"not a url", true},
+		}
+
+		for _, tt := range tests {
+			if diags := runStringValidators(t, attr.Validators, tt.value); diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for %q, got diagnostics: %v", tt.expectError, tt.value, diags)
+			}
+		}
+	})
+
+	t.Run("events", func(t *testing.T) {
+		attr := resp.Schema.Attributes["events"].(schema.ListAttribute)
+		tests := []struct {
+			name        string
+			events      []string
+			expectError bool
+		}{
+			{"success and failure", []string{"deploy.success", "deploy.failed"}, false},
+			{"all events", []string{"deploy.started", "deploy.success", "deploy.failed"}, false},
+			{"empty", []string{}, true},
+			{"unknown event", []string{"deploy.succeeded"}, true},
+			{"duplicate", []string{"deploy.failed", "deploy.failed"}, true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				value, _ := types.ListValueFrom(context.Background(), types.StringType, tt.events)
+				var hasError bool
+				for _, v := range attr.Validators {
+					vResp := &validator.ListResponse{}
+					v.ValidateList(context.Background(), validator.ListRequest{Path: path.Root("events"), ConfigValue: value}, vResp)
+					hasError = hasError || vResp.Diagnostics.HasError()
+				}
+				if hasError != tt.expectError {
+					t.Errorf("Expected error %v for %v", tt.expectError, tt.events)
+				}
+			})
+		}
+	})
+}
+
+func TestDeployNotificationResource_Delete_AlreadyDeleted(t *testing.T) {
+	ctx := context.Background()
+
+	status := http.StatusNotFound
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(`{"message": "Notification not found."}`))
+	}))
+	defer server.Close()
+
+	r := &DeployNotificationResource{client: client.NewClient("test-token", &server.URL, client.WithRetriesDisabled())}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	state.Set(ctx, &DeployNotificationResourceModel{
+		ID:            types.Int64Value(3),
+		ApplicationID: types.Int64Value(7),
+		URL:           types.StringValue("https://example.com/hook"),
+		Events:        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("deploy.failed")}),
+		Secret:        types.StringNull(),
+	})
+
+	deleteResp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Errorf("Expected a notification that is already gone to count as deleted, got %v", deleteResp.Diagnostics)
+	}
+
+	// Other failures are still reported
+	status = http.StatusForbidden
+	deleteResp = &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, deleteResp)
+	if !deleteResp.Diagnostics.HasError() {
+		t.Error("Expected a forbidden delete to fail")
+	}
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+var _ datasource.DataSource = &DeploymentLogsDataSource{}
+
+func NewDeploymentLogsDataSource() datasource.DataSource {
+	return &DeploymentLogsDataSource{}
+}
+
+type DeploymentLogsDataSource struct {
+	client *client.Client
+}
+
+type DeploymentLogsDataSourceModel struct {
+	ApplicationID types.Int64  `tfsdk:"application_id"`
+	Logs          types.String `tfsdk:"logs"`
+}
+
+func (d *DeploymentLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_logs"
+}
+
+func (d *DeploymentLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Build and deploy logs of the latest deployment of a Ploi Cloud application, e.g. to write them to a `local_file` when debugging a failed deploy",
+
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Application ID",
+			},
+			"logs": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Raw log text. Holds the output so far while the deployment is still running, and is empty when the application has not been deployed",
+			},
+		},
+	}
+}
+
+func (d *DeploymentLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DeploymentLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, d.client, &resp.Diagnostics)
+
+	var data DeploymentLogsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logs, err := d.client.GetDeploymentLogs(ctx, data.ApplicationID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployment logs, got error: %s", err))
+		return
+	}
+
+	data.Logs = types.StringValue(logs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+func TestDeploymentLogsDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/applications/7/deployments/latest/logs" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte("Cloning repository\nInstalling dependencies\n"))
+	}))
+	defer server.Close()
+
+	d := &DeploymentLogsDataSource{client: client.NewClient("test-token", &server.URL)}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"application_id": tftypes.NewValue(tftypes.Number, 7),
+		"logs":           tftypes.NewValue(tftypes.String, nil),
+	})}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var data DeploymentLogsDataSourceModel
+	resp.State.Get(ctx, &data)
+	if data.Logs.ValueString() != "Cloning repository\nInstalling dependencies\n" {
+		t.Errorf("Expected the logs written so far, got %q", data.Logs.ValueString())
+	}
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+var _ resource.Resource = &DeploymentResource{}
+
+func NewDeploymentResource() resource.Resource {
+	return &DeploymentResource{}
+}
+
+type DeploymentResource struct {
+	client *client.Client
+}
+
+type DeploymentResourceModel struct {
+	ID                types.Int64    `tfsdk:"id"`
+	ApplicationID     types.Int64    `tfsdk:"application_id"`
+	Triggers          types.Map      `tfsdk:"triggers"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	DeploymentStatus  types.String   `tfsdk:"deployment_status"`
+	CompletedAt       types.String   `tfsdk:"completed_at"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Triggers a deployment of a Ploi Cloud application. A new deployment is started whenever `application_id` or any value in `triggers` changes; destroying the resource does not undo the deployment",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Deployment ID, null when the API did not report the deployment it started",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Application ID to deploy",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that start a new deployment when they change, e.g. a hash of a configuration file",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Wait until the deployment has finished and fail when it does not succeed (default false)",
+			},
+			"deployment_status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Last known status of the deployment",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"completed_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the deployment finished (RFC 3339), null while it is still running",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *DeploymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
+	var data DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultDeploymentTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationID := data.ApplicationID.ValueInt64()
+	deployment, err := r.client.DeployApplication(ctx, applicationID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deploy application %d, got error: %s", applicationID, err))
+		return
+	}
+
+	// The API accepted the deployment without reporting it, so there is
+	// nothing to follow
+	if deployment == nil {
+		data.ID = types.Int64Null()
+		data.DeploymentStatus = types.StringValue("accepted")
+		data.CompletedAt = types.StringNull()
+		if data.WaitForCompletion.ValueBool() {
+			resp.Diagnostics.AddWarning("Deploy Warning", fmt.Sprintf("The deployment of application %d was started, but the API did not return it so it cannot be waited for", applicationID))
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	if data.WaitForCompletion.ValueBool() {
+		finished, err := r.client.WaitForDeployment(ctx, applicationID, deployment.ID, createTimeout)
+		if finished != nil {
+			deployment = finished
+		}
+		if err != nil {
+			resp.Diagnostics.Append(waitErrorDiagnostics("Deployment Failed", fmt.Sprintf("Deployment %d of application %d did not succeed", deployment.ID, applicationID), err)...)
+		}
+	}
+
+	r.fromAPIModel(deployment, &data)
+
+	// Saved even when the wait failed so state shows the outcome. The error
+	// taints the resource, so the next apply starts a new deployment.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
+	var data DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsNull() || data.ID.IsUnknown() {
+		return
+	}
+
+	deployment, err := r.client.GetDeployment(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployment, got error: %s", err))
+		return
+	}
+
+	// A deployment that has been pruned from the history keeps its last known
+	// state; removing it would start a new deployment on the next apply
+	if deployment == nil {
+		return
+	}
+
+	r.fromAPIModel(deployment, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
+	var data DeploymentResourceModel
+	var state DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only wait_for_completion and timeouts can change in place, neither of
+	// which starts a deployment
+	data.ID = state.ID
+	data.DeploymentStatus = state.DeploymentStatus
+	data.CompletedAt = state.CompletedAt
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
+	// A deployment cannot be undone, destroying only removes it from state
+}
+
+func (r *DeploymentResource) fromAPIModel(deployment *client.Deployment, data *DeploymentResourceModel) {
+	data.ID = types.Int64Value(deployment.ID)
+
+	if deployment.Status != "" {
+		data.DeploymentStatus = types.StringValue(deployment.Status)
+	} else if data.DeploymentStatus.IsUnknown() {
+		data.DeploymentStatus = types.StringNull()
+	}
+
+	if deployment.CompletedAt != nil {
+		data.CompletedAt = types.StringValue(deployment.CompletedAt.Format(time.RFC3339))
+	} else {
+		data.CompletedAt = types.StringNull()
+	}
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+func TestDeploymentResource_Schema(t *testing.T) {
+	r := NewDeploymentResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	for _, name := range []string{"id", "deployment_status", "completed_at"} {
+		if !resp.Schema.Attributes[name].IsComputed() {
+			t.Errorf("%s should be computed", name)
+		}
+	}
+
+	if attr, ok := resp.Schema.Attributes["application_id"].(schema.Int64Attribute); !ok || !attr.Required || len(attr.PlanModifiers) != 1 {
+		t.Error("application_id should be required and replace the deployment")
+	}
+	if attr, ok := resp.Schema.Attributes["triggers"].(schema.MapAttribute); !ok || !attr.Optional || len(attr.PlanModifiers) != 1 {
+		t.Error("triggers should be optional and replace the deployment")
+	}
+}
+
+func deploymentTestModel(triggers map[string]string, wait bool) *DeploymentResourceModel {
+	triggerValues := make(map[string]attr.Value, len(triggers))
+	for key, value := range triggers {
+		triggerValues[key] = types.StringValue(value)
+	}
+
+	return &DeploymentResourceModel{
+		ID:                types.Int64Unknown(),
+		ApplicationID:     types.Int64Value(7),
+		Triggers:          types.MapValueMust(types.StringType, triggerValues),
+		WaitForCompletion: types.BoolValue(wait),
+		DeploymentStatus:  types.StringUnknown(),
+		CompletedAt:       types.StringUnknown(),
+		Timeouts: timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+		})},
+	}
+}
+
+// TestDeploymentResource_TriggerChangeRedeploys walks through the lifecycle
+// Terraform drives: changed triggers replace the resource, which deploys
+// again, while in-place updates never reach the deploy endpoint
+func TestDeploymentResource_TriggerChangeRedeploys(t *testing.T) {
+	ctx := context.Background()
+
+	deployCalls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/applications/7/deploy":
+			deployCalls++
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprintf(w, `{"data": {"id": %d, "application_id": 7, "status": "queued"}}`, 100+deployCalls)
+		case r.Method == http.MethodGet && r.URL.Path == fmt.Sprintf("/applications/7/deployments/%d", 100+deployCalls):
+			fmt.Fprintf(w, `{"data": {"id": %d, "application_id": 7, "status": "success", "completed_at": "2026-01-02T03:04:05Z"}}`, 100+deployCalls)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &DeploymentResource{client: client.NewClient("test-token", &server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	create := func(model *DeploymentResourceModel) DeploymentResourceModel {
+		t.Helper()
+
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+		plan.Set(ctx, model)
+
+		resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+		r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+		}
+
+		var result DeploymentResourceModel
+		resp.State.Get(ctx, &result)
+		return result
+	}
+
+	requiresReplace := func(state DeploymentResourceModel, plan *DeploymentResourceModel) bool {
+		t.Helper()
+
+		stateValue := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+		stateValue.Set(ctx, &state)
+		planValue := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+		planValue.Set(ctx, plan)
+
+		triggers := schemaResp.Schema.Attributes["triggers"].(schema.MapAttribute)
+		resp := &planmodifier.MapResponse{PlanValue: plan.Triggers}
+		for _, modifier := range triggers.PlanModifiers {
+			modifier.PlanModifyMap(ctx, planmodifier.MapRequest{
+				State:       stateValue,
+				StateValue:  state.Triggers,
+				Plan:        planValue,
+				PlanValue:   plan.Triggers,
+				ConfigValue: plan.Triggers,
+			}, resp)
+		}
+		return resp.RequiresReplace
+	}
+
+	first := create(deploymentTestModel(map[string]string{"config": "hash-a"}, false))
+	if deployCalls != 1 {
+		t.Fatalf("Expected 1 deploy call after create, got %d", deployCalls)
+	}
+	if first.ID.ValueInt64() != 101 || first.DeploymentStatus.ValueString() != "queued" || !first.CompletedAt.IsNull() {
+		t.Errorf("Unexpected state after create: %+v", first)
+	}
+
+	// Unchanged triggers with only wait_for_completion toggled update in place
+	unchanged := deploymentTestModel(map[string]string{"config": "hash-a"}, true)
+	if requiresReplace(first, unchanged) {
+		t.Fatal("Expected unchanged triggers not to replace the deployment")
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	plan.Set(ctx, unchanged)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	state.Set(ctx, &first)
+	updateResp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", updateResp.Diagnostics)
+	}
+	if deployCalls != 1 {
+		t.Errorf("Expected an in-place update not to deploy, got %d deploy calls", deployCalls)
+	}
+	var updated DeploymentResourceModel
+	updateResp.State.Get(ctx, &updated)
+	if updated.ID.ValueInt64() != 101 {
+		t.Errorf("Expected the update to keep deployment 101, got %v", updated.ID)
+	}
+
+	// Changed triggers replace the resource, which deploys again
+	changed := deploymentTestModel(map[string]string{"config": "hash-b"}, true)
+	if !requiresReplace(updated, changed) {
+		t.Fatal("Expected changed triggers to replace the deployment")
+	}
+	second := create(changed)
+	if deployCalls != 2 {
+		t.Fatalf("Expected 2 deploy calls after the triggers changed, got %d", deployCalls)
+	}
+	if second.ID.ValueInt64() != 102 || second.DeploymentStatus.ValueString() != "success" || second.CompletedAt.ValueString() != "2026-01-02T03:04:05Z" {
+		t.Errorf("Expected the waited deployment to be complete, got %+v", second)
+	}
+}
+
+func TestDeploymentResource_WaitFailure(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			fmt.Fprint(w, `{"data": {"id": 5, "application_id": 7, "status": "queued"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"id": 5, "application_id": 7, "status": "failed", "completed_at": "2026-01-02T03:04:05Z"}}`)
+	}))
+	defer server.Close()
+
+	r := &DeploymentResource{client: client.NewClient("test-token", &server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	plan.Set(ctx, deploymentTestModel(nil, true))
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Deployment Failed" {
+		t.Fatalf("Expected a Deployment Failed error, got %v", resp.Diagnostics)
+	}
+
+	// The failed deployment is still recorded so it is not retried on the
+	// next apply
+	var result DeploymentResourceModel
+	resp.State.Get(ctx, &result)
+	if result.ID.ValueInt64() != 5 || result.DeploymentStatus.ValueString() != "failed" {
+		t.Errorf("Expected the failed deployment in state, got %+v", result)
+	}
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// attributeDeprecation describes a schema attribute that still works but has
+// been superseded, and what to configure instead
+type attributeDeprecation struct {
+	Path        path.Path
+	Replacement string
+}
+
+// Message explains the deprecation to users, both in the schema description
+// and in the plan-time warning
+func (d attributeDeprecation) Message() string {
+	return fmt.Sprintf("%s is deprecated and will be removed in a future release. %s", d.Path, d.Replacement)
+}
+
+// Description appends the deprecation to an attribute's markdown description
+func (d attributeDeprecation) Description(description string) string {
+	return fmt.Sprintf("%s. **Deprecated**: %s", description, d.Replacement)
+}
+
+// startCommandDeprecation supersedes start_command with the command of the
+// web process
+var startCommandDeprecation = attributeDeprecation{
+	Path:        path.Root("start_command"),
+	Replacement: "Add a `processes` block with a `web` process and set its command instead.",
+}
+
+// applicationDeprecations lists the deprecated attributes of the application
+// resource
+var applicationDeprecations = []attributeDeprecation{
+	startCommandDeprecation,
+}
+
+// deprecatedAttributeDiagnostics warns about every deprecated attribute set in
+// config. Unknown values count as set since they will be known at apply.
+func deprecatedAttributeDiagnostics(ctx context.Context, config tfsdk.Config, deprecations []attributeDeprecation) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, deprecation := range deprecations {
+		var value attr.Value
+		diags.Append(config.GetAttribute(ctx, deprecation.Path, &value)...)
+		if value == nil || value.IsNull() {
+			continue
+		}
+		diags.AddAttributeWarning(deprecation.Path, "Deprecated Attribute", deprecation.Message())
+	}
+
+	return diags
+}
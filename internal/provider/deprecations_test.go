@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestApplicationResource_DeprecatedAttributes(t *testing.T) {
+	ctx := context.Background()
+	r := &ApplicationResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	tests := []struct {
+		name          string
+		startCommand  tftypes.Value
+		expectWarning bool
+	}{
+		{"set", tftypes.NewValue(tftypes.String, "php artisan serve"), true},
+		{"unknown", tftypes.NewValue(tftypes.String, tftypes.UnknownValue), true},
+		{"unset", tftypes.NewValue(tftypes.String, nil), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := map[string]tftypes.Value{}
+			for name, attrType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attrType, nil)
+			}
+			values["name"] = tftypes.NewValue(tftypes.String, "app")
+			values["type"] = tftypes.NewValue(tftypes.String, "laravel")
+			values["start_command"] = tt.startCommand
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+			}
+			warned := false
+			for _, d := range resp.Diagnostics.Warnings() {
+				if d.Summary() == "Deprecated Attribute" && strings.Contains(d.Detail(), "start_command is deprecated") && strings.Contains(d.Detail(), "web") {
+					warned = true
+				}
+			}
+			if warned != tt.expectWarning {
+				t.Errorf("Expected deprecation warning %v, got diagnostics: %v", tt.expectWarning, resp.Diagnostics)
+			}
+		})
+	}
+
+	description := schemaResp.Schema.Attributes["start_command"].GetMarkdownDescription()
+	if !strings.Contains(description, "**Deprecated**") {
+		t.Errorf("Expected start_command description to mention the deprecation, got %q", description)
+	}
+}
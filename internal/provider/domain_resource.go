@@ -3,12 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/ploi/terraform-provider-ploicloud/internal/client"
 )
@@ -43,14 +44,23 @@ func (r *DomainResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"id": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "Domain ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"application_id": schema.Int64Attribute{
 				Required:            true,
 				MarkdownDescription: "Application ID this domain belongs to",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"domain": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "Domain name (e.g., example.com)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"ssl_status": schema.StringAttribute{
 				Computed:            true,
@@ -78,6 +88,8 @@ func (r *DomainResource) Configure(ctx context.Context, req resource.ConfigureRe
 }
 
 func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data DomainResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -87,7 +99,7 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	domain := r.toAPIModel(&data)
 
-	created, err := r.client.CreateDomain(domain)
+	created, err := r.client.CreateDomain(ctx, domain)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create domain, got error: %s", err))
 		return
@@ -99,6 +111,8 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data DomainResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -106,7 +120,7 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	domain, err := r.client.GetDomain(data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
+	domain, err := r.client.GetDomain(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read domain, got error: %s", err))
 		return
@@ -123,6 +137,8 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data DomainResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -134,6 +150,8 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data DomainResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -141,29 +159,18 @@ func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteDomain(data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
-	if err != nil {
+	err := r.client.DeleteDomain(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
+	// A domain that is already gone counts as deleted
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete domain, got error: %s", err))
 		return
 	}
 }
 
 func (r *DomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ".")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Invalid Import ID", "Import ID must be in the format 'application_id.domain_id'")
-		return
-	}
-
-	applicationID, err := strconv.ParseInt(parts[0], 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid Import ID", "Application ID must be a valid integer")
-		return
-	}
-
-	domainID, err := strconv.ParseInt(parts[1], 10, 64)
+	applicationID, domainID, err := parseImportID(req.ID, "Domain")
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid Import ID", "Domain ID must be a valid integer")
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
 		return
 	}
 
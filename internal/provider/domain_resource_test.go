@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+// domainTestServer mocks the domain endpoints of application 1. Created
+// domains start with a pending certificate.
+func domainTestServer(t *testing.T, domains map[int64]client.ApplicationDomain) *httptest.Server {
+	nextID := int64(50)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var id int64
+		fmt.Sscanf(r.URL.Path, "/applications/1/domains/%d", &id)
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/applications/1/domains":
+			var domain client.ApplicationDomain
+			json.NewDecoder(r.Body).Decode(&domain)
+			domain.ID = nextID
+			domain.SSLStatus = "pending"
+			domains[domain.ID] = domain
+			nextID++
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(client.SingleResponse[client.ApplicationDomain]{Data: domain})
+		case r.Method == http.MethodGet && id != 0:
+			domain, ok := domains[id]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(client.SingleResponse[client.ApplicationDomain]{Data: domain})
+		case r.Method == http.MethodDelete && id != 0:
+			delete(domains, id)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestDomainResource_CRUD(t *testing.T) {
+	ctx := context.Background()
+
+	domains := map[int64]client.ApplicationDomain{}
+	server := domainTestServer(t, domains)
+	defer server.Close()
+
+	r := &DomainResource{client: client.NewClient("test-token", &server.URL, client.WithRetriesDisabled())}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	plan.Set(ctx, &DomainResourceModel{
+		ID:            types.Int64Unknown(),
+		ApplicationID: types.Int64Value(1),
+		Domain:        types.StringValue("shop.example.com"),
+		SSLStatus:     types.StringUnknown(),
+	})
+	stateModel := func(state tfsdk.State) DomainResourceModel {
+		var data DomainResourceModel
+		state.Get(ctx, &data)
+		return data
+	}
+
+	// Create
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected create errors: %v", createResp.Diagnostics)
+	}
+	created := stateModel(createResp.State)
+	if created.ID.ValueInt64() != 50 || created.SSLStatus.ValueString() != "pending" {
+		t.Errorf("Expected domain 50 with a pending certificate, got %+v", created)
+	}
+
+	// Read picks up the certificate status once it has been issued
+	domain := domains[50]
+	domain.SSLStatus = "active"
+	domains[50] = domain
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected read errors: %v", readResp.Diagnostics)
+	}
+	read := stateModel(readResp.State)
+	if !read.SSLStatus.Equal(types.StringValue("active")) || !read.Domain.Equal(types.StringValue("shop.example.com")) {
+		t.Errorf("Expected the active domain after read, got %+v", read)
+	}
+
+	// Delete
+	deleteResp := &resource.DeleteResponse{State: readResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: readResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected delete errors: %v", deleteResp.Diagnostics)
+	}
+	if len(domains) != 0 {
+		t.Errorf("Expected the domain to be deleted, got %+v", domains)
+	}
+
+	// Read after delete removes the resource from state
+	goneResp := &resource.ReadResponse{State: readResp.State}
+	r.Read(ctx, resource.ReadRequest{State: readResp.State}, goneResp)
+	if goneResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected read errors: %v", goneResp.Diagnostics)
+	}
+	if !goneResp.State.Raw.IsNull() {
+		t.Error("Expected the deleted domain to be removed from state")
+	}
+}
+
+func TestDomainResource_Delete_AlreadyDeleted(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "Domain not found."}`))
+	}))
+	defer server.Close()
+
+	r := &DomainResource{client: client.NewClient("test-token", &server.URL, client.WithRetriesDisabled())}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	state.Set(ctx, &DomainResourceModel{
+		ID:            types.Int64Value(50),
+		ApplicationID: types.Int64Value(1),
+		Domain:        types.StringValue("shop.example.com"),
+		SSLStatus:     types.StringValue("active"),
+	})
+
+	deleteResp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Errorf("Expected a domain that is already gone to count as deleted, got %v", deleteResp.Diagnostics)
+	}
+}
+
+func TestDomainResource_Schema(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewDomainResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	if domain := resp.Schema.Attributes["domain"].(schema.StringAttribute); len(domain.PlanModifiers) == 0 {
+		t.Error("Expected changing domain to replace the domain")
+	}
+	if applicationID := resp.Schema.Attributes["application_id"].(schema.Int64Attribute); len(applicationID.PlanModifiers) == 0 {
+		t.Error("Expected changing application_id to replace the domain")
+	}
+	if sslStatus := resp.Schema.Attributes["ssl_status"].(schema.StringAttribute); !sslStatus.Computed {
+		t.Error("Expected ssl_status to be computed")
+	}
+}
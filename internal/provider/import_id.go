@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseImportID parses an "application_id.<child>_id" import ID, as exposed by
+// the ploicloud_application data source. "application_id:<child>_id" is
+// accepted as well. childName is the human readable name
+// of the child resource (e.g. "Service") used in error messages.
+func parseImportID(id string, childName string) (int64, int64, error) {
+	applicationID, child, err := splitImportID(id, strings.ToLower(childName)+"_id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	childID, err := strconv.ParseInt(child, 10, 64)
+	if err != nil || childID <= 0 {
+		return 0, 0, fmt.Errorf("%s ID must be a valid integer", childName)
+	}
+
+	return applicationID, childID, nil
+}
+
+// parseImportIDWithKey parses an "application_id.<key>" import ID where the
+// child is identified by a string key rather than a numeric ID.
+func parseImportIDWithKey(id string, keyName string) (int64, string, error) {
+	return splitImportID(id, keyName)
+}
+
+func splitImportID(id string, childFormat string) (int64, string, error) {
+	id = strings.TrimSpace(id)
+	separator := "."
+	if strings.Contains(id, ":") {
+		separator = ":"
+	}
+
+	parts := strings.Split(id, separator)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return 0, "", fmt.Errorf("Import ID must be in the format 'application_id.%s' or 'application_id:%s', got %q", childFormat, childFormat, id)
+	}
+
+	applicationID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || applicationID <= 0 {
+		return 0, "", fmt.Errorf("Application ID must be a valid integer")
+	}
+
+	return applicationID, parts[1], nil
+}
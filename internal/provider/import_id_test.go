@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestParseImportID(t *testing.T) {
+	tests := []struct {
+		id              string
+		expectedAppID   int64
+		expectedChildID int64
+		errorContains   string
+	}{
+		{id: "12.34", expectedAppID: 12, expectedChildID: 34},
+		{id: " 12.34 ", expectedAppID: 12, expectedChildID: 34},
+		{id: "12:34", expectedAppID: 12, expectedChildID: 34},
+		{id: "12:34:56", errorContains: "format 'application_id.service_id'"},
+		{id: "12:", errorContains: "format 'application_id.service_id' or 'application_id:service_id'"},
+		{id: "12:abc", errorContains: "Service ID must be a valid integer"},
+		{id: "abc:34", errorContains: "Application ID must be a valid integer"},
+		{id: "12", errorContains: "format 'application_id.service_id'"},
+		{id: "12.34.56", errorContains: "format 'application_id.service_id'"},
+		{id: ".34", errorContains: "format 'application_id.service_id'"},
+		{id: "12.", errorContains: "format 'application_id.service_id'"},
+		{id: "abc.34", errorContains: "Application ID must be a valid integer"},
+		{id: "0.34", errorContains: "Application ID must be a valid integer"},
+		{id: "12.abc", errorContains: "Service ID must be a valid integer"},
+		{id: "12.-1", errorContains: "Service ID must be a valid integer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			appID, childID, err := parseImportID(tt.id, "Service")
+			if tt.errorContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errorContains) {
+					t.Fatalf("Expected error containing %q, got %v", tt.errorContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if appID != tt.expectedAppID || childID != tt.expectedChildID {
+				t.Errorf("Expected %d.%d, got %d.%d", tt.expectedAppID, tt.expectedChildID, appID, childID)
+			}
+		})
+	}
+}
+
+func TestParseImportIDWithKey(t *testing.T) {
+	appID, key, err := parseImportIDWithKey("12.APP_KEY", "secret_key")
+	if err != nil || appID != 12 || key != "APP_KEY" {
+		t.Errorf("Expected 12/APP_KEY, got %d/%s (error %v)", appID, key, err)
+	}
+
+	for _, id := range []string{"12", "12.", "x.APP_KEY", "12.APP.KEY"} {
+		if _, _, err := parseImportIDWithKey(id, "secret_key"); err == nil {
+			t.Errorf("Expected error for %q", id)
+		}
+	}
+}
+
+// TestImportState_CompositeIDs imports every sub-resource with the composite
+// ID format surfaced by the ploicloud_application data source.
+func TestImportState_CompositeIDs(t *testing.T) {
+	tests := []struct {
+		name          string
+		resource      resource.ResourceWithImportState
+		id            string
+		childPath     path.Path
+		expectedChild interface{}
+	}{
+		{"service", &ServiceResource{}, "12.30", path.Root("id"), int64(30)},
+		{"service with colon", &ServiceResource{}, "12:30", path.Root("id"), int64(30)},
+		{"volume", &VolumeResource{}, "12.40", path.Root("id"), int64(40)},
+		{"volume with colon", &VolumeResource{}, "12:40", path.Root("id"), int64(40)},
+		{"domain", &DomainResource{}, "12.50", path.Root("id"), int64(50)},
+		{"domain with colon", &DomainResource{}, "12:50", path.Root("id"), int64(50)},
+		{"worker", &WorkerResource{}, "12.60", path.Root("id"), int64(60)},
+		{"worker with colon", &WorkerResource{}, "12:60", path.Root("id"), int64(60)},
+		{"deploy notification", &DeployNotificationResource{}, "12.70", path.Root("id"), int64(70)},
+		{"secret", &SecretResource{}, "12.APP_KEY", path.Root("key"), "APP_KEY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			schemaResp := &resource.SchemaResponse{}
+			tt.resource.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			newResp := func() *resource.ImportStateResponse {
+				return &resource.ImportStateResponse{
+					State: tfsdk.State{
+						Schema: schemaResp.Schema,
+						Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+					},
+				}
+			}
+
+			resp := newResp()
+			tt.resource.ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected import error: %v", resp.Diagnostics)
+			}
+
+			var appID int64
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("application_id"), &appID)...)
+			if appID != 12 {
+				t.Errorf("Expected application_id 12, got %d", appID)
+			}
+
+			switch expected := tt.expectedChild.(type) {
+			case int64:
+				var child int64
+				resp.State.GetAttribute(ctx, tt.childPath, &child)
+				if child != expected {
+					t.Errorf("Expected %s %d, got %d", tt.childPath, expected, child)
+				}
+			case string:
+				var child string
+				resp.State.GetAttribute(ctx, tt.childPath, &child)
+				if child != expected {
+					t.Errorf("Expected %s %s, got %s", tt.childPath, expected, child)
+				}
+			}
+
+			// Malformed IDs are rejected
+			resp = newResp()
+			tt.resource.ImportState(ctx, resource.ImportStateRequest{ID: "12"}, resp)
+			if !resp.Diagnostics.HasError() {
+				t.Error("Expected an error for an ID without child part")
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -104,7 +105,7 @@ func TestIntegration_AllEnhancedFeatures(t *testing.T) {
 		StartCommand: "php artisan octane:start --host=0.0.0.0",
 	}
 	
-	createdApp, err := c.CreateApplication(app)
+	createdApp, err := c.CreateApplication(context.Background(), app)
 	if err != nil {
 		t.Fatalf("Failed to create application: %v", err)
 	}
@@ -124,7 +125,7 @@ func TestIntegration_AllEnhancedFeatures(t *testing.T) {
 		Extensions:    []string{"uuid-ossp", "pgcrypto"},
 	}
 	
-	createdService, err := c.CreateService(service)
+	createdService, err := c.CreateService(context.Background(), service)
 	if err != nil {
 		t.Fatalf("Failed to create service: %v", err)
 	}
@@ -150,7 +151,7 @@ func TestIntegration_AllEnhancedFeatures(t *testing.T) {
 		CPURequest:    "250m",
 	}
 	
-	createdWorker, err := c.CreateWorker(worker)
+	createdWorker, err := c.CreateWorker(context.Background(), worker)
 	if err != nil {
 		t.Fatalf("Failed to create worker: %v", err)
 	}
@@ -174,7 +175,7 @@ func TestIntegration_AllEnhancedFeatures(t *testing.T) {
 		StorageClass:  "fast-ssd",
 	}
 	
-	createdVolume, err := c.CreateVolume(volume)
+	createdVolume, err := c.CreateVolume(context.Background(), volume)
 	if err != nil {
 		t.Fatalf("Failed to create volume: %v", err)
 	}
@@ -476,7 +477,7 @@ func TestIntegration_ErrorHandling(t *testing.T) {
 		StorageSize:   "bad-size",
 	}
 	
-	_, err := c.CreateService(service)
+	_, err := c.CreateService(context.Background(), service)
 	if err == nil {
 		t.Error("Expected error for invalid service fields, got nil")
 	}
@@ -490,7 +491,7 @@ func TestIntegration_ErrorHandling(t *testing.T) {
 		MemoryRequest: "bad-memory",
 	}
 	
-	_, err = c.CreateWorker(worker)
+	_, err = c.CreateWorker(context.Background(), worker)
 	if err == nil {
 		t.Error("Expected error for invalid worker fields, got nil")
 	}
@@ -504,7 +505,7 @@ func TestIntegration_ErrorHandling(t *testing.T) {
 		StorageClass:  "invalid-class",
 	}
 	
-	_, err = c.CreateVolume(volume)
+	_, err = c.CreateVolume(context.Background(), volume)
 	if err == nil {
 		t.Error("Expected error for invalid volume fields, got nil")
 	}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = ipAddressValidator{}
+
+// ipAddressValidator checks that a value is a single IPv4 or IPv6 address
+// such as "10.0.0.5" or "2001:db8::5".
+type ipAddressValidator struct{}
+
+func ipAddress() validator.String {
+	return ipAddressValidator{}
+}
+
+func (v ipAddressValidator) Description(ctx context.Context) string {
+	return "value must be an IP address such as 10.0.0.5 or 2001:db8::5"
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if net.ParseIP(value) == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("%q is not a valid IP address (e.g. 10.0.0.5 or 2001:db8::5)", value),
+		)
+	}
+}
@@ -2,12 +2,19 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/ploi/terraform-provider-ploicloud/internal/client"
 )
@@ -19,8 +26,16 @@ type PloiCloudProvider struct {
 }
 
 type PloiCloudProviderModel struct {
-	ApiToken    types.String `tfsdk:"api_token"`
-	ApiEndpoint types.String `tfsdk:"api_endpoint"`
+	ApiToken         types.String `tfsdk:"api_token"`
+	ApiEndpoint      types.String `tfsdk:"api_endpoint"`
+	DisableRetries   types.Bool   `tfsdk:"disable_retries"`
+	RequestTimeout   types.Int64  `tfsdk:"request_timeout"`
+	MaxRetries       types.Int64  `tfsdk:"max_retries"`
+	RetryBackoff     types.String `tfsdk:"retry_backoff"`
+	RetryJitter      types.Bool   `tfsdk:"retry_jitter"`
+	DisableReadCache types.Bool   `tfsdk:"disable_read_cache"`
+	ExtraHeaders     types.Map    `tfsdk:"extra_headers"`
+	MaxIdleConns     types.Int64  `tfsdk:"max_idle_conns"`
 }
 
 func (p *PloiCloudProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -38,9 +53,57 @@ func (p *PloiCloudProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Sensitive:           true,
 			},
 			"api_endpoint": schema.StringAttribute{
-				MarkdownDescription: "The API endpoint for Ploi Cloud. Defaults to https://cloud.ploi.io/api/v1.",
+				MarkdownDescription: "The API endpoint for Ploi Cloud. Can also be set with the PLOI_API_ENDPOINT environment variable. Defaults to https://cloud.ploi.io/api/v1.",
 				Optional:            true,
 			},
+			"disable_retries": schema.BoolAttribute{
+				MarkdownDescription: "Send every API request exactly once, without retrying server errors or network failures. Intended for test environments that mock the API.",
+				Optional:            true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Seconds a single API request may take before it is aborted. Defaults to 30.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "How often a request is retried after a server error, network failure or rate limit (429). Other client errors are never retried. Defaults to 3.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
+			},
+			"retry_backoff": schema.StringAttribute{
+				MarkdownDescription: "How the wait between retries grows: `linear` (1s, 2s, 3s, ...) or `exponential` (1s, 2s, 4s, ... capped at 30s). Defaults to `linear`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(client.BackoffLinear), string(client.BackoffExponential)),
+				},
+			},
+			"retry_jitter": schema.BoolAttribute{
+				MarkdownDescription: "Randomize every wait between half and the full backoff so concurrent runs do not retry in lockstep.",
+				Optional:            true,
+			},
+			"disable_read_cache": schema.BoolAttribute{
+				MarkdownDescription: "Send every read with `Cache-Control: no-cache` so proxies or gateways in front of the API cannot answer with stale data. Useful to force a full reconcile when state and reality diverged.",
+				Optional:            true,
+			},
+			"extra_headers": schema.MapAttribute{
+				MarkdownDescription: "Additional headers sent with every API request, e.g. an `X-Org-Id` required by a gateway in front of the API. `Authorization`, `Content-Type`, `Accept` and `User-Agent` are set by the provider and cannot be overridden.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.NoneOfCaseInsensitive(client.ReservedHeaders...)),
+				},
+			},
+			"max_idle_conns": schema.Int64Attribute{
+				MarkdownDescription: "How many idle connections to the API are kept open for reuse. Raise it along with `-parallelism` when managing many resources. Defaults to 10.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -68,9 +131,54 @@ func (p *PloiCloudProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	apiEndpoint := config.ApiEndpoint.ValueStringPointer()
+	// An empty endpoint falls back to the client default
+	apiEndpoint := os.Getenv("PLOI_API_ENDPOINT")
+	if !config.ApiEndpoint.IsNull() {
+		apiEndpoint = config.ApiEndpoint.ValueString()
+	}
+
+	opts := []client.ClientOption{client.WithProviderVersion(p.version)}
+	if config.DisableRetries.ValueBool() {
+		opts = append(opts, client.WithRetriesDisabled())
+	}
+	if !config.RequestTimeout.IsNull() && !config.RequestTimeout.IsUnknown() {
+		timeout := config.RequestTimeout.ValueInt64()
+		if timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				fmt.Sprintf("request_timeout must be greater than zero, got %d", timeout),
+			)
+			return
+		}
+		opts = append(opts, client.WithRequestTimeout(time.Duration(timeout)*time.Second))
+	}
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		opts = append(opts, client.WithMaxRetries(int(config.MaxRetries.ValueInt64())))
+	}
+	if !config.RetryBackoff.IsNull() && !config.RetryBackoff.IsUnknown() {
+		opts = append(opts, client.WithBackoffStrategy(client.BackoffStrategy(config.RetryBackoff.ValueString())))
+	}
+	if config.RetryJitter.ValueBool() {
+		opts = append(opts, client.WithBackoffJitter())
+	}
+	if config.DisableReadCache.ValueBool() {
+		opts = append(opts, client.WithReadCacheDisabled())
+	}
+	if !config.ExtraHeaders.IsNull() && !config.ExtraHeaders.IsUnknown() {
+		headers := make(map[string]string, len(config.ExtraHeaders.Elements()))
+		resp.Diagnostics.Append(config.ExtraHeaders.ElementsAs(ctx, &headers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		opts = append(opts, client.WithExtraHeaders(headers))
+	}
+
+	if !config.MaxIdleConns.IsNull() && !config.MaxIdleConns.IsUnknown() {
+		opts = append(opts, client.WithMaxIdleConns(int(config.MaxIdleConns.ValueInt64())))
+	}
 
-	client := client.NewClient(apiToken, apiEndpoint)
+	client := client.NewClient(apiToken, &apiEndpoint, opts...)
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -84,13 +192,24 @@ func (p *PloiCloudProvider) Resources(ctx context.Context) []func() resource.Res
 		NewSecretResource,
 		NewVolumeResource,
 		NewWorkerResource,
+		NewDeployNotificationResource,
+		NewDeploymentResource,
 	}
 }
 
 func (p *PloiCloudProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewApplicationDataSource,
+		NewApplicationsDataSource,
 		NewTeamDataSource,
+		NewApplicationMetricsDataSource,
+		NewApplicationHTTPMetricsDataSource,
+		NewServiceDataSource,
+		NewApplicationTopologyDataSource,
+		NewRuntimeVersionsDataSource,
+		NewApplicationTemplatesDataSource,
+		NewDeploymentLogsDataSource,
+		NewConnectionStringDataSource,
 	}
 }
 
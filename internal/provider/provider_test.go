@@ -1,10 +1,25 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
 )
 
 const (
@@ -20,4 +35,358 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 }
 
 func testAccPreCheck(t *testing.T) {
-}
\ No newline at end of file
+}
+
+// runStringValidators runs the given schema validators against a single value
+// and returns the collected diagnostics.
+func runStringValidators(t *testing.T, validators []validator.String, value string) diag.Diagnostics {
+	t.Helper()
+
+	var diags diag.Diagnostics
+	for _, v := range validators {
+		req := validator.StringRequest{
+			Path:        path.Root("test"),
+			ConfigValue: types.StringValue(value),
+		}
+		resp := &validator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+		diags.Append(resp.Diagnostics...)
+	}
+	return diags
+}
+
+// runInt64Validators runs the given schema validators against a single value
+// and returns the collected diagnostics.
+func runInt64Validators(t *testing.T, validators []validator.Int64, value int64) diag.Diagnostics {
+	t.Helper()
+
+	var diags diag.Diagnostics
+	for _, v := range validators {
+		req := validator.Int64Request{
+			Path:        path.Root("test"),
+			ConfigValue: types.Int64Value(value),
+		}
+		resp := &validator.Int64Response{}
+		v.ValidateInt64(context.Background(), req, resp)
+		diags.Append(resp.Diagnostics...)
+	}
+	return diags
+}
+
+// runFloat64Validators runs the given schema validators against a single value
+// and returns the collected diagnostics.
+func runFloat64Validators(t *testing.T, validators []validator.Float64, value float64) diag.Diagnostics {
+	t.Helper()
+
+	var diags diag.Diagnostics
+	for _, v := range validators {
+		req := validator.Float64Request{
+			Path:        path.Root("test"),
+			ConfigValue: types.Float64Value(value),
+		}
+		resp := &validator.Float64Response{}
+		v.ValidateFloat64(context.Background(), req, resp)
+		diags.Append(resp.Diagnostics...)
+	}
+	return diags
+}
+
+func TestProvider_Configure_RequestTimeout(t *testing.T) {
+	p := &PloiCloudProvider{}
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+	configure := func(timeout tftypes.Value) *provider.ConfigureResponse {
+		objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["api_token"] = tftypes.NewValue(tftypes.String, "test-token")
+		values["request_timeout"] = timeout
+		raw := tftypes.NewValue(objectType, values)
+
+		resp := &provider.ConfigureResponse{}
+		p.Configure(context.Background(), provider.ConfigureRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw},
+		}, resp)
+		return resp
+	}
+
+	tests := []struct {
+		name            string
+		timeout         tftypes.Value
+		expectError     bool
+		expectedTimeout time.Duration
+	}{
+		{"default", tftypes.NewValue(tftypes.Number, nil), false, client.DefaultRequestTimeout},
+		{"configured", tftypes.NewValue(tftypes.Number, 120), false, 120 * time.Second},
+		{"zero", tftypes.NewValue(tftypes.Number, 0), true, 0},
+		{"negative", tftypes.NewValue(tftypes.Number, -5), true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := configure(tt.timeout)
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Fatalf("Expected error %v, got diagnostics: %v", tt.expectError, resp.Diagnostics)
+			}
+			if tt.expectError {
+				return
+			}
+
+			c, ok := resp.ResourceData.(*client.Client)
+			if !ok {
+				t.Fatalf("Expected *client.Client as resource data, got %T", resp.ResourceData)
+			}
+			if c.RequestTimeout() != tt.expectedTimeout {
+				t.Errorf("Expected request timeout %v, got %v", tt.expectedTimeout, c.RequestTimeout())
+			}
+		})
+	}
+
+	attr := schemaResp.Schema.Attributes["request_timeout"].(schema.Int64Attribute)
+	for value, expectError := range map[int64]bool{1: false, 300: false, 0: true, -1: true} {
+		if diags := runInt64Validators(t, attr.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for request timeout %d, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+}
+
+func TestProvider_Configure_APIEndpoint(t *testing.T) {
+	p := &PloiCloudProvider{}
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+	configure := func(endpoint tftypes.Value) *provider.ConfigureResponse {
+		objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["api_token"] = tftypes.NewValue(tftypes.String, "test-token")
+		values["api_endpoint"] = endpoint
+		raw := tftypes.NewValue(objectType, values)
+
+		resp := &provider.ConfigureResponse{}
+		p.Configure(context.Background(), provider.ConfigureRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw},
+		}, resp)
+		return resp
+	}
+
+	tests := []struct {
+		name             string
+		env              string
+		endpoint         tftypes.Value
+		expectedEndpoint string
+	}{
+		{"default", "", tftypes.NewValue(tftypes.String, nil), client.DefaultAPIEndpoint},
+		{"environment", "https://staging.example.com/api/v1", tftypes.NewValue(tftypes.String, nil), "https://staging.example.com/api/v1"},
+		{"config over environment", "https://staging.example.com/api/v1", tftypes.NewValue(tftypes.String, "https://local.test/api/v1"), "https://local.test/api/v1"},
+		{"config without environment", "", tftypes.NewValue(tftypes.String, "https://local.test/api/v1"), "https://local.test/api/v1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// An empty variable must behave like an unset one
+			t.Setenv("PLOI_API_ENDPOINT", tt.env)
+
+			resp := configure(tt.endpoint)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+			}
+
+			c, ok := resp.ResourceData.(*client.Client)
+			if !ok {
+				t.Fatalf("Expected *client.Client as resource data, got %T", resp.ResourceData)
+			}
+			if c.APIEndpoint() != tt.expectedEndpoint {
+				t.Errorf("Expected endpoint %q, got %q", tt.expectedEndpoint, c.APIEndpoint())
+			}
+		})
+	}
+}
+
+func TestProvider_Configure_DisableReadCache(t *testing.T) {
+	p := &PloiCloudProvider{}
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+	for _, disabled := range []bool{false, true} {
+		objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["api_token"] = tftypes.NewValue(tftypes.String, "test-token")
+		values["disable_read_cache"] = tftypes.NewValue(tftypes.Bool, disabled)
+
+		resp := &provider.ConfigureResponse{}
+		p.Configure(context.Background(), provider.ConfigureRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+		}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+		}
+
+		c, ok := resp.ResourceData.(*client.Client)
+		if !ok {
+			t.Fatalf("Expected *client.Client as resource data, got %T", resp.ResourceData)
+		}
+		if c.ReadCacheDisabled() != disabled {
+			t.Errorf("Expected read cache disabled %v, got %v", disabled, c.ReadCacheDisabled())
+		}
+	}
+}
+
+func TestProvider_Configure_UserAgent(t *testing.T) {
+	p := &PloiCloudProvider{version: "1.4.0"}
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["api_token"] = tftypes.NewValue(tftypes.String, "test-token")
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(context.Background(), provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	c, ok := resp.ResourceData.(*client.Client)
+	if !ok {
+		t.Fatalf("Expected *client.Client as resource data, got %T", resp.ResourceData)
+	}
+	if !strings.HasPrefix(c.UserAgent(), "terraform-provider-ploicloud/1.4.0 ") {
+		t.Errorf("Expected the provider version in the User-Agent, got %q", c.UserAgent())
+	}
+}
+
+func TestProvider_Configure_MaxIdleConns(t *testing.T) {
+	p := &PloiCloudProvider{}
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+	if diags := runInt64Validators(t, schemaResp.Schema.Attributes["max_idle_conns"].(schema.Int64Attribute).Validators, 0); !diags.HasError() {
+		t.Error("Expected max_idle_conns 0 to be rejected")
+	}
+
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	tests := []struct {
+		name         string
+		maxIdleConns tftypes.Value
+		expected     int
+	}{
+		{"unset", tftypes.NewValue(tftypes.Number, nil), client.DefaultMaxIdleConns},
+		{"set", tftypes.NewValue(tftypes.Number, 50), 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := map[string]tftypes.Value{}
+			for name, attrType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attrType, nil)
+			}
+			values["api_token"] = tftypes.NewValue(tftypes.String, "test-token")
+			values["max_idle_conns"] = tt.maxIdleConns
+
+			resp := &provider.ConfigureResponse{}
+			p.Configure(context.Background(), provider.ConfigureRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+			}
+
+			c := resp.ResourceData.(*client.Client)
+			if c.MaxIdleConns() != tt.expected {
+				t.Errorf("Expected %d idle connections, got %d", tt.expected, c.MaxIdleConns())
+			}
+		})
+	}
+}
+
+func TestProvider_ExtraHeaders(t *testing.T) {
+	p := &PloiCloudProvider{}
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+	extraHeaders := schemaResp.Schema.Attributes["extra_headers"].(schema.MapAttribute)
+	for name, expectError := range map[string]bool{"X-Org-Id": false, "Authorization": true, "content-type": true, "ACCEPT": true, "User-Agent": true} {
+		var diags diag.Diagnostics
+		for _, v := range extraHeaders.Validators {
+			resp := &validator.MapResponse{}
+			v.ValidateMap(context.Background(), validator.MapRequest{
+				Path:        path.Root("extra_headers"),
+				ConfigValue: types.MapValueMust(types.StringType, map[string]attr.Value{name: types.StringValue("value")}),
+			}, resp)
+			diags.Append(resp.Diagnostics...)
+		}
+		if diags.HasError() != expectError {
+			t.Errorf("Expected error %v for header %q, got diagnostics: %v", expectError, name, diags)
+		}
+	}
+
+	var orgID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		orgID = r.Header.Get("X-Org-Id")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"id": 1, "name": "app", "application_type": "laravel"}}`))
+	}))
+	defer server.Close()
+
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["api_token"] = tftypes.NewValue(tftypes.String, "test-token")
+	values["api_endpoint"] = tftypes.NewValue(tftypes.String, server.URL)
+	values["extra_headers"] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"X-Org-Id": tftypes.NewValue(tftypes.String, "acme"),
+	})
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(context.Background(), provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	c := resp.ResourceData.(*client.Client)
+	if _, err := c.GetApplication(context.Background(), 1); err != nil {
+		t.Fatalf("Expected success but got error: %v", err)
+	}
+	if orgID != "acme" {
+		t.Errorf("Expected the configured X-Org-Id header to be sent, got %q", orgID)
+	}
+}
+
+func TestProvider_RetrySchema(t *testing.T) {
+	p := &PloiCloudProvider{}
+	resp := &provider.SchemaResponse{}
+	p.Schema(context.Background(), provider.SchemaRequest{}, resp)
+
+	retries := resp.Schema.Attributes["max_retries"].(schema.Int64Attribute)
+	for value, expectError := range map[int64]bool{0: false, 3: false, 10: false, -1: true, 11: true} {
+		if diags := runInt64Validators(t, retries.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for max_retries %d, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+
+	backoff := resp.Schema.Attributes["retry_backoff"].(schema.StringAttribute)
+	for value, expectError := range map[string]bool{"linear": false, "exponential": false, "fibonacci": true, "": true} {
+		if diags := runStringValidators(t, backoff.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for retry_backoff %q, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+}
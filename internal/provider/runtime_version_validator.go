@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// knownPHPVersions and knownNodeJSVersions are the runtime versions supported
+// when this provider was released. The platform may support newer ones, which
+// the ploicloud_runtime_versions data source reports.
+var (
+	knownPHPVersions    = []string{"7.4", "8.0", "8.1", "8.2", "8.3", "8.4"}
+	knownNodeJSVersions = []string{"18", "20", "22", "24"}
+)
+
+var _ validator.String = knownRuntimeVersionValidator{}
+
+// knownRuntimeVersionValidator warns, rather than errors, when a runtime
+// version is not in the list known to the provider, so newly added platform
+// versions can be used without a provider release.
+type knownRuntimeVersionValidator struct {
+	runtime  string
+	versions []string
+}
+
+func knownRuntimeVersion(runtime string, versions ...string) validator.String {
+	return knownRuntimeVersionValidator{runtime: runtime, versions: versions}
+}
+
+func (v knownRuntimeVersionValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value should be one of the known %s versions: %s", v.runtime, strings.Join(v.versions, ", "))
+}
+
+func (v knownRuntimeVersionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v knownRuntimeVersionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	for _, version := range v.versions {
+		if value == version {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		req.Path,
+		"Unknown Runtime Version",
+		fmt.Sprintf("%s version %q is not known to this provider (known versions: %s). It will be sent to the API as-is; "+
+			"use the ploicloud_runtime_versions data source to list the versions currently supported by the platform.",
+			v.runtime, value, strings.Join(v.versions, ", ")),
+	)
+}
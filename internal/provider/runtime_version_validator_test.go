@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func TestKnownRuntimeVersionValidator(t *testing.T) {
+	tests := []struct {
+		name          string
+		validator     validator.String
+		value         string
+		expectWarning bool
+	}{
+		{"known php version", knownRuntimeVersion("PHP", knownPHPVersions...), "8.3", false},
+		{"unknown php version", knownRuntimeVersion("PHP", knownPHPVersions...), "8.5", true},
+		{"known nodejs version", knownRuntimeVersion("Node.js", knownNodeJSVersions...), "22", false},
+		{"unknown nodejs version", knownRuntimeVersion("Node.js", knownNodeJSVersions...), "26", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := runStringValidators(t, []validator.String{tt.validator}, tt.value)
+
+			// Unknown versions must never hard-fail the plan
+			if diags.HasError() {
+				t.Fatalf("Expected no errors for %q, got %v", tt.value, diags)
+			}
+			if got := diags.WarningsCount() > 0; got != tt.expectWarning {
+				t.Errorf("Expected warning %v for %q, got diagnostics: %v", tt.expectWarning, tt.value, diags)
+			}
+		})
+	}
+}
+
+func TestApplicationResource_RuntimeVersion_UnknownWarns(t *testing.T) {
+	r := NewApplicationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	runtime := resp.Schema.Blocks["runtime"].(schema.SingleNestedBlock)
+
+	for attrName, version := range map[string]string{"php_version": "9.0", "nodejs_version": "26"} {
+		t.Run(attrName, func(t *testing.T) {
+			attr := runtime.Attributes[attrName].(schema.StringAttribute)
+
+			diags := runStringValidators(t, attr.Validators, version)
+			if diags.HasError() {
+				t.Fatalf("Expected unknown %s %q to warn rather than error, got %v", attrName, version, diags)
+			}
+			if diags.WarningsCount() != 1 || diags.Warnings()[0].Severity() != diag.SeverityWarning {
+				t.Errorf("Expected a single warning for %s %q, got %v", attrName, version, diags)
+			}
+		})
+	}
+}
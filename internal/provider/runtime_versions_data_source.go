@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+var _ datasource.DataSource = &RuntimeVersionsDataSource{}
+
+func NewRuntimeVersionsDataSource() datasource.DataSource {
+	return &RuntimeVersionsDataSource{}
+}
+
+type RuntimeVersionsDataSource struct {
+	client *client.Client
+}
+
+type RuntimeVersionsDataSourceModel struct {
+	PHPVersions    types.List `tfsdk:"php_versions"`
+	NodeJSVersions types.List `tfsdk:"nodejs_versions"`
+}
+
+func (d *RuntimeVersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_runtime_versions"
+}
+
+func (d *RuntimeVersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "PHP and Node.js versions currently supported by the Ploi Cloud platform",
+
+		Attributes: map[string]schema.Attribute{
+			"php_versions": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Supported PHP versions",
+			},
+			"nodejs_versions": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Supported Node.js versions",
+			},
+		},
+	}
+}
+
+func (d *RuntimeVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RuntimeVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, d.client, &resp.Diagnostics)
+
+	var data RuntimeVersionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versions, err := d.client.ListRuntimeVersions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list runtime versions, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(d.fromAPIModel(ctx, versions, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RuntimeVersionsDataSource) fromAPIModel(ctx context.Context, versions *client.RuntimeVersions, data *RuntimeVersionsDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Always empty lists rather than null so the result can be iterated
+	php := versions.PHP
+	if php == nil {
+		php = []string{}
+	}
+	nodejs := versions.NodeJS
+	if nodejs == nil {
+		nodejs = []string{}
+	}
+
+	var listDiags diag.Diagnostics
+	data.PHPVersions, listDiags = types.ListValueFrom(ctx, types.StringType, php)
+	diags.Append(listDiags...)
+	data.NodeJSVersions, listDiags = types.ListValueFrom(ctx, types.StringType, nodejs)
+	diags.Append(listDiags...)
+
+	return diags
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+func TestRuntimeVersionsDataSource_fromAPIModel(t *testing.T) {
+	d := &RuntimeVersionsDataSource{}
+	ctx := context.Background()
+
+	var data RuntimeVersionsDataSourceModel
+	diags := d.fromAPIModel(ctx, &client.RuntimeVersions{PHP: []string{"8.3", "8.4", "8.5"}, NodeJS: []string{"22", "24"}}, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	var php []string
+	data.PHPVersions.ElementsAs(ctx, &php, false)
+	if len(php) != 3 || php[2] != "8.5" {
+		t.Errorf("Expected php_versions [8.3 8.4 8.5], got %v", php)
+	}
+
+	var nodejs []string
+	data.NodeJSVersions.ElementsAs(ctx, &nodejs, false)
+	if len(nodejs) != 2 || nodejs[0] != "22" {
+		t.Errorf("Expected nodejs_versions [22 24], got %v", nodejs)
+	}
+
+	// Missing lists become empty rather than null
+	data = RuntimeVersionsDataSourceModel{}
+	d.fromAPIModel(ctx, &client.RuntimeVersions{}, &data)
+	if data.PHPVersions.IsNull() || len(data.PHPVersions.Elements()) != 0 {
+		t.Errorf("Expected empty php_versions, got %v", data.PHPVersions)
+	}
+	if !data.NodeJSVersions.Equal(types.ListValueMust(types.StringType, nil)) {
+		t.Errorf("Expected empty nodejs_versions, got %v", data.NodeJSVersions)
+	}
+}
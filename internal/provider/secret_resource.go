@@ -3,12 +3,19 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/ploi/terraform-provider-ploicloud/internal/client"
 )
@@ -16,6 +23,12 @@ import (
 var _ resource.Resource = &SecretResource{}
 var _ resource.ResourceWithImportState = &SecretResource{}
 
+// secretScopes control where a secret is injected: during the build, into the running application, or both
+var secretScopes = []string{"build", "runtime", "both"}
+
+// defaultSecretScope is used when a secret's scope is not configured or not reported by the API
+const defaultSecretScope = "runtime"
+
 func NewSecretResource() resource.Resource {
 	return &SecretResource{}
 }
@@ -25,9 +38,11 @@ type SecretResource struct {
 }
 
 type SecretResourceModel struct {
-	ApplicationID types.Int64  `tfsdk:"application_id"`
-	Key           types.String `tfsdk:"key"`
-	Value         types.String `tfsdk:"value"`
+	ApplicationID   types.Int64  `tfsdk:"application_id"`
+	Key             types.String `tfsdk:"key"`
+	Value           types.String `tfsdk:"value"`
+	Scope           types.String `tfsdk:"scope"`
+	EncryptedAtRest types.Bool   `tfsdk:"encrypted_at_rest"`
 }
 
 func (r *SecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,16 +57,39 @@ func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"application_id": schema.Int64Attribute{
 				Required:            true,
 				MarkdownDescription: "Application ID this secret belongs to",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"key": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "Environment variable key (must be uppercase with underscores)",
+				// Update addresses the secret by key, so a new key is a new secret
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"value": schema.StringAttribute{
 				Required:            true,
 				Sensitive:           true,
 				MarkdownDescription: "Environment variable value",
 			},
+			"scope": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultSecretScope),
+				MarkdownDescription: "Where the secret is injected: `build` (build time only), `runtime` (running application only) or `both`. Defaults to `runtime`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(secretScopes...),
+				},
+			},
+			"encrypted_at_rest": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the API stores the secret encrypted at rest, null when the API does not report it",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -74,6 +112,8 @@ func (r *SecretResource) Configure(ctx context.Context, req resource.ConfigureRe
 }
 
 func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data SecretResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -84,28 +124,32 @@ func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest,
 	secret := r.toAPIModel(&data)
 
 	// Try to create the secret first
-	created, err := r.client.CreateSecret(secret)
+	created, err := r.client.CreateSecret(ctx, secret)
 	if err != nil {
 		// If creation failed due to existing secret, try to update it instead
 		if strings.Contains(err.Error(), "already exists") {
-			updated, updateErr := r.client.UpdateSecret(data.ApplicationID.ValueInt64(), data.Key.ValueString(), secret)
+			updated, updateErr := r.client.UpdateSecret(ctx, data.ApplicationID.ValueInt64(), data.Key.ValueString(), secret)
 			if updateErr != nil {
 				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create or update secret, create error: %s, update error: %s", err, updateErr))
 				return
 			}
 			r.fromAPIModel(updated, &data)
+			resp.Diagnostics.Append(secretEncryptionDiagnostics(updated)...)
 		} else {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create secret, got error: %s", err))
 			return
 		}
 	} else {
 		r.fromAPIModel(created, &data)
+		resp.Diagnostics.Append(secretEncryptionDiagnostics(created)...)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data SecretResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -113,7 +157,7 @@ func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	secret, err := r.client.GetSecret(data.ApplicationID.ValueInt64(), data.Key.ValueString())
+	secret, err := r.client.GetSecret(ctx, data.ApplicationID.ValueInt64(), data.Key.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret, got error: %s", err))
 		return
@@ -125,11 +169,14 @@ func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	r.fromAPIModel(secret, &data)
+	resp.Diagnostics.Append(secretEncryptionDiagnostics(secret)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data SecretResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -139,18 +186,21 @@ func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	secret := r.toAPIModel(&data)
 
-	updated, err := r.client.UpdateSecret(data.ApplicationID.ValueInt64(), data.Key.ValueString(), secret)
+	updated, err := r.client.UpdateSecret(ctx, data.ApplicationID.ValueInt64(), data.Key.ValueString(), secret)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update secret, got error: %s", err))
 		return
 	}
 
 	r.fromAPIModel(updated, &data)
+	resp.Diagnostics.Append(secretEncryptionDiagnostics(updated)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data SecretResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -158,28 +208,21 @@ func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteSecret(data.ApplicationID.ValueInt64(), data.Key.ValueString())
-	if err != nil {
+	err := r.client.DeleteSecret(ctx, data.ApplicationID.ValueInt64(), data.Key.ValueString())
+	// A secret that is already gone counts as deleted
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete secret, got error: %s", err))
 		return
 	}
 }
 
 func (r *SecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ".")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Invalid Import ID", "Import ID must be in the format 'application_id.secret_key'")
-		return
-	}
-
-	applicationID, err := strconv.ParseInt(parts[0], 10, 64)
+	applicationID, secretKey, err := parseImportIDWithKey(req.ID, "secret_key")
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid Import ID", "Application ID must be a valid integer")
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
 		return
 	}
 
-	secretKey := parts[1]
-
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), applicationID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), secretKey)...)
 }
@@ -189,6 +232,7 @@ func (r *SecretResource) toAPIModel(data *SecretResourceModel) *client.Applicati
 		ApplicationID: data.ApplicationID.ValueInt64(),
 		Key:           data.Key.ValueString(),
 		Value:         data.Value.ValueString(),
+		Scope:         data.Scope.ValueString(),
 	}
 }
 
@@ -205,4 +249,29 @@ func (r *SecretResource) fromAPIModel(secret *client.ApplicationSecret, data *Se
 	if secret.Value != "" && secret.Value != "********" {
 		data.Value = types.StringValue(secret.Value)
 	}
+
+	if secret.Scope != "" {
+		data.Scope = types.StringValue(secret.Scope)
+	} else if data.Scope.IsNull() || data.Scope.IsUnknown() {
+		data.Scope = types.StringValue(defaultSecretScope)
+	}
+
+	if secret.EncryptedAtRest != nil {
+		data.EncryptedAtRest = types.BoolValue(*secret.EncryptedAtRest)
+	} else {
+		data.EncryptedAtRest = types.BoolNull()
+	}
+}
+
+// secretEncryptionDiagnostics warns when the API reports that a secret is
+// stored unencrypted. Secrets the API says nothing about are not flagged.
+func secretEncryptionDiagnostics(secret *client.ApplicationSecret) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if secret.EncryptedAtRest != nil && !*secret.EncryptedAtRest {
+		diags.AddWarning(
+			"Secret Stored Unencrypted",
+			fmt.Sprintf("The API reports that secret %s is not encrypted at rest.", secret.Key),
+		)
+	}
+	return diags
 }
\ No newline at end of file
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+func TestSecretResource_Scope_Mapping(t *testing.T) {
+	r := &SecretResource{}
+
+	data := &SecretResourceModel{
+		ApplicationID: types.Int64Value(3),
+		Key:           types.StringValue("NPM_TOKEN"),
+		Value:         types.StringValue("npm_abc123"),
+		Scope:         types.StringValue("build"),
+	}
+
+	expected := &client.ApplicationSecret{
+		ApplicationID: 3,
+		Key:           "NPM_TOKEN",
+		Value:         "npm_abc123",
+		Scope:         "build",
+	}
+	if secret := r.toAPIModel(data); !reflect.DeepEqual(secret, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, secret)
+	}
+
+	tests := []struct {
+		name     string
+		planned  types.String
+		apiScope string
+		expected string
+	}{
+		{"api scope wins", types.StringValue("runtime"), "both", "both"},
+		{"planned scope kept when api omits it", types.StringValue("build"), "", "build"},
+		{"import falls back to default", types.StringNull(), "", "runtime"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &SecretResourceModel{Value: types.StringValue("npm_abc123"), Scope: tt.planned}
+			r.fromAPIModel(&client.ApplicationSecret{
+				ApplicationID: 3,
+				Key:           "NPM_TOKEN",
+				Value:         "********",
+				Scope:         tt.apiScope,
+			}, data)
+
+			if !data.Scope.Equal(types.StringValue(tt.expected)) {
+				t.Errorf("Expected scope %q, got %v", tt.expected, data.Scope)
+			}
+		})
+	}
+}
+
+func TestSecretResource_Scope_Validation(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewSecretResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	scope := resp.Schema.Attributes["scope"].(schema.StringAttribute)
+	if scope.Default == nil {
+		t.Error("Expected scope to have a default")
+	}
+
+	for value, expectError := range map[string]bool{
+		"build":   false,
+		"runtime": false,
+		"both":    false,
+		"Runtime": true,
+		"deploy":  true,
+		"":        true,
+	} {
+		if diags := runStringValidators(t, scope.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for scope %q, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+}
+
+// secretTestServer mocks the secrets endpoints of application 1. Listed
+// values are masked like the real API does.
+func secretTestServer(t *testing.T, secrets map[string]client.ApplicationSecret) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		key := strings.TrimPrefix(r.URL.Path, "/applications/1/secrets/")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/applications/1/secrets":
+			var secret client.ApplicationSecret
+			json.NewDecoder(r.Body).Decode(&secret)
+			secrets[secret.Key] = secret
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(client.SingleResponse[client.ApplicationSecret]{Data: secret})
+		case r.Method == http.MethodGet && r.URL.Path == "/applications/1/secrets":
+			list := []client.ApplicationSecret{}
+			for _, secret := range secrets {
+				secret.Value = "********"
+				list = append(list, secret)
+			}
+			json.NewEncoder(w).Encode(client.ListResponse[client.ApplicationSecret]{Data: list})
+		case r.Method == http.MethodPut && key != r.URL.Path:
+			if _, ok := secrets[key]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			var secret client.ApplicationSecret
+			json.NewDecoder(r.Body).Decode(&secret)
+			secrets[key] = secret
+			json.NewEncoder(w).Encode(client.SingleResponse[client.ApplicationSecret]{Data: secret})
+		case r.Method == http.MethodDelete && key != r.URL.Path:
+			delete(secrets, key)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestSecretResource_CRUD(t *testing.T) {
+	ctx := context.Background()
+
+	secrets := map[string]client.ApplicationSecret{}
+	server := secretTestServer(t, secrets)
+	defer server.Close()
+
+	r := &SecretResource{client: client.NewClient("test-token", &server.URL, client.WithRetriesDisabled())}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+	emptyState := func() tfsdk.State {
+		return tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	}
+	plannedSecret := func(value string) tfsdk.Plan {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+		plan.Set(ctx, &SecretResourceModel{
+			ApplicationID: types.Int64Value(1),
+			Key:           types.StringValue("STRIPE_SECRET"),
+			Value:         types.StringValue(value),
+			Scope:         types.StringValue("runtime"),
+		})
+		return plan
+	}
+	stateValue := func(state tfsdk.State) types.String {
+		var data SecretResourceModel
+		state.Get(ctx, &data)
+		return data.Value
+	}
+
+	// Create
+	createResp := &resource.CreateResponse{State: emptyState()}
+	r.Create(ctx, resource.CreateRequest{Plan: plannedSecret("sk_live_1")}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected create errors: %v", createResp.Diagnostics)
+	}
+	if secrets["STRIPE_SECRET"].Value != "sk_live_1" {
+		t.Errorf("Expected the secret to be created, got %+v", secrets)
+	}
+
+	// Read keeps the value from state since the API masks it
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected read errors: %v", readResp.Diagnostics)
+	}
+	if value := stateValue(readResp.State); !value.Equal(types.StringValue("sk_live_1")) {
+		t.Errorf("Expected value to be kept after read, got %v", value)
+	}
+
+	// Update
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: plannedSecret("sk_live_2"), State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected update errors: %v", updateResp.Diagnostics)
+	}
+	if secrets["STRIPE_SECRET"].Value != "sk_live_2" {
+		t.Errorf("Expected the secret to be updated, got %+v", secrets)
+	}
+	if value := stateValue(updateResp.State); !value.Equal(types.StringValue("sk_live_2")) {
+		t.Errorf("Expected updated value in state, got %v", value)
+	}
+
+	// Delete
+	deleteResp := &resource.DeleteResponse{State: updateResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected delete errors: %v", deleteResp.Diagnostics)
+	}
+	if len(secrets) != 0 {
+		t.Errorf("Expected the secret to be deleted, got %+v", secrets)
+	}
+
+	// Read after delete removes the resource from state
+	goneResp := &resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, goneResp)
+	if goneResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected read errors: %v", goneResp.Diagnostics)
+	}
+	if !goneResp.State.Raw.IsNull() {
+		t.Error("Expected the deleted secret to be removed from state")
+	}
+}
+
+func TestSecretResource_EncryptedAtRest(t *testing.T) {
+	ctx := context.Background()
+	encrypted, unencrypted := true, false
+
+	tests := []struct {
+		name          string
+		encrypted     *bool
+		expected      types.Bool
+		expectWarning bool
+	}{
+		{name: "encrypted", encrypted: &encrypted, expected: types.BoolValue(true)},
+		{name: "unencrypted", encrypted: &unencrypted, expected: types.BoolValue(false), expectWarning: true},
+		{name: "not reported", expected: types.BoolNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secrets := map[string]client.ApplicationSecret{
+				"STRIPE_SECRET": {ApplicationID: 1, Key: "STRIPE_SECRET", Value: "sk_live_1", EncryptedAtRest: tt.encrypted},
+			}
+			server := secretTestServer(t, secrets)
+			defer server.Close()
+
+			r := &SecretResource{client: client.NewClient("test-token", &server.URL, client.WithRetriesDisabled())}
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			state.Set(ctx, &SecretResourceModel{
+				ApplicationID:   types.Int64Value(1),
+				Key:             types.StringValue("STRIPE_SECRET"),
+				Value:           types.StringValue("sk_live_1"),
+				Scope:           types.StringValue("runtime"),
+				EncryptedAtRest: types.BoolNull(),
+			})
+
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected read errors: %v", resp.Diagnostics)
+			}
+
+			var data SecretResourceModel
+			resp.State.Get(ctx, &data)
+			if !data.EncryptedAtRest.Equal(tt.expected) {
+				t.Errorf("Expected encrypted_at_rest %v, got %v", tt.expected, data.EncryptedAtRest)
+			}
+
+			warned := resp.Diagnostics.WarningsCount() == 1 && resp.Diagnostics.Warnings()[0].Summary() == "Secret Stored Unencrypted"
+			if warned != tt.expectWarning {
+				t.Errorf("Expected warning %v, got %v", tt.expectWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestSecretResource_Schema(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewSecretResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	if value := resp.Schema.Attributes["value"].(schema.StringAttribute); !value.Sensitive {
+		t.Error("Expected value to be sensitive")
+	}
+	if key := resp.Schema.Attributes["key"].(schema.StringAttribute); len(key.PlanModifiers) == 0 {
+		t.Error("Expected changing key to replace the secret")
+	}
+	if applicationID := resp.Schema.Attributes["application_id"].(schema.Int64Attribute); len(applicationID.PlanModifiers) == 0 {
+		t.Error("Expected changing application_id to replace the secret")
+	}
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+var _ datasource.DataSource = &ServiceDataSource{}
+
+func NewServiceDataSource() datasource.DataSource {
+	return &ServiceDataSource{}
+}
+
+type ServiceDataSource struct {
+	client *client.Client
+}
+
+type ServiceDataSourceModel struct {
+	ApplicationID types.Int64  `tfsdk:"application_id"`
+	Name          types.String `tfsdk:"name"`
+	ID            types.Int64  `tfsdk:"id"`
+	Type          types.String `tfsdk:"type"`
+	Version       types.String `tfsdk:"version"`
+	Status        types.String `tfsdk:"status"`
+	StorageSize   types.String `tfsdk:"storage_size"`
+	MemoryRequest types.String `tfsdk:"memory_request"`
+	Replicas      types.Int64  `tfsdk:"replicas"`
+	Settings      types.Map    `tfsdk:"settings"`
+}
+
+func (d *ServiceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+func (d *ServiceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing service of an application by name",
+
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Application ID the service belongs to",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Service name",
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Service ID",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Service type (mysql, postgresql, redis, ...)",
+			},
+			"version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Service version",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Service status",
+			},
+			"storage_size": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Storage size of the service (e.g., '10Gi')",
+			},
+			"memory_request": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Memory request of the service (e.g., '256Mi')",
+			},
+			"replicas": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of replicas of the service",
+			},
+			"settings": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Service-specific settings",
+			},
+		},
+	}
+}
+
+func (d *ServiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, d.client, &resp.Diagnostics)
+
+	var data ServiceDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := d.client.GetApplication(ctx, data.ApplicationID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application, got error: %s", err))
+		return
+	}
+
+	if app == nil {
+		resp.Diagnostics.AddError("Application Not Found", fmt.Sprintf("Application with ID %d not found", data.ApplicationID.ValueInt64()))
+		return
+	}
+
+	service, diags := findServiceByName(app.Services, data.Name.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Services embedded in the application may omit the application ID
+	if service.ApplicationID == 0 {
+		service.ApplicationID = app.ID
+	}
+
+	d.fromAPIModel(service, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findServiceByName returns the only service called name, reporting an error
+// when no service or more than one service has that name.
+func findServiceByName(services []client.ApplicationService, name string) (*client.ApplicationService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var matches []*client.ApplicationService
+	for i := range services {
+		if services[i].Name == name {
+			matches = append(matches, &services[i])
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0], diags
+	case 0:
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Service Not Found",
+			fmt.Sprintf("No service named %q exists in this application", name),
+		)
+	default:
+		ids := make([]string, len(matches))
+		for i, match := range matches {
+			ids[i] = fmt.Sprintf("%d", match.ID)
+		}
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Ambiguous Service Name",
+			fmt.Sprintf("%d services are named %q (IDs %s), rename them so the name is unique", len(matches), name, strings.Join(ids, ", ")),
+		)
+	}
+
+	return nil, diags
+}
+
+// fromAPIModel maps the service through ServiceResource.fromAPIModel so the
+// data source reports the same values the resource would store in state.
+func (d *ServiceDataSource) fromAPIModel(service *client.ApplicationService, data *ServiceDataSourceModel) {
+	var model ServiceResourceModel
+	(&ServiceResource{}).fromAPIModel(service, &model)
+
+	data.ApplicationID = model.ApplicationID
+	data.Name = model.Name
+	data.ID = model.ID
+	data.Type = model.Type
+	data.Version = model.Version
+	data.Status = model.Status
+	data.StorageSize = model.StorageSize
+	data.MemoryRequest = model.MemoryRequest
+	data.Replicas = model.Replicas
+
+	data.Settings = model.Settings
+	if data.Settings.IsNull() {
+		data.Settings = types.MapNull(types.StringType)
+	}
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ploi/terraform-provider-ploicloud/internal/client"
+)
+
+func TestFindServiceByName(t *testing.T) {
+	services := []client.ApplicationService{
+		{ID: 30, Name: "postgres", Type: "postgresql"},
+		{ID: 31, Name: "cache", Type: "redis"},
+		{ID: 32, Name: "cache", Type: "valkey"},
+	}
+
+	tests := []struct {
+		name          string
+		lookup        string
+		expectedID    int64
+		errorContains string
+	}{
+		{name: "unique match", lookup: "postgres", expectedID: 30},
+		{name: "no match", lookup: "mysql", errorContains: `No service named "mysql"`},
+		{name: "multiple matches", lookup: "cache", errorContains: "IDs 31, 32"},
+		{name: "case sensitive", lookup: "Postgres", errorContains: "No service named"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, diags := findServiceByName(services, tt.lookup)
+			if tt.errorContains != "" {
+				if !diags.HasError() {
+					t.Fatalf("Expected error, got service %+v", service)
+				}
+				if !strings.Contains(diags[0].Detail(), tt.errorContains) {
+					t.Errorf("Expected error detail to contain %q, got %q", tt.errorContains, diags[0].Detail())
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("Expected success, got diagnostics: %v", diags)
+			}
+			if service.ID != tt.expectedID {
+				t.Errorf("Expected service %d, got %d", tt.expectedID, service.ID)
+			}
+		})
+	}
+}
+
+func TestServiceDataSource_fromAPIModel(t *testing.T) {
+	d := &ServiceDataSource{}
+
+	var data ServiceDataSourceModel
+	d.fromAPIModel(&client.ApplicationService{
+		ID:            30,
+		ApplicationID: 1,
+		Name:          "postgres",
+		Type:          "postgresql",
+		Version:       "16",
+		Status:        "running",
+		StorageSize:   "10Gi",
+		MemoryRequest: "512Mi",
+		Replicas:      1,
+		Settings:      client.FlexibleSettingsFromMap(map[string]string{"max_connections": "200"}),
+	}, &data)
+
+	expected := ServiceDataSourceModel{
+		ApplicationID: types.Int64Value(1),
+		Name:          types.StringValue("postgres"),
+		ID:            types.Int64Value(30),
+		Type:          types.StringValue("postgresql"),
+		Version:       types.StringValue("16"),
+		Status:        types.StringValue("running"),
+		StorageSize:   types.StringValue("10Gi"),
+		MemoryRequest: types.StringValue("512Mi"),
+		Replicas:      types.Int64Value(1),
+	}
+	expected.Settings, _ = types.MapValueFrom(context.Background(), types.StringType, map[string]string{"max_connections": "200"})
+
+	if !data.ApplicationID.Equal(expected.ApplicationID) || !data.Name.Equal(expected.Name) || !data.ID.Equal(expected.ID) ||
+		!data.Type.Equal(expected.Type) || !data.Version.Equal(expected.Version) || !data.Status.Equal(expected.Status) ||
+		!data.StorageSize.Equal(expected.StorageSize) || !data.MemoryRequest.Equal(expected.MemoryRequest) ||
+		!data.Replicas.Equal(expected.Replicas) || !data.Settings.Equal(expected.Settings) {
+		t.Errorf("Expected %+v, got %+v", expected, data)
+	}
+
+	// Fields the API omits are null rather than empty
+	data = ServiceDataSourceModel{}
+	d.fromAPIModel(&client.ApplicationService{ID: 31, ApplicationID: 1, Name: "cache", Type: "redis"}, &data)
+	if !data.Version.IsNull() || !data.StorageSize.IsNull() || !data.MemoryRequest.IsNull() || !data.Settings.IsNull() {
+		t.Errorf("Expected omitted fields to be null, got %+v", data)
+	}
+}
@@ -2,15 +2,25 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strconv"
+	"regexp"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,6 +29,49 @@ import (
 
 var _ resource.Resource = &ServiceResource{}
 var _ resource.ResourceWithImportState = &ServiceResource{}
+var _ resource.ResourceWithValidateConfig = &ServiceResource{}
+
+// queueNameRegex matches a queue or queue connection name. Commas are excluded
+// because queues are passed to the worker as a comma-separated list.
+var queueNameRegex = regexp.MustCompile(`^[^,\s]+$`)
+
+// pemCertificateRegex matches text containing a PEM-encoded certificate
+var pemCertificateRegex = regexp.MustCompile(`-----BEGIN CERTIFICATE-----`)
+
+// tlsServiceTypes are the stateful service types that accept TLS connections
+var tlsServiceTypes = []string{"mysql", "postgresql", "mongodb", "redis", "valkey"}
+
+// autoscalableServiceTypes are the database and cache service types whose
+// replicas can be scaled on CPU usage
+var autoscalableServiceTypes = []string{"mysql", "postgresql", "mongodb", "redis", "valkey"}
+
+// pitrServiceTypes are the database service types supporting point-in-time recovery
+var pitrServiceTypes = []string{"mysql", "postgresql"}
+
+// maxPITRRetentionDays caps how long point-in-time recovery logs are kept
+const maxPITRRetentionDays = 35
+
+// serviceAlertMetrics are the service metrics alerts can be configured on
+var serviceAlertMetrics = []string{"connections", "cpu_usage_percent", "memory_usage_percent", "disk_usage_percent"}
+
+// serviceAlertComparisons compare a metric against the alert threshold:
+// greater than, greater than or equal, less than, less than or equal
+var serviceAlertComparisons = []string{"gt", "gte", "lt", "lte"}
+
+// serviceAPIFieldPaths maps API validation error fields to their attributes
+var serviceAPIFieldPaths = map[string]path.Path{
+	"name":           path.Root("service_name"),
+	"type":           path.Root("type"),
+	"version":        path.Root("version"),
+	"settings":       path.Root("settings"),
+	"replicas":       path.Root("replicas"),
+	"memory_request": path.Root("memory_request"),
+	"storage_size":   path.Root("storage_size"),
+	"extensions":     path.Root("extensions"),
+	"command":        path.Root("command"),
+	"autoscaling":    path.Root("autoscaling"),
+	"alerts":         path.Root("alerts"),
+}
 
 func NewServiceResource() resource.Resource {
 	return &ServiceResource{}
@@ -26,8 +79,18 @@ func NewServiceResource() resource.Resource {
 
 type ServiceResource struct {
 	client *client.Client
+
+	// dependencyPollInterval and dependencyTimeout control how long Create waits
+	// for depends_on_services to become ready. Zero values use the defaults.
+	dependencyPollInterval time.Duration
+	dependencyTimeout      time.Duration
 }
 
+const (
+	defaultDependencyPollInterval = 5 * time.Second
+	defaultDependencyTimeout      = 10 * time.Minute
+)
+
 type ServiceResourceModel struct {
 	ID            types.Int64  `tfsdk:"id"`
 	ApplicationID types.Int64  `tfsdk:"application_id"`
@@ -41,6 +104,68 @@ type ServiceResourceModel struct {
 	Extensions    types.List   `tfsdk:"extensions"`
 	Command       types.String `tfsdk:"command"`
 	Status        types.String `tfsdk:"status"`
+	DependsOn     types.List   `tfsdk:"depends_on_services"`
+
+	QueueConnection types.String `tfsdk:"queue_connection"`
+	Queues          types.List   `tfsdk:"queues"`
+
+	RotateCredentials          types.String `tfsdk:"rotate_credentials"`
+	ExportCredentialsAsSecrets types.Bool   `tfsdk:"export_credentials_as_secrets"`
+	Host                       types.String `tfsdk:"host"`
+	Port                       types.Int64  `tfsdk:"port"`
+	Username                   types.String `tfsdk:"username"`
+	Password                   types.String `tfsdk:"password"`
+	DatabaseName               types.String `tfsdk:"database_name"`
+
+	ConnectionPooling *ConnectionPoolingModel  `tfsdk:"connection_pooling"`
+	Backup            *ServiceBackupModel      `tfsdk:"backup"`
+	Maintenance       *ServiceMaintenanceModel `tfsdk:"maintenance"`
+	TLS               *ServiceTLSModel         `tfsdk:"tls"`
+	Autoscaling       *ServiceAutoscalingModel `tfsdk:"autoscaling"`
+	Alerts            []ServiceAlertModel      `tfsdk:"alerts"`
+
+	ReadReplicas             types.Int64  `tfsdk:"read_replicas"`
+	ReadReplicaCPURequest    types.String `tfsdk:"read_replica_cpu_request"`
+	ReadReplicaMemoryRequest types.String `tfsdk:"read_replica_memory_request"`
+	ReadReplicaEndpoints     types.List   `tfsdk:"read_replica_endpoints"`
+}
+
+type ConnectionPoolingModel struct {
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Mode     types.String `tfsdk:"mode"`
+	PoolSize types.Int64  `tfsdk:"pool_size"`
+}
+
+type ServiceBackupModel struct {
+	EncryptionEnabled types.Bool   `tfsdk:"encryption_enabled"`
+	EncryptionMode    types.String `tfsdk:"encryption_mode"`
+	KMSKey            types.String `tfsdk:"kms_key"`
+	PITRRetentionDays types.Int64  `tfsdk:"pitr_retention_days"`
+}
+
+type ServiceTLSModel struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Mode    types.String `tfsdk:"mode"`
+	CACert  types.String `tfsdk:"ca_cert"`
+}
+
+type ServiceAutoscalingModel struct {
+	Enabled   types.Bool  `tfsdk:"enabled"`
+	Min       types.Int64 `tfsdk:"min"`
+	Max       types.Int64 `tfsdk:"max"`
+	TargetCPU types.Int64 `tfsdk:"target_cpu"`
+}
+
+type ServiceAlertModel struct {
+	Metric          types.String  `tfsdk:"metric"`
+	Threshold       types.Float64 `tfsdk:"threshold"`
+	Comparison      types.String  `tfsdk:"comparison"`
+	NotificationURL types.String  `tfsdk:"notification_url"`
+}
+
+type ServiceMaintenanceModel struct {
+	VacuumSchedule  types.String `tfsdk:"vacuum_schedule"`
+	AnalyzeSchedule types.String `tfsdk:"analyze_schedule"`
 }
 
 func (r *ServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -109,14 +234,482 @@ func (r *ServiceResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 				MarkdownDescription: "Command to run for worker services (e.g., 'php artisan queue:work'). Only applicable to worker type services.",
 			},
+			"queue_connection": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Laravel queue connection the worker processes (e.g., 'redis'). Only applicable to worker type services.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(queueNameRegex, "must be a non-empty name without whitespace or commas"),
+				},
+			},
+			"queues": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Queues the worker processes, in priority order (e.g., ['high', 'default']). Only applicable to worker type services.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(queueNameRegex, "must be a non-empty name without whitespace or commas"),
+					),
+				},
+			},
 			"status": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Service status",
 			},
+			"depends_on_services": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "IDs of services in the same application that must be running before this service is created",
+			},
+			"rotate_credentials": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Arbitrary value that triggers a credential rotation whenever it changes (e.g., a date or counter)",
+			},
+			"export_credentials_as_secrets": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Write the service credentials to the application's secrets and restart the application whenever they are rotated",
+			},
+			"host": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Hostname the application connects to the service on",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Port the application connects to the service on",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Service username",
+			},
+			"password": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Service password",
+			},
+			"database_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the database created for the application. Only set for database services",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"read_replicas": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Number of read-only replicas. Only applicable to postgresql and mysql services.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"read_replica_cpu_request": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "CPU request for each read replica (e.g., '250m', '1')",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cpuRequestRegex, "must be a CPU quantity such as '250m' or '0.5'"),
+				},
+			},
+			"read_replica_memory_request": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Memory request for each read replica (e.g., '512Mi', '1Gi')",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(memoryRequestRegex, "must be a memory quantity such as '512Mi' or '1Gi'"),
+				},
+			},
+			"read_replica_endpoints": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Connection endpoints (host:port) of the read replicas",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"connection_pooling": schema.SingleNestedBlock{
+				MarkdownDescription: "pgbouncer-style connection pooling. Only applicable to postgresql services.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+						MarkdownDescription: "Route connections through the pooler",
+					},
+					"mode": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("transaction"),
+						MarkdownDescription: "Pooling mode (transaction, session, statement)",
+						Validators: []validator.String{
+							stringvalidator.OneOf("transaction", "session", "statement"),
+						},
+					},
+					"pool_size": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Number of server connections per database/user pair (1-1000)",
+						Validators: []validator.Int64{
+							int64validator.Between(1, 1000),
+						},
+					},
+				},
+			},
+			"tls": schema.SingleNestedBlock{
+				MarkdownDescription: "TLS for client connections. Only applicable to mysql, postgresql, mongodb, redis and valkey services.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+						MarkdownDescription: "Enforce TLS on client connections",
+					},
+					"mode": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("require"),
+						MarkdownDescription: "How strictly clients verify the server certificate (require, verify-ca, verify-full)",
+						Validators: []validator.String{
+							stringvalidator.OneOf("require", "verify-ca", "verify-full"),
+						},
+					},
+					"ca_cert": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "PEM-encoded CA certificate clients use to verify the server certificate",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(pemCertificateRegex, "must be a PEM-encoded certificate"),
+						},
+					},
+				},
+			},
+			"autoscaling": schema.SingleNestedBlock{
+				MarkdownDescription: "Scale replicas on CPU usage. Only applicable to mysql, postgresql, mongodb, redis and valkey services.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+						MarkdownDescription: "Scale the service automatically",
+					},
+					"min": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Minimum number of replicas. Required when enabled",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"max": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Maximum number of replicas, at least min. Required when enabled",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"target_cpu": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Average CPU usage in percent (0-100) the autoscaler aims for",
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+				},
+			},
+			"alerts": schema.ListNestedBlock{
+				MarkdownDescription: "Alerts posted to a notification URL when a service metric crosses a threshold. Each metric and comparison pair can be used once.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"metric": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Metric to watch (connections, cpu_usage_percent, memory_usage_percent, disk_usage_percent)",
+							Validators: []validator.String{
+								stringvalidator.OneOf(serviceAlertMetrics...),
+							},
+						},
+						"threshold": schema.Float64Attribute{
+							Required:            true,
+							MarkdownDescription: "Value the metric is compared against. Percentage metrics accept 0-100",
+							Validators: []validator.Float64{
+								float64validator.AtLeast(0),
+							},
+						},
+						"comparison": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("gt"),
+							MarkdownDescription: "How the metric is compared against the threshold (gt, gte, lt, lte)",
+							Validators: []validator.String{
+								stringvalidator.OneOf(serviceAlertComparisons...),
+							},
+						},
+						"notification_url": schema.StringAttribute{
+							Required:            true,
+							Sensitive:           true,
+							MarkdownDescription: "Webhook URL (http or https) the alert is posted to. Sensitive because webhook URLs usually embed a token",
+							Validators: []validator.String{
+								webhookURL(),
+							},
+						},
+					},
+				},
+			},
+			"maintenance": schema.SingleNestedBlock{
+				MarkdownDescription: "Scheduled database maintenance. Only applicable to postgresql services.",
+				Attributes: map[string]schema.Attribute{
+					"vacuum_schedule": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Cron expression for running VACUUM, e.g. `0 3 * * 0`",
+						Validators: []validator.String{
+							cronExpression(),
+						},
+					},
+					"analyze_schedule": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Cron expression for running ANALYZE, e.g. `0 4 * * *`",
+						Validators: []validator.String{
+							cronExpression(),
+						},
+					},
+				},
+			},
+			"backup": schema.SingleNestedBlock{
+				MarkdownDescription: "Backup configuration of the service",
+				Attributes: map[string]schema.Attribute{
+					"encryption_enabled": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+						MarkdownDescription: "Encrypt backups at rest",
+					},
+					"encryption_mode": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("platform"),
+						MarkdownDescription: "Who manages the encryption key (platform, customer_managed)",
+						Validators: []validator.String{
+							stringvalidator.OneOf("platform", "customer_managed"),
+						},
+					},
+					"kms_key": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						MarkdownDescription: "Reference to the customer-managed KMS key. Required when encryption_mode is customer_managed",
+					},
+					"pitr_retention_days": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: fmt.Sprintf("Days of point-in-time recovery kept beyond the scheduled backups, from 1 to %d. Only supported for mysql and postgresql services", maxPITRRetentionDays),
+						Validators: []validator.Int64{
+							int64validator.Between(1, maxPITRRetentionDays),
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func (r *ServiceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ServiceResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ConnectionPooling != nil {
+		resp.Diagnostics.Append(validateServiceTypeScope(path.Root("connection_pooling"), data.Type, "postgresql")...)
+	}
+
+	if data.Maintenance != nil {
+		resp.Diagnostics.Append(validateServiceTypeScope(path.Root("maintenance"), data.Type, "postgresql")...)
+	}
+
+	if data.TLS != nil {
+		resp.Diagnostics.Append(validateServiceTypeScope(path.Root("tls"), data.Type, tlsServiceTypes...)...)
+	}
+
+	if data.Autoscaling != nil {
+		resp.Diagnostics.Append(validateServiceTypeScope(path.Root("autoscaling"), data.Type, autoscalableServiceTypes...)...)
+		resp.Diagnostics.Append(validateServiceAutoscaling(data.Autoscaling)...)
+	}
+
+	if data.Backup != nil {
+		resp.Diagnostics.Append(validateServiceBackup(data.Backup)...)
+		if !data.Backup.PITRRetentionDays.IsNull() {
+			resp.Diagnostics.Append(validateServiceTypeScope(path.Root("backup").AtName("pitr_retention_days"), data.Type, pitrServiceTypes...)...)
+		}
+	}
+
+	resp.Diagnostics.Append(validateServiceAlerts(data.Alerts)...)
+
+	for _, attr := range []struct {
+		name string
+		set  bool
+	}{
+		{"queue_connection", !data.QueueConnection.IsNull()},
+		{"queues", !data.Queues.IsNull()},
+	} {
+		if attr.set {
+			resp.Diagnostics.Append(validateServiceTypeScope(path.Root(attr.name), data.Type, "worker")...)
+		}
+	}
+
+	for _, attr := range []struct {
+		name string
+		set  bool
+	}{
+		{"read_replicas", !data.ReadReplicas.IsNull()},
+		{"read_replica_cpu_request", !data.ReadReplicaCPURequest.IsNull()},
+		{"read_replica_memory_request", !data.ReadReplicaMemoryRequest.IsNull()},
+	} {
+		if attr.set {
+			resp.Diagnostics.Append(validateServiceTypeScope(path.Root(attr.name), data.Type, "postgresql", "mysql")...)
+		}
+	}
+}
+
+// validateServiceTypeScope reports an error at attrPath when the configured
+// service type is not one of allowedTypes. Unknown types are not validated.
+func validateServiceTypeScope(attrPath path.Path, serviceType types.String, allowedTypes ...string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if serviceType.IsNull() || serviceType.IsUnknown() {
+		return diags
+	}
+
+	for _, allowed := range allowedTypes {
+		if serviceType.ValueString() == allowed {
+			return diags
+		}
+	}
+
+	diags.AddAttributeError(
+		attrPath,
+		"Unsupported Service Type",
+		fmt.Sprintf("%s is only supported for %s services, got %q", attrPath, strings.Join(allowedTypes, ", "), serviceType.ValueString()),
+	)
+	return diags
+}
+
+// validateServiceBackup checks that a KMS key is given exactly when
+// customer-managed backup encryption is selected.
+func validateServiceBackup(backup *ServiceBackupModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if backup.EncryptionMode.IsUnknown() || backup.KMSKey.IsUnknown() {
+		return diags
+	}
+
+	customerManaged := backup.EncryptionMode.ValueString() == "customer_managed"
+
+	if customerManaged && backup.KMSKey.ValueString() == "" {
+		diags.AddAttributeError(
+			path.Root("backup").AtName("kms_key"),
+			"Missing KMS Key",
+			"backup.kms_key is required when backup.encryption_mode is \"customer_managed\"",
+		)
+	}
+
+	if !customerManaged && !backup.KMSKey.IsNull() {
+		diags.AddAttributeError(
+			path.Root("backup").AtName("kms_key"),
+			"Unexpected KMS Key",
+			"backup.kms_key can only be set when backup.encryption_mode is \"customer_managed\"",
+		)
+	}
+
+	if customerManaged && !backup.EncryptionEnabled.IsNull() && !backup.EncryptionEnabled.IsUnknown() && !backup.EncryptionEnabled.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("backup").AtName("encryption_enabled"),
+			"Backup Encryption Disabled",
+			"backup.encryption_enabled must be true when backup.encryption_mode is \"customer_managed\"",
+		)
+	}
+
+	return diags
+}
+
+// validateServiceAlerts checks that percentage thresholds are at most 100 and
+// that every metric and comparison pair is used once. Unknown values are skipped.
+func validateServiceAlerts(alerts []ServiceAlertModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	seen := make(map[string]bool, len(alerts))
+	for i, alert := range alerts {
+		if alert.Metric.IsUnknown() {
+			continue
+		}
+		metric := alert.Metric.ValueString()
+
+		if strings.HasSuffix(metric, "_percent") && !alert.Threshold.IsUnknown() && alert.Threshold.ValueFloat64() > 100 {
+			diags.AddAttributeError(
+				path.Root("alerts").AtListIndex(i).AtName("threshold"),
+				"Invalid Alert Threshold",
+				fmt.Sprintf("threshold for %s is a percentage and must be between 0 and 100, got %g", metric, alert.Threshold.ValueFloat64()),
+			)
+		}
+
+		// The comparison defaults to gt when unset
+		if alert.Comparison.IsUnknown() {
+			continue
+		}
+		comparison := alert.Comparison.ValueString()
+		if alert.Comparison.IsNull() {
+			comparison = "gt"
+		}
+		key := serviceAlertKey(metric, comparison)
+		if seen[key] {
+			diags.AddAttributeError(
+				path.Root("alerts").AtListIndex(i),
+				"Duplicate Service Alert",
+				fmt.Sprintf("an alert for %s with comparison %s is already configured", metric, comparison),
+			)
+		}
+		seen[key] = true
+	}
+
+	return diags
+}
+
+// validateServiceAutoscaling checks that enabled autoscaling has replica
+// bounds and that min does not exceed max.
+func validateServiceAutoscaling(autoscaling *ServiceAutoscalingModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if autoscaling.Enabled.IsNull() || autoscaling.Enabled.ValueBool() {
+		for _, bound := range []struct {
+			name  string
+			value types.Int64
+		}{
+			{"min", autoscaling.Min},
+			{"max", autoscaling.Max},
+		} {
+			if bound.value.IsNull() {
+				diags.AddAttributeError(
+					path.Root("autoscaling").AtName(bound.name),
+					"Missing Autoscaling Bound",
+					fmt.Sprintf("autoscaling.%s is required when autoscaling is enabled", bound.name),
+				)
+			}
+		}
+	}
+
+	if autoscaling.Min.IsNull() || autoscaling.Min.IsUnknown() || autoscaling.Max.IsNull() || autoscaling.Max.IsUnknown() {
+		return diags
+	}
+
+	if autoscaling.Min.ValueInt64() > autoscaling.Max.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("autoscaling").AtName("min"),
+			"Invalid Autoscaling Bounds",
+			fmt.Sprintf("autoscaling.min (%d) must not be greater than autoscaling.max (%d)", autoscaling.Min.ValueInt64(), autoscaling.Max.ValueInt64()),
+		)
+	}
+
+	return diags
+}
+
 func (r *ServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -135,6 +728,8 @@ func (r *ServiceResource) Configure(ctx context.Context, req resource.ConfigureR
 }
 
 func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data ServiceResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -144,9 +739,22 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 
 	service := r.toAPIModel(&data)
 
-	created, err := r.client.CreateService(service)
+	if !data.DependsOn.IsNull() && !data.DependsOn.IsUnknown() {
+		var dependencies []int64
+		resp.Diagnostics.Append(data.DependsOn.ElementsAs(ctx, &dependencies, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := r.waitForDependencies(ctx, service.ApplicationID, dependencies); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("depends_on_services"), "Service Dependency Error", err.Error())
+			return
+		}
+	}
+
+	created, err := r.client.CreateService(ctx, service)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create service, got error: %s", err))
+		resp.Diagnostics.Append(clientErrorDiagnostics("create service", err, serviceAPIFieldPaths)...)
 		return
 	}
 
@@ -154,10 +762,30 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 	created.ApplicationID = service.ApplicationID
 	r.fromAPIModel(created, &data)
 
+	if len(data.Alerts) > 0 {
+		alerts, err := r.syncAlerts(ctx, created.ApplicationID, created.ID, data.Alerts)
+		if err != nil {
+			// Failing the create would replace the service, leave the alerts
+			// out of the state instead so the next apply configures them
+			resp.Diagnostics.AddWarning("Service Alerts Warning", fmt.Sprintf("Service created successfully, but configuring its alerts failed: %s", err))
+			data.Alerts = []ServiceAlertModel{}
+		} else {
+			data.Alerts = serviceAlertsFromAPI(alerts, data.Alerts)
+		}
+	}
+
+	if data.ExportCredentialsAsSecrets.ValueBool() && created.Connection != nil {
+		if _, err := r.exportCredentials(ctx, created.ApplicationID, created.Type, created.Connection); err != nil {
+			resp.Diagnostics.AddWarning("Credential Export Warning", fmt.Sprintf("Service created successfully, but exporting its credentials as secrets failed: %s", err))
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data ServiceResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -165,7 +793,7 @@ func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	service, err := r.client.GetService(data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
+	service, err := r.client.GetServiceDirect(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service, got error: %s", err))
 		return
@@ -178,10 +806,22 @@ func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	r.fromAPIModel(service, &data)
 
+	// Alerts are only tracked once managed by this resource
+	if len(data.Alerts) > 0 {
+		alerts, err := r.client.ListServiceAlerts(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service alerts, got error: %s", err))
+			return
+		}
+		data.Alerts = serviceAlertsFromAPI(alerts, data.Alerts)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data ServiceResourceModel
 	var state ServiceResourceModel
 
@@ -199,12 +839,20 @@ func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest
 	data.ID = state.ID
 	data.ApplicationID = state.ApplicationID
 
+	// Connection details only change on rotation, keep the known values
+	// otherwise since update responses may omit them
+	data.Host = state.Host
+	data.Port = state.Port
+	data.Username = state.Username
+	data.Password = state.Password
+	data.DatabaseName = state.DatabaseName
+
 	// Convert to API model and update
 	service := r.toAPIModel(&data)
 	
-	updated, err := r.client.UpdateService(data.ApplicationID.ValueInt64(), data.ID.ValueInt64(), service)
+	updated, err := r.client.UpdateService(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64(), service)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update service, got error: %s", err))
+		resp.Diagnostics.Append(clientErrorDiagnostics("update service", err, serviceAPIFieldPaths)...)
 		return
 	}
 
@@ -212,10 +860,36 @@ func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest
 	updated.ApplicationID = service.ApplicationID
 	r.fromAPIModel(updated, &data)
 
+	// Removing every alerts block deletes the alerts configured before
+	if len(data.Alerts) > 0 || len(state.Alerts) > 0 {
+		alerts, err := r.syncAlerts(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64(), data.Alerts)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update service alerts, got error: %s", err))
+			return
+		}
+		if len(data.Alerts) > 0 {
+			data.Alerts = serviceAlertsFromAPI(alerts, data.Alerts)
+		}
+	}
+
+	// Rotate credentials when the trigger value changed
+	if !data.RotateCredentials.IsNull() && !data.RotateCredentials.Equal(state.RotateCredentials) {
+		if err := r.rotateCredentials(ctx, &data); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rotate service credentials, got error: %s", err))
+			// Keep the old trigger so the next apply retries the rotation.
+			// The credentials only change when the rotation itself succeeded.
+			data.RotateCredentials = state.RotateCredentials
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data ServiceResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -223,34 +897,250 @@ func (r *ServiceResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err := r.client.DeleteService(data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
-	if err != nil {
+	err := r.client.DeleteService(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
+	// A service that is already gone counts as deleted
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete service, got error: %s", err))
 		return
 	}
 }
 
 func (r *ServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ".")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Invalid Import ID", "Import ID must be in the format 'application_id.service_id'")
+	applicationID, serviceID, err := parseImportID(req.ID, "Service")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
 		return
 	}
 
-	applicationID, err := strconv.ParseInt(parts[0], 10, 64)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), applicationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), serviceID)...)
+}
+
+// serviceAlertKey identifies an alert by metric and comparison, which are
+// unique per service
+func serviceAlertKey(metric, comparison string) string {
+	return metric + " " + comparison
+}
+
+// syncAlerts creates, updates and deletes the alerts of a service so they
+// match planned, and returns the planned alerts as reported by the API
+func (r *ServiceResource) syncAlerts(ctx context.Context, applicationID, serviceID int64, planned []ServiceAlertModel) ([]client.ServiceAlert, error) {
+	existing, err := r.client.ListServiceAlerts(ctx, applicationID, serviceID)
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid Import ID", "Application ID must be a valid integer")
-		return
+		return nil, err
+	}
+
+	unmatched := make(map[string]client.ServiceAlert, len(existing))
+	for _, alert := range existing {
+		unmatched[serviceAlertKey(alert.Metric, alert.Comparison)] = alert
+	}
+
+	synced := make([]client.ServiceAlert, 0, len(planned))
+	for _, model := range planned {
+		alert := &client.ServiceAlert{
+			Metric:          model.Metric.ValueString(),
+			Threshold:       model.Threshold.ValueFloat64(),
+			Comparison:      model.Comparison.ValueString(),
+			NotificationURL: model.NotificationURL.ValueString(),
+		}
+		key := serviceAlertKey(alert.Metric, alert.Comparison)
+		current, found := unmatched[key]
+		delete(unmatched, key)
+
+		result := &current
+		switch {
+		case !found:
+			result, err = r.client.CreateServiceAlert(ctx, applicationID, serviceID, alert)
+		// The API may not echo the notification URL, which also counts as changed
+		case current.Threshold != alert.Threshold || current.NotificationURL != alert.NotificationURL:
+			result, err = r.client.UpdateServiceAlert(ctx, applicationID, serviceID, current.ID, alert)
+		}
+		if err != nil {
+			return nil, err
+		}
+		synced = append(synced, *result)
+	}
+
+	for _, alert := range existing {
+		if _, stale := unmatched[serviceAlertKey(alert.Metric, alert.Comparison)]; !stale {
+			continue
+		}
+		if err := r.client.DeleteServiceAlert(ctx, applicationID, serviceID, alert.ID); err != nil && !client.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return synced, nil
+}
+
+// serviceAlertsFromAPI converts alerts to their models in the order of
+// planned, followed by alerts not in planned. Notification URLs the API does
+// not echo back keep their planned value.
+func serviceAlertsFromAPI(alerts []client.ServiceAlert, planned []ServiceAlertModel) []ServiceAlertModel {
+	plannedByKey := make(map[string]ServiceAlertModel, len(planned))
+	position := make(map[string]int, len(planned))
+	for i, model := range planned {
+		key := serviceAlertKey(model.Metric.ValueString(), model.Comparison.ValueString())
+		plannedByKey[key] = model
+		position[key] = i
 	}
 
-	serviceID, err := strconv.ParseInt(parts[1], 10, 64)
+	ordered := make([]client.ServiceAlert, len(alerts))
+	copy(ordered, alerts)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		pi, iPlanned := position[serviceAlertKey(ordered[i].Metric, ordered[i].Comparison)]
+		pj, jPlanned := position[serviceAlertKey(ordered[j].Metric, ordered[j].Comparison)]
+		if iPlanned && jPlanned {
+			return pi < pj
+		}
+		return iPlanned && !jPlanned
+	})
+
+	models := make([]ServiceAlertModel, 0, len(ordered))
+	for _, alert := range ordered {
+		planned := plannedByKey[serviceAlertKey(alert.Metric, alert.Comparison)]
+		models = append(models, ServiceAlertModel{
+			Metric:          types.StringValue(alert.Metric),
+			Threshold:       types.Float64Value(alert.Threshold),
+			Comparison:      types.StringValue(alert.Comparison),
+			NotificationURL: stringValueOrPlanned(alert.NotificationURL, planned.NotificationURL),
+		})
+	}
+	return models
+}
+
+// waitForDependencies blocks until every referenced service in the same
+// application reports a running status. Services that belong to another
+// application, or that end up in a failed state, are reported as errors.
+func (r *ServiceResource) waitForDependencies(ctx context.Context, applicationID int64, serviceIDs []int64) error {
+	interval := r.dependencyPollInterval
+	if interval <= 0 {
+		interval = defaultDependencyPollInterval
+	}
+	timeout := r.dependencyTimeout
+	if timeout <= 0 {
+		timeout = defaultDependencyTimeout
+	}
+	deadline := time.Now().Add(timeout)
+
+	pending := make([]int64, len(serviceIDs))
+	copy(pending, serviceIDs)
+
+	for {
+		var notReady []int64
+		for _, serviceID := range pending {
+			service, err := r.client.GetService(ctx, applicationID, serviceID)
+			if err != nil {
+				return fmt.Errorf("unable to read dependency service %d: %w", serviceID, err)
+			}
+			if service == nil {
+				return fmt.Errorf("service %d does not belong to application %d", serviceID, applicationID)
+			}
+
+			switch service.Status {
+			case "running":
+				// Ready
+			case "failed", "error":
+				return fmt.Errorf("dependency service %d is in status '%s'", serviceID, service.Status)
+			default:
+				notReady = append(notReady, serviceID)
+			}
+		}
+
+		if len(notReady) == 0 {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %v waiting for dependency services %v to become ready", timeout, notReady)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+		pending = notReady
+	}
+}
+
+// credentialSecretKeys maps a service type to the secret keys its username and
+// password are exported under. An empty key means the value is not exported.
+var credentialSecretKeys = map[string][2]string{
+	"mysql":      {"DB_USERNAME", "DB_PASSWORD"},
+	"postgresql": {"DB_USERNAME", "DB_PASSWORD"},
+	"mongodb":    {"MONGODB_USERNAME", "MONGODB_PASSWORD"},
+	"redis":      {"", "REDIS_PASSWORD"},
+	"valkey":     {"", "REDIS_PASSWORD"},
+	"rabbitmq":   {"RABBITMQ_USER", "RABBITMQ_PASSWORD"},
+	"minio":      {"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY"},
+	"sftp":       {"SFTP_USERNAME", "SFTP_PASSWORD"},
+}
+
+// rotateCredentials rotates the service credentials, stores them in the model
+// and, when requested, exports them as application secrets and restarts the
+// application so the running pods pick up the new values.
+func (r *ServiceResource) rotateCredentials(ctx context.Context, data *ServiceResourceModel) error {
+	applicationID := data.ApplicationID.ValueInt64()
+
+	connection, err := r.client.RotateServiceCredentials(ctx, applicationID, data.ID.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid Import ID", "Service ID must be a valid integer")
-		return
+		return err
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), applicationID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), serviceID)...)
+	data.Username = types.StringValue(connection.Username)
+	data.Password = types.StringValue(connection.Password)
+
+	if !data.ExportCredentialsAsSecrets.ValueBool() {
+		return nil
+	}
+
+	// Without the batch endpoint secrets are written one call at a time, so a
+	// failure can leave some keys with the new credentials. The restart is
+	// skipped in that case.
+	written, err := r.exportCredentials(ctx, applicationID, data.Type.ValueString(), connection)
+	if err != nil {
+		updated := "none"
+		if len(written) > 0 {
+			updated = strings.Join(written, ", ")
+		}
+		return fmt.Errorf("credentials were rotated but could not be exported as secrets, so the application was not restarted (secrets already updated: %s): %w", updated, err)
+	}
+
+	if _, err := r.client.DeployApplication(ctx, applicationID); err != nil {
+		return fmt.Errorf("credentials were rotated and exported but the application restart failed: %w", err)
+	}
+
+	return nil
+}
+
+// exportCredentials writes the service credentials to the application's secrets,
+// creating the secret keys when they do not exist yet. It returns the keys
+// written before any error.
+func (r *ServiceResource) exportCredentials(ctx context.Context, applicationID int64, serviceType string, connection *client.ServiceConnection) ([]string, error) {
+	keys, ok := credentialSecretKeys[serviceType]
+	if !ok {
+		return nil, fmt.Errorf("service type '%s' does not expose credentials", serviceType)
+	}
+
+	values := map[string]string{}
+	if keys[0] != "" {
+		values[keys[0]] = connection.Username
+	}
+	if keys[1] != "" {
+		values[keys[1]] = connection.Password
+	}
+
+	err := r.client.SetSecrets(ctx, applicationID, values)
+	var secretsErr *client.SecretsError
+	if errors.As(err, &secretsErr) {
+		return secretsErr.Applied, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return sortedKeys(values), nil
 }
 
 func (r *ServiceResource) toAPIModel(data *ServiceResourceModel) *client.ApplicationService {
@@ -287,6 +1177,16 @@ func (r *ServiceResource) toAPIModel(data *ServiceResourceModel) *client.Applica
 		settingsMap["command"] = data.Command.ValueString()
 	}
 
+	// Queue selection for worker services, passed to queue:work as --queue and connection
+	if service.Type == "worker" && !data.QueueConnection.IsNull() && !data.QueueConnection.IsUnknown() {
+		settingsMap["queue_connection"] = data.QueueConnection.ValueString()
+	}
+	if service.Type == "worker" && !data.Queues.IsNull() && !data.Queues.IsUnknown() {
+		var queues []string
+		data.Queues.ElementsAs(context.Background(), &queues, false)
+		settingsMap["queues"] = strings.Join(queues, ",")
+	}
+
 	// Set the settings object
 	service.Settings = client.FlexibleSettingsFromMap(settingsMap)
 
@@ -311,6 +1211,96 @@ func (r *ServiceResource) toAPIModel(data *ServiceResourceModel) *client.Applica
 		service.Command = data.Command.ValueString()
 	}
 
+	if data.ConnectionPooling != nil {
+		pooling := &client.ConnectionPooling{
+			Enabled: true,
+		}
+		if !data.ConnectionPooling.Enabled.IsNull() && !data.ConnectionPooling.Enabled.IsUnknown() {
+			pooling.Enabled = data.ConnectionPooling.Enabled.ValueBool()
+		}
+		if !data.ConnectionPooling.Mode.IsNull() && data.ConnectionPooling.Mode.ValueString() != "" {
+			pooling.Mode = data.ConnectionPooling.Mode.ValueString()
+		}
+		if !data.ConnectionPooling.PoolSize.IsNull() && !data.ConnectionPooling.PoolSize.IsUnknown() {
+			pooling.PoolSize = data.ConnectionPooling.PoolSize.ValueInt64()
+		}
+		service.ConnectionPooling = pooling
+	}
+
+	if data.Maintenance != nil {
+		maintenance := &client.ServiceMaintenance{}
+		if !data.Maintenance.VacuumSchedule.IsNull() && !data.Maintenance.VacuumSchedule.IsUnknown() {
+			maintenance.VacuumSchedule = data.Maintenance.VacuumSchedule.ValueString()
+		}
+		if !data.Maintenance.AnalyzeSchedule.IsNull() && !data.Maintenance.AnalyzeSchedule.IsUnknown() {
+			maintenance.AnalyzeSchedule = data.Maintenance.AnalyzeSchedule.ValueString()
+		}
+		service.Maintenance = maintenance
+	}
+
+	if data.TLS != nil {
+		tls := &client.ServiceTLS{
+			Enabled: true,
+			Mode:    "require",
+		}
+		if !data.TLS.Enabled.IsNull() && !data.TLS.Enabled.IsUnknown() {
+			tls.Enabled = data.TLS.Enabled.ValueBool()
+		}
+		if !data.TLS.Mode.IsNull() && data.TLS.Mode.ValueString() != "" {
+			tls.Mode = data.TLS.Mode.ValueString()
+		}
+		if !data.TLS.CACert.IsNull() && !data.TLS.CACert.IsUnknown() {
+			tls.CACert = data.TLS.CACert.ValueString()
+		}
+		service.TLS = tls
+	}
+
+	if data.Autoscaling != nil {
+		autoscaling := &client.ServiceAutoscaling{
+			Enabled: true,
+		}
+		if !data.Autoscaling.Enabled.IsNull() && !data.Autoscaling.Enabled.IsUnknown() {
+			autoscaling.Enabled = data.Autoscaling.Enabled.ValueBool()
+		}
+		if !data.Autoscaling.Min.IsNull() && !data.Autoscaling.Min.IsUnknown() {
+			autoscaling.Min = data.Autoscaling.Min.ValueInt64()
+		}
+		if !data.Autoscaling.Max.IsNull() && !data.Autoscaling.Max.IsUnknown() {
+			autoscaling.Max = data.Autoscaling.Max.ValueInt64()
+		}
+		if !data.Autoscaling.TargetCPU.IsNull() && !data.Autoscaling.TargetCPU.IsUnknown() {
+			autoscaling.TargetCPU = data.Autoscaling.TargetCPU.ValueInt64()
+		}
+		service.Autoscaling = autoscaling
+	}
+
+	if data.Backup != nil {
+		backup := &client.ServiceBackup{
+			EncryptionMode: "platform",
+		}
+		if !data.Backup.EncryptionEnabled.IsNull() && !data.Backup.EncryptionEnabled.IsUnknown() {
+			backup.EncryptionEnabled = data.Backup.EncryptionEnabled.ValueBool()
+		}
+		if !data.Backup.EncryptionMode.IsNull() && data.Backup.EncryptionMode.ValueString() != "" {
+			backup.EncryptionMode = data.Backup.EncryptionMode.ValueString()
+		}
+		if !data.Backup.KMSKey.IsNull() && !data.Backup.KMSKey.IsUnknown() {
+			backup.KMSKey = data.Backup.KMSKey.ValueString()
+		}
+		if !data.Backup.PITRRetentionDays.IsNull() && !data.Backup.PITRRetentionDays.IsUnknown() {
+			backup.PITRRetentionDays = data.Backup.PITRRetentionDays.ValueInt64()
+		}
+		service.Backup = backup
+	}
+
+	if !data.ReadReplicas.IsNull() && !data.ReadReplicas.IsUnknown() {
+		service.ReadReplicas = &client.ReadReplicas{
+			Count:         data.ReadReplicas.ValueInt64(),
+			CPURequest:    data.ReadReplicaCPURequest.ValueString(),
+			MemoryRequest: data.ReadReplicaMemoryRequest.ValueString(),
+		}
+	}
+
 	return service
 }
 
@@ -413,6 +1403,19 @@ func (r *ServiceResource) fromAPIModel(service *client.ApplicationService, data
 		}
 	}
 
+	// Queue selection is stored in settings; keep planned values when the API omits it
+	workerSettings := service.Settings.ToMap()
+	if connection, ok := workerSettings["queue_connection"]; ok && connection != "" {
+		data.QueueConnection = types.StringValue(connection)
+	} else if data.QueueConnection.IsUnknown() {
+		data.QueueConnection = types.StringNull()
+	}
+	if queues, ok := workerSettings["queues"]; ok && queues != "" {
+		data.Queues, _ = types.ListValueFrom(context.Background(), types.StringType, strings.Split(queues, ","))
+	} else if data.Queues.IsNull() || data.Queues.IsUnknown() {
+		data.Queues = types.ListNull(types.StringType)
+	}
+
 	if len(service.Settings) > 0 {
 		settingsMap := make(map[string]types.String)
 		for k, v := range service.Settings.ToMap() {
@@ -420,4 +1423,117 @@ func (r *ServiceResource) fromAPIModel(service *client.ApplicationService, data
 		}
 		data.Settings, _ = types.MapValueFrom(context.Background(), types.StringType, settingsMap)
 	}
-}
\ No newline at end of file
+
+	// Credentials are only returned by some endpoints, keep the known values otherwise
+	if service.Connection != nil {
+		data.Host = types.StringValue(service.Connection.Host)
+		data.Port = types.Int64Value(service.Connection.Port)
+		data.Username = types.StringValue(service.Connection.Username)
+		data.Password = types.StringValue(service.Connection.Password)
+		data.DatabaseName = types.StringValue(service.Connection.Database)
+	} else {
+		if data.Host.IsUnknown() {
+			data.Host = types.StringNull()
+		}
+		if data.Port.IsUnknown() {
+			data.Port = types.Int64Null()
+		}
+		if data.Username.IsUnknown() {
+			data.Username = types.StringNull()
+		}
+		if data.Password.IsUnknown() {
+			data.Password = types.StringNull()
+		}
+		if data.DatabaseName.IsUnknown() {
+			data.DatabaseName = types.StringNull()
+		}
+	}
+	// Only track connection pooling when it is configured
+	if data.ConnectionPooling != nil && service.ConnectionPooling != nil {
+		data.ConnectionPooling.Enabled = types.BoolValue(service.ConnectionPooling.Enabled)
+		if service.ConnectionPooling.Mode != "" {
+			data.ConnectionPooling.Mode = types.StringValue(service.ConnectionPooling.Mode)
+		}
+		if service.ConnectionPooling.PoolSize > 0 {
+			data.ConnectionPooling.PoolSize = types.Int64Value(service.ConnectionPooling.PoolSize)
+		}
+	}
+	// Only track maintenance schedules when they are configured
+	if data.Maintenance != nil && service.Maintenance != nil {
+		if service.Maintenance.VacuumSchedule != "" {
+			data.Maintenance.VacuumSchedule = types.StringValue(service.Maintenance.VacuumSchedule)
+		}
+		if service.Maintenance.AnalyzeSchedule != "" {
+			data.Maintenance.AnalyzeSchedule = types.StringValue(service.Maintenance.AnalyzeSchedule)
+		}
+	}
+	// Only track TLS settings when they are configured. The CA certificate is
+	// kept as configured when the API does not echo it back.
+	if data.TLS != nil && service.TLS != nil {
+		data.TLS.Enabled = types.BoolValue(service.TLS.Enabled)
+		if service.TLS.Mode != "" {
+			data.TLS.Mode = types.StringValue(service.TLS.Mode)
+		}
+		if service.TLS.CACert != "" {
+			data.TLS.CACert = types.StringValue(service.TLS.CACert)
+		}
+	}
+	if data.TLS != nil && data.TLS.CACert.IsUnknown() {
+		data.TLS.CACert = types.StringNull()
+	}
+	// Only track autoscaling when it is configured
+	if data.Autoscaling != nil && service.Autoscaling != nil {
+		data.Autoscaling.Enabled = types.BoolValue(service.Autoscaling.Enabled)
+		if service.Autoscaling.Min > 0 {
+			data.Autoscaling.Min = types.Int64Value(service.Autoscaling.Min)
+		}
+		if service.Autoscaling.Max > 0 {
+			data.Autoscaling.Max = types.Int64Value(service.Autoscaling.Max)
+		}
+		if service.Autoscaling.TargetCPU > 0 {
+			data.Autoscaling.TargetCPU = types.Int64Value(service.Autoscaling.TargetCPU)
+		}
+	}
+	// Only track backup settings when they are configured. The KMS key is never
+	// returned by the API, so the configured reference is kept as-is.
+	if data.Backup != nil && service.Backup != nil {
+		data.Backup.EncryptionEnabled = types.BoolValue(service.Backup.EncryptionEnabled)
+		if service.Backup.EncryptionMode != "" {
+			data.Backup.EncryptionMode = types.StringValue(service.Backup.EncryptionMode)
+		}
+		if data.Backup.KMSKey.IsUnknown() {
+			data.Backup.KMSKey = types.StringNull()
+		}
+		if service.Backup.PITRRetentionDays > 0 {
+			data.Backup.PITRRetentionDays = types.Int64Value(service.Backup.PITRRetentionDays)
+		} else if data.Backup.PITRRetentionDays.IsUnknown() {
+			data.Backup.PITRRetentionDays = types.Int64Null()
+		}
+	}
+	// Read replicas - only read back what is configured, preserve planned
+	// values when the API does not report them
+	if !data.ReadReplicas.IsNull() && service.ReadReplicas != nil {
+		data.ReadReplicas = types.Int64Value(service.ReadReplicas.Count)
+		if !data.ReadReplicaCPURequest.IsNull() && service.ReadReplicas.CPURequest != "" {
+			data.ReadReplicaCPURequest = types.StringValue(service.ReadReplicas.CPURequest)
+		}
+		if !data.ReadReplicaMemoryRequest.IsNull() && service.ReadReplicas.MemoryRequest != "" {
+			data.ReadReplicaMemoryRequest = types.StringValue(service.ReadReplicas.MemoryRequest)
+		}
+	}
+	if data.ReadReplicas.IsUnknown() {
+		data.ReadReplicas = types.Int64Null()
+	}
+	if data.ReadReplicaCPURequest.IsUnknown() {
+		data.ReadReplicaCPURequest = types.StringNull()
+	}
+	if data.ReadReplicaMemoryRequest.IsUnknown() {
+		data.ReadReplicaMemoryRequest = types.StringNull()
+	}
+
+	if len(service.ReadReplicaEndpoints) > 0 {
+		data.ReadReplicaEndpoints, _ = types.ListValueFrom(context.Background(), types.StringType, service.ReadReplicaEndpoints)
+	} else {
+		data.ReadReplicaEndpoints = types.ListNull(types.StringType)
+	}
+}
@@ -2,16 +2,24 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/ploi/terraform-provider-ploicloud/internal/client"
 )
 
@@ -513,7 +521,7 @@ func TestServiceResource_APIClientIntegration(t *testing.T) {
 		Extensions:    []string{"uuid-ossp", "pgcrypto"},
 	}
 	
-	created, err := c.CreateService(service)
+	created, err := c.CreateService(context.Background(), service)
 	if err != nil {
 		t.Fatalf("Failed to create service: %v", err)
 	}
@@ -613,4 +621,1597 @@ func TestServiceResource_CRUDOperations(t *testing.T) {
 	if !reflect.DeepEqual(retrieved.Extensions, []string{"uuid-ossp", "pgcrypto"}) {
 		t.Errorf("Expected extensions ['uuid-ossp', 'pgcrypto'], got %v", retrieved.Extensions)
 	}
-}
\ No newline at end of file
+}
+func TestServiceResource_DependsOnServices_WaitsForReadiness(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/applications/1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requestCount++
+
+		// The database becomes ready on the third poll, the cache is ready immediately
+		dbStatus := "creating"
+		if requestCount >= 3 {
+			dbStatus = "running"
+		}
+		fmt.Fprintf(w, `{"data": {"id": 1, "name": "app", "application_type": "laravel", "services": [
+			{"id": 10, "type": "postgresql", "status": "%s"},
+			{"id": 11, "type": "redis", "status": "running"}
+		]}}`, dbStatus)
+	}))
+	defer server.Close()
+
+	r := &ServiceResource{
+		client:                 client.NewClient("test-token", &server.URL),
+		dependencyPollInterval: time.Millisecond,
+		dependencyTimeout:      time.Second,
+	}
+
+	if err := r.waitForDependencies(context.Background(), 1, []int64{10, 11}); err != nil {
+		t.Fatalf("Expected dependencies to become ready, got error: %v", err)
+	}
+
+	// First poll checks both services, the second only re-checks the pending database
+	if requestCount != 3 {
+		t.Errorf("Expected 3 application reads, got %d", requestCount)
+	}
+}
+
+func TestServiceResource_DependsOnServices_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/applications/1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"data": {"id": 1, "name": "app", "application_type": "laravel", "services": [
+			{"id": 10, "type": "postgresql", "status": "creating"},
+			{"id": 12, "type": "mysql", "status": "failed"}
+		]}}`))
+	}))
+	defer server.Close()
+
+	r := &ServiceResource{
+		client:                 client.NewClient("test-token", &server.URL),
+		dependencyPollInterval: time.Millisecond,
+		dependencyTimeout:      20 * time.Millisecond,
+	}
+
+	tests := []struct {
+		name          string
+		dependencies  []int64
+		expectedError string
+	}{
+		{
+			name:          "service from another application",
+			dependencies:  []int64{99},
+			expectedError: "does not belong to application 1",
+		},
+		{
+			name:          "failed dependency",
+			dependencies:  []int64{12},
+			expectedError: "is in status 'failed'",
+		},
+		{
+			name:          "dependency never becomes ready",
+			dependencies:  []int64{10},
+			expectedError: "timed out",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := r.waitForDependencies(context.Background(), 1, tt.dependencies)
+			if err == nil {
+				t.Fatal("Expected an error but got nil")
+			}
+			if !strings.Contains(err.Error(), tt.expectedError) {
+				t.Errorf("Expected error containing '%s', got '%s'", tt.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestServiceResource_RotateCredentials(t *testing.T) {
+	var requests []string
+	var failSecret string
+	secrets := map[string]string{"DB_USERNAME": "old-user"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/applications/1/services/5/rotate-credentials":
+			w.Write([]byte(`{"data": {"username": "app", "password": "rotated"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/applications/1/secrets":
+			var items []string
+			for k, v := range secrets {
+				items = append(items, fmt.Sprintf(`{"key": "%s", "value": "%s"}`, k, v))
+			}
+			fmt.Fprintf(w, `{"data": [%s]}`, strings.Join(items, ","))
+		case r.Method == http.MethodPost && r.URL.Path == "/applications/1/secrets",
+			r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/applications/1/secrets/"):
+			var secret client.ApplicationSecret
+			json.NewDecoder(r.Body).Decode(&secret)
+			if secret.Key == failSecret {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"message": "The value is invalid."}`))
+				return
+			}
+			secrets[secret.Key] = secret.Value
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, `{"data": {"key": "%s", "value": "%s"}}`, secret.Key, secret.Value)
+		case r.Method == http.MethodPost && r.URL.Path == "/applications/1/deploy":
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &ServiceResource{client: client.NewClient("test-token", &server.URL)}
+
+	t.Run("rotation without export only rotates", func(t *testing.T) {
+		requests = nil
+		data := &ServiceResourceModel{
+			ID:                         types.Int64Value(5),
+			ApplicationID:              types.Int64Value(1),
+			Type:                       types.StringValue("postgresql"),
+			ExportCredentialsAsSecrets: types.BoolNull(),
+		}
+
+		if err := r.rotateCredentials(context.Background(), data); err != nil {
+			t.Fatalf("Expected rotation to succeed, got error: %v", err)
+		}
+		if !data.Password.Equal(types.StringValue("rotated")) {
+			t.Errorf("Expected password 'rotated', got %v", data.Password)
+		}
+		if len(requests) != 1 {
+			t.Errorf("Expected a single rotate request, got %v", requests)
+		}
+	})
+
+	t.Run("rotation with export updates secrets and restarts", func(t *testing.T) {
+		requests = nil
+		data := &ServiceResourceModel{
+			ID:                         types.Int64Value(5),
+			ApplicationID:              types.Int64Value(1),
+			Type:                       types.StringValue("postgresql"),
+			ExportCredentialsAsSecrets: types.BoolValue(true),
+		}
+
+		if err := r.rotateCredentials(context.Background(), data); err != nil {
+			t.Fatalf("Expected rotation to succeed, got error: %v", err)
+		}
+
+		if secrets["DB_USERNAME"] != "app" || secrets["DB_PASSWORD"] != "rotated" {
+			t.Errorf("Expected secrets to hold the rotated credentials, got %v", secrets)
+		}
+
+		// The existing key is updated, the missing key is created, and the restart happens last
+		if requests[len(requests)-1] != "POST /applications/1/deploy" {
+			t.Errorf("Expected the application restart to be the final request, got %v", requests)
+		}
+		expectedWrites := []string{"PUT /applications/1/secrets/DB_USERNAME", "POST /applications/1/secrets"}
+		for _, expected := range expectedWrites {
+			found := false
+			for _, req := range requests {
+				if req == expected {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("Expected request '%s', got %v", expected, requests)
+			}
+		}
+	})
+
+	t.Run("failed export stops before the restart and names the written keys", func(t *testing.T) {
+		requests = nil
+		failSecret = "DB_PASSWORD"
+		defer func() { failSecret = "" }()
+		data := &ServiceResourceModel{
+			ID:                         types.Int64Value(5),
+			ApplicationID:              types.Int64Value(1),
+			Type:                       types.StringValue("postgresql"),
+			ExportCredentialsAsSecrets: types.BoolValue(true),
+		}
+
+		err := r.rotateCredentials(context.Background(), data)
+		if err == nil {
+			t.Fatal("Expected the failed export to be reported")
+		}
+		if !strings.Contains(err.Error(), "secrets already updated: DB_USERNAME") || !strings.Contains(err.Error(), "unable to write secret DB_PASSWORD") {
+			t.Errorf("Expected the error to name the written and failed keys, got %q", err)
+		}
+		for _, req := range requests {
+			if req == "POST /applications/1/deploy" {
+				t.Errorf("Expected no application restart after a failed export, got %v", requests)
+			}
+		}
+		// The rotation itself happened, so the new credentials are kept
+		if !data.Password.Equal(types.StringValue("rotated")) {
+			t.Errorf("Expected password 'rotated', got %v", data.Password)
+		}
+	})
+}
+
+func TestServiceResource_ConnectionCredentials(t *testing.T) {
+	r := &ServiceResource{}
+
+	var service client.ApplicationService
+	body := `{"id": 5, "application_id": 1, "type": "postgresql", "connection": {"host": "db-5.internal", "port": 5432, "username": "app", "password": "s3cret", "database": "app_production"}}`
+	if err := json.Unmarshal([]byte(body), &service); err != nil {
+		t.Fatalf("Failed to decode service: %v", err)
+	}
+
+	data := &ServiceResourceModel{
+		Host:         types.StringUnknown(),
+		Port:         types.Int64Unknown(),
+		Username:     types.StringUnknown(),
+		Password:     types.StringUnknown(),
+		DatabaseName: types.StringUnknown(),
+	}
+	r.fromAPIModel(&service, data)
+
+	expected := map[string]attr.Value{
+		"host":          types.StringValue("db-5.internal"),
+		"port":          types.Int64Value(5432),
+		"username":      types.StringValue("app"),
+		"password":      types.StringValue("s3cret"),
+		"database_name": types.StringValue("app_production"),
+	}
+	actual := map[string]attr.Value{
+		"host":          data.Host,
+		"port":          data.Port,
+		"username":      data.Username,
+		"password":      data.Password,
+		"database_name": data.DatabaseName,
+	}
+	for name, value := range expected {
+		if !actual[name].Equal(value) {
+			t.Errorf("Expected %s %v, got %v", name, value, actual[name])
+		}
+	}
+
+	// Responses without credentials keep known values and resolve unknown ones to null
+	data.Port = types.Int64Unknown()
+	r.fromAPIModel(&client.ApplicationService{ID: 5, ApplicationID: 1, Type: "postgresql"}, data)
+	if !data.Host.Equal(types.StringValue("db-5.internal")) || !data.Password.Equal(types.StringValue("s3cret")) {
+		t.Errorf("Expected known credentials to be kept, got host %v and password %v", data.Host, data.Password)
+	}
+	if !data.Port.IsNull() {
+		t.Errorf("Expected unknown port to become null, got %v", data.Port)
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	for name, sensitive := range map[string]bool{"host": false, "port": false, "username": true, "password": true, "database_name": false} {
+		attribute := schemaResp.Schema.Attributes[name]
+		if attribute == nil || !attribute.IsComputed() || attribute.IsSensitive() != sensitive {
+			t.Errorf("Expected %s to be computed with sensitive %v, got %+v", name, sensitive, attribute)
+		}
+	}
+}
+
+// TestServiceResource_Update_KeepsConnection tests that an update whose
+// response omits the connection keeps the connection details from state
+func TestServiceResource_Update_KeepsConnection(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"id": 5, "application_id": 1, "name": "postgres", "type": "postgresql", "version": "16", "replicas": 2}}`))
+	}))
+	defer server.Close()
+
+	r := &ServiceResource{client: client.NewClient("test-token", &server.URL, client.WithRetriesDisabled())}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := func(connection func(name string) tftypes.Value, replicas int) tftypes.Value {
+		v := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			v[name] = tftypes.NewValue(attrType, nil)
+		}
+		v["id"] = tftypes.NewValue(tftypes.Number, 5)
+		v["application_id"] = tftypes.NewValue(tftypes.Number, 1)
+		v["service_name"] = tftypes.NewValue(tftypes.String, "postgres")
+		v["type"] = tftypes.NewValue(tftypes.String, "postgresql")
+		v["version"] = tftypes.NewValue(tftypes.String, "16")
+		v["replicas"] = tftypes.NewValue(tftypes.Number, replicas)
+		for _, name := range []string{"host", "port", "username", "password", "database_name"} {
+			v[name] = connection(name)
+		}
+		return tftypes.NewValue(objectType, v)
+	}
+	known := map[string]tftypes.Value{
+		"host":          tftypes.NewValue(tftypes.String, "db-5.internal"),
+		"port":          tftypes.NewValue(tftypes.Number, 5432),
+		"username":      tftypes.NewValue(tftypes.String, "app"),
+		"password":      tftypes.NewValue(tftypes.String, "s3cret"),
+		"database_name": tftypes.NewValue(tftypes.String, "app_production"),
+	}
+	unknown := func(name string) tftypes.Value {
+		return tftypes.NewValue(objectType.AttributeTypes[name], tftypes.UnknownValue)
+	}
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: values(func(name string) tftypes.Value { return known[name] }, 1)}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: values(unknown, 2)}
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var data ServiceResourceModel
+	resp.State.Get(ctx, &data)
+	actual := map[string]attr.Value{
+		"host":          data.Host,
+		"port":          data.Port,
+		"username":      data.Username,
+		"password":      data.Password,
+		"database_name": data.DatabaseName,
+	}
+	expected := map[string]attr.Value{
+		"host":          types.StringValue("db-5.internal"),
+		"port":          types.Int64Value(5432),
+		"username":      types.StringValue("app"),
+		"password":      types.StringValue("s3cret"),
+		"database_name": types.StringValue("app_production"),
+	}
+	for name, value := range expected {
+		if !actual[name].Equal(value) {
+			t.Errorf("Expected %s %v to survive the update, got %v", name, value, actual[name])
+		}
+	}
+
+	// Plans keep the known host, port and database name instead of showing them as unknown
+	for _, name := range []string{"host", "port", "database_name"} {
+		switch attribute := schemaResp.Schema.Attributes[name].(type) {
+		case schema.StringAttribute:
+			if len(attribute.PlanModifiers) == 0 {
+				t.Errorf("Expected %s to use the state for unknown values", name)
+			}
+		case schema.Int64Attribute:
+			if len(attribute.PlanModifiers) == 0 {
+				t.Errorf("Expected %s to use the state for unknown values", name)
+			}
+		}
+	}
+}
+
+func TestServiceResource_Update_RotateFailure(t *testing.T) {
+	ctx := context.Background()
+	var rotateFails bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/applications/1/services/5/rotate-credentials":
+			if rotateFails {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"message": "Rotation is unavailable."}`))
+				return
+			}
+			w.Write([]byte(`{"data": {"username": "app", "password": "rotated"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/applications/1/secrets":
+			w.Write([]byte(`{"data": []}`))
+		case strings.HasPrefix(r.URL.Path, "/applications/1/secrets"):
+			// No batch endpoint, and every single write fails
+			if r.Method == http.MethodPut {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"message": "The value is invalid."}`))
+		default:
+			w.Write([]byte(`{"data": {"id": 5, "application_id": 1, "name": "postgres", "type": "postgresql", "version": "16", "replicas": 1}}`))
+		}
+	}))
+	defer server.Close()
+
+	r := &ServiceResource{client: client.NewClient("test-token", &server.URL, client.WithRetriesDisabled())}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := func(trigger string) tftypes.Value {
+		v := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			v[name] = tftypes.NewValue(attrType, nil)
+		}
+		v["id"] = tftypes.NewValue(tftypes.Number, 5)
+		v["application_id"] = tftypes.NewValue(tftypes.Number, 1)
+		v["service_name"] = tftypes.NewValue(tftypes.String, "postgres")
+		v["type"] = tftypes.NewValue(tftypes.String, "postgresql")
+		v["version"] = tftypes.NewValue(tftypes.String, "16")
+		v["replicas"] = tftypes.NewValue(tftypes.Number, 1)
+		v["username"] = tftypes.NewValue(tftypes.String, "app")
+		v["password"] = tftypes.NewValue(tftypes.String, "s3cret")
+		v["export_credentials_as_secrets"] = tftypes.NewValue(tftypes.Bool, true)
+		v["rotate_credentials"] = tftypes.NewValue(tftypes.String, trigger)
+		return tftypes.NewValue(objectType, v)
+	}
+
+	tests := []struct {
+		name             string
+		rotateFails      bool
+		expectedPassword string
+	}{
+		{"rotate API fails", true, "s3cret"},
+		{"export fails", false, "rotated"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rotateFails = tt.rotateFails
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: values("v1")}
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: values("v2")}
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+			if !resp.Diagnostics.HasError() {
+				t.Fatal("Expected the failed rotation to be reported")
+			}
+
+			var data ServiceResourceModel
+			resp.State.Get(ctx, &data)
+			// The old trigger stays in state so the next apply retries
+			if !data.RotateCredentials.Equal(types.StringValue("v1")) {
+				t.Errorf("Expected rotate_credentials to stay 'v1', got %v", data.RotateCredentials)
+			}
+			if !data.Password.Equal(types.StringValue(tt.expectedPassword)) {
+				t.Errorf("Expected password %q, got %v", tt.expectedPassword, data.Password)
+			}
+		})
+	}
+}
+
+func TestServiceResource_ConnectionPooling_Mapping(t *testing.T) {
+	r := &ServiceResource{}
+
+	data := &ServiceResourceModel{
+		ApplicationID: types.Int64Value(1),
+		Type:          types.StringValue("postgresql"),
+		Settings:      types.MapNull(types.StringType),
+		Extensions:    types.ListNull(types.StringType),
+		ConnectionPooling: &ConnectionPoolingModel{
+			Enabled:  types.BoolValue(true),
+			Mode:     types.StringValue("session"),
+			PoolSize: types.Int64Value(40),
+		},
+	}
+
+	service := r.toAPIModel(data)
+	expected := &client.ConnectionPooling{Enabled: true, Mode: "session", PoolSize: 40}
+	if !reflect.DeepEqual(service.ConnectionPooling, expected) {
+		t.Errorf("Expected pooling %+v, got %+v", expected, service.ConnectionPooling)
+	}
+
+	data.ConnectionPooling = nil
+	if service := r.toAPIModel(data); service.ConnectionPooling != nil {
+		t.Errorf("Expected pooling to be omitted, got %+v", service.ConnectionPooling)
+	}
+
+	// Read back only updates a configured block and keeps the planned pool size
+	data.ConnectionPooling = &ConnectionPoolingModel{
+		Enabled:  types.BoolValue(true),
+		Mode:     types.StringValue("transaction"),
+		PoolSize: types.Int64Value(20),
+	}
+	r.fromAPIModel(&client.ApplicationService{
+		ID:                5,
+		ApplicationID:     1,
+		Type:              "postgresql",
+		ConnectionPooling: &client.ConnectionPooling{Enabled: false, Mode: "session"},
+	}, data)
+
+	if !data.ConnectionPooling.Enabled.Equal(types.BoolValue(false)) {
+		t.Errorf("Expected enabled false from API, got %v", data.ConnectionPooling.Enabled)
+	}
+	if !data.ConnectionPooling.Mode.Equal(types.StringValue("session")) {
+		t.Errorf("Expected mode session from API, got %v", data.ConnectionPooling.Mode)
+	}
+	if !data.ConnectionPooling.PoolSize.Equal(types.Int64Value(20)) {
+		t.Errorf("Expected planned pool size to be preserved, got %v", data.ConnectionPooling.PoolSize)
+	}
+
+	data.ConnectionPooling = nil
+	r.fromAPIModel(&client.ApplicationService{
+		ID:                5,
+		ApplicationID:     1,
+		Type:              "postgresql",
+		ConnectionPooling: &client.ConnectionPooling{Enabled: true},
+	}, data)
+	if data.ConnectionPooling != nil {
+		t.Errorf("Expected unconfigured pooling block to stay unset, got %+v", data.ConnectionPooling)
+	}
+}
+
+func TestServiceResource_ConnectionPooling_Validation(t *testing.T) {
+	t.Run("service type scope", func(t *testing.T) {
+		tests := []struct {
+			serviceType types.String
+			expectError bool
+		}{
+			{types.StringValue("postgresql"), false},
+			{types.StringValue("mysql"), true},
+			{types.StringValue("redis"), true},
+			{types.StringUnknown(), false},
+		}
+
+		for _, tt := range tests {
+			diags := validateServiceTypeScope(path.Root("connection_pooling"), tt.serviceType, "postgresql")
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for type %v, got diagnostics: %v", tt.expectError, tt.serviceType, diags)
+			}
+		}
+	})
+
+	resp := &resource.SchemaResponse{}
+	NewServiceResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+	block := resp.Schema.Blocks["connection_pooling"].(schema.SingleNestedBlock)
+
+	t.Run("pool size", func(t *testing.T) {
+		attr := block.Attributes["pool_size"].(schema.Int64Attribute)
+		tests := []struct {
+			value       int64
+			expectError bool
+		}{
+			{1, false},
+			{100, false},
+			{1000, false},
+			{0, true},
+			{-5, true},
+			{1001, true},
+		}
+
+		for _, tt := range tests {
+			if diags := runInt64Validators(t, attr.Validators, tt.value); diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for pool size %d, got diagnostics: %v", tt.expectError, tt.value, diags)
+			}
+		}
+	})
+
+	t.Run("mode", func(t *testing.T) {
+		attr := block.Attributes["mode"].(schema.StringAttribute)
+		for value, expectError := range map[string]bool{"transaction": false, "session": false, "statement": false, "pooled": true} {
+			if diags := runStringValidators(t, attr.Validators, value); diags.HasError() != expectError {
+				t.Errorf("Expected error %v for mode %q, got diagnostics: %v", expectError, value, diags)
+			}
+		}
+	})
+}
+
+func TestServiceResource_ReadReplicas_Mapping(t *testing.T) {
+	r := &ServiceResource{}
+
+	data := &ServiceResourceModel{
+		ApplicationID:            types.Int64Value(1),
+		Type:                     types.StringValue("postgresql"),
+		Settings:                 types.MapNull(types.StringType),
+		Extensions:               types.ListNull(types.StringType),
+		ReadReplicas:             types.Int64Value(2),
+		ReadReplicaCPURequest:    types.StringValue("500m"),
+		ReadReplicaMemoryRequest: types.StringNull(),
+	}
+
+	expected := &client.ReadReplicas{Count: 2, CPURequest: "500m"}
+	if service := r.toAPIModel(data); !reflect.DeepEqual(service.ReadReplicas, expected) {
+		t.Errorf("Expected read replicas %+v, got %+v", expected, service.ReadReplicas)
+	}
+
+	// An explicit zero is sent so replicas can be removed
+	data.ReadReplicas = types.Int64Value(0)
+	payload, _ := json.Marshal(r.toAPIModel(data))
+	if !strings.Contains(string(payload), `"read_replicas":{"count":0`) {
+		t.Errorf("Expected explicit zero replica count in payload, got %s", payload)
+	}
+
+	data.ReadReplicas = types.Int64Null()
+	if service := r.toAPIModel(data); service.ReadReplicas != nil {
+		t.Errorf("Expected read replicas to be omitted, got %+v", service.ReadReplicas)
+	}
+
+	// Read back with endpoints; the planned memory request is kept
+	data.ReadReplicas = types.Int64Value(2)
+	data.ReadReplicaMemoryRequest = types.StringValue("1Gi")
+	data.ReadReplicaEndpoints = types.ListUnknown(types.StringType)
+	r.fromAPIModel(&client.ApplicationService{
+		ID:                   5,
+		ApplicationID:        1,
+		Type:                 "postgresql",
+		ReadReplicas:         &client.ReadReplicas{Count: 2, CPURequest: "500m"},
+		ReadReplicaEndpoints: []string{"db-ro-0.internal:5432", "db-ro-1.internal:5432"},
+	}, data)
+
+	if !data.ReadReplicas.Equal(types.Int64Value(2)) {
+		t.Errorf("Expected 2 read replicas, got %v", data.ReadReplicas)
+	}
+	if !data.ReadReplicaMemoryRequest.Equal(types.StringValue("1Gi")) {
+		t.Errorf("Expected planned memory request to be preserved, got %v", data.ReadReplicaMemoryRequest)
+	}
+	var endpoints []string
+	data.ReadReplicaEndpoints.ElementsAs(context.Background(), &endpoints, false)
+	if !reflect.DeepEqual(endpoints, []string{"db-ro-0.internal:5432", "db-ro-1.internal:5432"}) {
+		t.Errorf("Unexpected endpoints: %v", endpoints)
+	}
+
+	// No replicas reported: endpoints become null rather than unknown
+	data.ReadReplicas = types.Int64Null()
+	data.ReadReplicaEndpoints = types.ListUnknown(types.StringType)
+	r.fromAPIModel(&client.ApplicationService{ID: 5, ApplicationID: 1, Type: "postgresql"}, data)
+	if !data.ReadReplicaEndpoints.IsNull() || !data.ReadReplicas.IsNull() {
+		t.Errorf("Expected null replicas and endpoints, got %v / %v", data.ReadReplicas, data.ReadReplicaEndpoints)
+	}
+
+	// Replicas reported by the API stay out of state when not configured,
+	// while the endpoints are still exposed
+	data.ReadReplicas = types.Int64Null()
+	data.ReadReplicaCPURequest = types.StringNull()
+	data.ReadReplicaMemoryRequest = types.StringNull()
+	data.ReadReplicaEndpoints = types.ListUnknown(types.StringType)
+	r.fromAPIModel(&client.ApplicationService{
+		ID:                   5,
+		ApplicationID:        1,
+		Type:                 "postgresql",
+		ReadReplicas:         &client.ReadReplicas{Count: 1, CPURequest: "250m", MemoryRequest: "512Mi"},
+		ReadReplicaEndpoints: []string{"db-ro-0.internal:5432"},
+	}, data)
+	if !data.ReadReplicas.IsNull() || !data.ReadReplicaCPURequest.IsNull() || !data.ReadReplicaMemoryRequest.IsNull() {
+		t.Errorf("Expected unconfigured read replicas to stay null, got %v / %v / %v", data.ReadReplicas, data.ReadReplicaCPURequest, data.ReadReplicaMemoryRequest)
+	}
+	if len(data.ReadReplicaEndpoints.Elements()) != 1 {
+		t.Errorf("Expected the reported endpoint, got %v", data.ReadReplicaEndpoints)
+	}
+}
+
+func TestServiceResource_ReadReplicas_Validation(t *testing.T) {
+	for _, tt := range []struct {
+		serviceType string
+		expectError bool
+	}{
+		{"postgresql", false},
+		{"mysql", false},
+		{"redis", true},
+		{"mongodb", true},
+	} {
+		diags := validateServiceTypeScope(path.Root("read_replicas"), types.StringValue(tt.serviceType), "postgresql", "mysql")
+		if diags.HasError() != tt.expectError {
+			t.Errorf("Expected error %v for type %s, got diagnostics: %v", tt.expectError, tt.serviceType, diags)
+		}
+	}
+
+	resp := &resource.SchemaResponse{}
+	NewServiceResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	count := resp.Schema.Attributes["read_replicas"].(schema.Int64Attribute)
+	for value, expectError := range map[int64]bool{0: false, 3: false, -1: true} {
+		if diags := runInt64Validators(t, count.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for read_replicas %d, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+
+	cpu := resp.Schema.Attributes["read_replica_cpu_request"].(schema.StringAttribute)
+	for value, expectError := range map[string]bool{"250m": false, "1": false, "lots": true} {
+		if diags := runStringValidators(t, cpu.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for cpu request %q, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+
+	memory := resp.Schema.Attributes["read_replica_memory_request"].(schema.StringAttribute)
+	for value, expectError := range map[string]bool{"512Mi": false, "2Gi": false, "2GB": true} {
+		if diags := runStringValidators(t, memory.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for memory request %q, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+}
+
+func TestServiceResource_TLS_Mapping(t *testing.T) {
+	r := &ServiceResource{}
+
+	caCert := "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----"
+	data := &ServiceResourceModel{
+		ApplicationID: types.Int64Value(1),
+		Type:          types.StringValue("postgresql"),
+		Settings:      types.MapNull(types.StringType),
+		Extensions:    types.ListNull(types.StringType),
+		TLS: &ServiceTLSModel{
+			Enabled: types.BoolValue(true),
+			Mode:    types.StringValue("verify-full"),
+			CACert:  types.StringValue(caCert),
+		},
+	}
+
+	expected := &client.ServiceTLS{Enabled: true, Mode: "verify-full", CACert: caCert}
+	if service := r.toAPIModel(data); !reflect.DeepEqual(service.TLS, expected) {
+		t.Errorf("Expected tls %+v, got %+v", expected, service.TLS)
+	}
+
+	// Defaults apply when values are unknown
+	data.TLS = &ServiceTLSModel{
+		Enabled: types.BoolUnknown(),
+		Mode:    types.StringUnknown(),
+		CACert:  types.StringNull(),
+	}
+	expected = &client.ServiceTLS{Enabled: true, Mode: "require"}
+	if service := r.toAPIModel(data); !reflect.DeepEqual(service.TLS, expected) {
+		t.Errorf("Expected tls defaults %+v, got %+v", expected, service.TLS)
+	}
+
+	data.TLS = nil
+	if service := r.toAPIModel(data); service.TLS != nil {
+		t.Errorf("Expected tls to be omitted, got %+v", service.TLS)
+	}
+
+	// Read back keeps the configured CA certificate when the API omits it
+	data.TLS = &ServiceTLSModel{
+		Enabled: types.BoolValue(true),
+		Mode:    types.StringValue("verify-ca"),
+		CACert:  types.StringValue(caCert),
+	}
+	r.fromAPIModel(&client.ApplicationService{
+		ID:            5,
+		ApplicationID: 1,
+		Type:          "postgresql",
+		TLS:           &client.ServiceTLS{Enabled: false, Mode: "require"},
+	}, data)
+
+	if !data.TLS.Enabled.Equal(types.BoolValue(false)) || !data.TLS.Mode.Equal(types.StringValue("require")) {
+		t.Errorf("Expected tls settings from API, got %+v", data.TLS)
+	}
+	if !data.TLS.CACert.Equal(types.StringValue(caCert)) {
+		t.Errorf("Expected configured CA certificate to be preserved, got %v", data.TLS.CACert)
+	}
+
+	data.TLS = nil
+	r.fromAPIModel(&client.ApplicationService{
+		ID:            5,
+		ApplicationID: 1,
+		Type:          "postgresql",
+		TLS:           &client.ServiceTLS{Enabled: true, Mode: "require"},
+	}, data)
+	if data.TLS != nil {
+		t.Errorf("Expected unconfigured tls block to stay unset, got %+v", data.TLS)
+	}
+}
+
+func TestServiceResource_TLS_Validation(t *testing.T) {
+	t.Run("service type scope", func(t *testing.T) {
+		tests := []struct {
+			serviceType types.String
+			expectError bool
+		}{
+			{types.StringValue("postgresql"), false},
+			{types.StringValue("mysql"), false},
+			{types.StringValue("mongodb"), false},
+			{types.StringValue("redis"), false},
+			{types.StringValue("valkey"), false},
+			{types.StringValue("worker"), true},
+			{types.StringValue("minio"), true},
+			{types.StringValue("sftp"), true},
+			{types.StringUnknown(), false},
+		}
+
+		for _, tt := range tests {
+			diags := validateServiceTypeScope(path.Root("tls"), tt.serviceType, tlsServiceTypes...)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for type %v, got diagnostics: %v", tt.expectError, tt.serviceType, diags)
+			}
+		}
+	})
+
+	resp := &resource.SchemaResponse{}
+	NewServiceResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+	block := resp.Schema.Blocks["tls"].(schema.SingleNestedBlock)
+
+	t.Run("mode", func(t *testing.T) {
+		attr := block.Attributes["mode"].(schema.StringAttribute)
+		for value, expectError := range map[string]bool{"require": false, "verify-ca": false, "verify-full": false, "disable": true, "prefer": true} {
+			if diags := runStringValidators(t, attr.Validators, value); diags.HasError() != expectError {
+				t.Errorf("Expected error %v for mode %q, got diagnostics: %v", expectError, value, diags)
+			}
+		}
+	})
+
+	t.Run("ca cert", func(t *testing.T) {
+		attr := block.Attributes["ca_cert"].(schema.StringAttribute)
+		for value, expectError := range map[string]bool{
+			"-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----": false,
+			"not a certificate": true,
+		} {
+			if diags := runStringValidators(t, attr.Validators, value); diags.HasError() != expectError {
+				t.Errorf("Expected error %v for CA certificate %q, got diagnostics: %v", expectError, value, diags)
+			}
+		}
+	})
+}
+
+func TestServiceResource_Autoscaling_Mapping(t *testing.T) {
+	r := &ServiceResource{}
+
+	data := &ServiceResourceModel{
+		ApplicationID: types.Int64Value(1),
+		Type:          types.StringValue("mysql"),
+		Settings:      types.MapNull(types.StringType),
+		Extensions:    types.ListNull(types.StringType),
+		Autoscaling: &ServiceAutoscalingModel{
+			Enabled:   types.BoolValue(true),
+			Min:       types.Int64Value(1),
+			Max:       types.Int64Value(4),
+			TargetCPU: types.Int64Value(70),
+		},
+	}
+
+	expected := &client.ServiceAutoscaling{Enabled: true, Min: 1, Max: 4, TargetCPU: 70}
+	if service := r.toAPIModel(data); !reflect.DeepEqual(service.Autoscaling, expected) {
+		t.Errorf("Expected autoscaling %+v, got %+v", expected, service.Autoscaling)
+	}
+
+	// Enabled defaults to true when unknown, unset values are omitted
+	data.Autoscaling = &ServiceAutoscalingModel{
+		Enabled:   types.BoolUnknown(),
+		Min:       types.Int64Value(2),
+		Max:       types.Int64Value(3),
+		TargetCPU: types.Int64Null(),
+	}
+	expected = &client.ServiceAutoscaling{Enabled: true, Min: 2, Max: 3}
+	if service := r.toAPIModel(data); !reflect.DeepEqual(service.Autoscaling, expected) {
+		t.Errorf("Expected autoscaling defaults %+v, got %+v", expected, service.Autoscaling)
+	}
+
+	data.Autoscaling = nil
+	if service := r.toAPIModel(data); service.Autoscaling != nil {
+		t.Errorf("Expected autoscaling to be omitted, got %+v", service.Autoscaling)
+	}
+
+	// Read back
+	data.Autoscaling = &ServiceAutoscalingModel{
+		Enabled:   types.BoolValue(true),
+		Min:       types.Int64Value(1),
+		Max:       types.Int64Value(4),
+		TargetCPU: types.Int64Null(),
+	}
+	r.fromAPIModel(&client.ApplicationService{
+		ID:            5,
+		ApplicationID: 1,
+		Type:          "mysql",
+		Autoscaling:   &client.ServiceAutoscaling{Enabled: false, Min: 2, Max: 6, TargetCPU: 80},
+	}, data)
+
+	expectedModel := &ServiceAutoscalingModel{
+		Enabled:   types.BoolValue(false),
+		Min:       types.Int64Value(2),
+		Max:       types.Int64Value(6),
+		TargetCPU: types.Int64Value(80),
+	}
+	if !reflect.DeepEqual(data.Autoscaling, expectedModel) {
+		t.Errorf("Expected autoscaling settings from API %+v, got %+v", expectedModel, data.Autoscaling)
+	}
+
+	data.Autoscaling = nil
+	r.fromAPIModel(&client.ApplicationService{
+		ID:            5,
+		ApplicationID: 1,
+		Type:          "mysql",
+		Autoscaling:   &client.ServiceAutoscaling{Enabled: true, Min: 1, Max: 2},
+	}, data)
+	if data.Autoscaling != nil {
+		t.Errorf("Expected unconfigured autoscaling block to stay unset, got %+v", data.Autoscaling)
+	}
+}
+
+func TestServiceResource_Autoscaling_Validation(t *testing.T) {
+	t.Run("service type scope", func(t *testing.T) {
+		tests := []struct {
+			serviceType types.String
+			expectError bool
+		}{
+			{types.StringValue("mysql"), false},
+			{types.StringValue("postgresql"), false},
+			{types.StringValue("mongodb"), false},
+			{types.StringValue("redis"), false},
+			{types.StringValue("valkey"), false},
+			{types.StringValue("worker"), true},
+			{types.StringValue("minio"), true},
+			{types.StringValue("sftp"), true},
+			{types.StringUnknown(), false},
+		}
+
+		for _, tt := range tests {
+			diags := validateServiceTypeScope(path.Root("autoscaling"), tt.serviceType, autoscalableServiceTypes...)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for type %v, got diagnostics: %v", tt.expectError, tt.serviceType, diags)
+			}
+		}
+	})
+
+	t.Run("bounds", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			autoscaling *ServiceAutoscalingModel
+			expectError bool
+		}{
+			{"min below max", &ServiceAutoscalingModel{Enabled: types.BoolValue(true), Min: types.Int64Value(1), Max: types.Int64Value(3)}, false},
+			{"min equals max", &ServiceAutoscalingModel{Enabled: types.BoolValue(true), Min: types.Int64Value(2), Max: types.Int64Value(2)}, false},
+			{"min above max", &ServiceAutoscalingModel{Enabled: types.BoolValue(true), Min: types.Int64Value(4), Max: types.Int64Value(2)}, true},
+			{"missing max", &ServiceAutoscalingModel{Enabled: types.BoolValue(true), Min: types.Int64Value(1), Max: types.Int64Null()}, true},
+			{"missing bounds with default enabled", &ServiceAutoscalingModel{Enabled: types.BoolNull(), Min: types.Int64Null(), Max: types.Int64Null()}, true},
+			{"missing bounds while disabled", &ServiceAutoscalingModel{Enabled: types.BoolValue(false), Min: types.Int64Null(), Max: types.Int64Null()}, false},
+			{"unknown max", &ServiceAutoscalingModel{Enabled: types.BoolValue(true), Min: types.Int64Value(4), Max: types.Int64Unknown()}, false},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				diags := validateServiceAutoscaling(tt.autoscaling)
+				if diags.HasError() != tt.expectError {
+					t.Errorf("Expected error %v, got diagnostics: %v", tt.expectError, diags)
+				}
+			})
+		}
+	})
+
+	resp := &resource.SchemaResponse{}
+	NewServiceResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+	block := resp.Schema.Blocks["autoscaling"].(schema.SingleNestedBlock)
+
+	t.Run("target cpu", func(t *testing.T) {
+		attr := block.Attributes["target_cpu"].(schema.Int64Attribute)
+		for value, expectError := range map[int64]bool{0: false, 50: false, 100: false, -1: true, 101: true} {
+			if diags := runInt64Validators(t, attr.Validators, value); diags.HasError() != expectError {
+				t.Errorf("Expected error %v for target_cpu %d, got diagnostics: %v", expectError, value, diags)
+			}
+		}
+	})
+
+	t.Run("replica bounds", func(t *testing.T) {
+		for _, name := range []string{"min", "max"} {
+			attr := block.Attributes[name].(schema.Int64Attribute)
+			for value, expectError := range map[int64]bool{1: false, 10: false, 0: true} {
+				if diags := runInt64Validators(t, attr.Validators, value); diags.HasError() != expectError {
+					t.Errorf("Expected error %v for %s %d, got diagnostics: %v", expectError, name, value, diags)
+				}
+			}
+		}
+	})
+}
+
+func TestServiceResource_Backup_Mapping(t *testing.T) {
+	r := &ServiceResource{}
+
+	data := &ServiceResourceModel{
+		ApplicationID: types.Int64Value(1),
+		Type:          types.StringValue("postgresql"),
+		Settings:      types.MapNull(types.StringType),
+		Extensions:    types.ListNull(types.StringType),
+		Backup: &ServiceBackupModel{
+			EncryptionEnabled: types.BoolValue(true),
+			EncryptionMode:    types.StringValue("customer_managed"),
+			KMSKey:            types.StringValue("arn:aws:kms:eu-west-1:123456789012:key/abcd"),
+		},
+	}
+
+	expected := &client.ServiceBackup{EncryptionEnabled: true, EncryptionMode: "customer_managed", KMSKey: "arn:aws:kms:eu-west-1:123456789012:key/abcd"}
+	if service := r.toAPIModel(data); !reflect.DeepEqual(service.Backup, expected) {
+		t.Errorf("Expected backup %+v, got %+v", expected, service.Backup)
+	}
+
+	// Defaults apply when values are unknown
+	data.Backup = &ServiceBackupModel{
+		EncryptionEnabled: types.BoolUnknown(),
+		EncryptionMode:    types.StringUnknown(),
+		KMSKey:            types.StringNull(),
+	}
+	expected = &client.ServiceBackup{EncryptionEnabled: false, EncryptionMode: "platform"}
+	if service := r.toAPIModel(data); !reflect.DeepEqual(service.Backup, expected) {
+		t.Errorf("Expected backup defaults %+v, got %+v", expected, service.Backup)
+	}
+
+	data.Backup = nil
+	if service := r.toAPIModel(data); service.Backup != nil {
+		t.Errorf("Expected backup to be omitted, got %+v", service.Backup)
+	}
+
+	// Read back keeps the configured key, which the API never returns
+	data.Backup = &ServiceBackupModel{
+		EncryptionEnabled: types.BoolValue(true),
+		EncryptionMode:    types.StringValue("customer_managed"),
+		KMSKey:            types.StringValue("projects/p/locations/l/keyRings/r/cryptoKeys/k"),
+	}
+	r.fromAPIModel(&client.ApplicationService{
+		ID:            5,
+		ApplicationID: 1,
+		Type:          "postgresql",
+		Backup:        &client.ServiceBackup{EncryptionEnabled: true, EncryptionMode: "customer_managed"},
+	}, data)
+
+	if !data.Backup.EncryptionEnabled.Equal(types.BoolValue(true)) || !data.Backup.EncryptionMode.Equal(types.StringValue("customer_managed")) {
+		t.Errorf("Expected encryption settings from API, got %+v", data.Backup)
+	}
+	if !data.Backup.KMSKey.Equal(types.StringValue("projects/p/locations/l/keyRings/r/cryptoKeys/k")) {
+		t.Errorf("Expected configured KMS key to be preserved, got %v", data.Backup.KMSKey)
+	}
+
+	data.Backup = nil
+	r.fromAPIModel(&client.ApplicationService{
+		ID:            5,
+		ApplicationID: 1,
+		Type:          "postgresql",
+		Backup:        &client.ServiceBackup{EncryptionEnabled: true, EncryptionMode: "platform"},
+	}, data)
+	if data.Backup != nil {
+		t.Errorf("Expected unconfigured backup block to stay unset, got %+v", data.Backup)
+	}
+}
+
+func TestServiceResource_Backup_Validation(t *testing.T) {
+	tests := []struct {
+		name          string
+		backup        *ServiceBackupModel
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:   "platform encryption",
+			backup: &ServiceBackupModel{EncryptionEnabled: types.BoolValue(true), EncryptionMode: types.StringValue("platform"), KMSKey: types.StringNull()},
+		},
+		{
+			name:   "defaults",
+			backup: &ServiceBackupModel{EncryptionEnabled: types.BoolNull(), EncryptionMode: types.StringNull(), KMSKey: types.StringNull()},
+		},
+		{
+			name:   "customer managed with key",
+			backup: &ServiceBackupModel{EncryptionEnabled: types.BoolValue(true), EncryptionMode: types.StringValue("customer_managed"), KMSKey: types.StringValue("key-ref")},
+		},
+		{
+			name:   "customer managed with unknown key",
+			backup: &ServiceBackupModel{EncryptionEnabled: types.BoolValue(true), EncryptionMode: types.StringValue("customer_managed"), KMSKey: types.StringUnknown()},
+		},
+		{
+			name:          "customer managed without key",
+			backup:        &ServiceBackupModel{EncryptionEnabled: types.BoolValue(true), EncryptionMode: types.StringValue("customer_managed"), KMSKey: types.StringNull()},
+			expectError:   true,
+			errorContains: "kms_key is required",
+		},
+		{
+			name:          "customer managed with empty key",
+			backup:        &ServiceBackupModel{EncryptionEnabled: types.BoolValue(true), EncryptionMode: types.StringValue("customer_managed"), KMSKey: types.StringValue("")},
+			expectError:   true,
+			errorContains: "kms_key is required",
+		},
+		{
+			name:          "key without customer managed mode",
+			backup:        &ServiceBackupModel{EncryptionEnabled: types.BoolValue(true), EncryptionMode: types.StringNull(), KMSKey: types.StringValue("key-ref")},
+			expectError:   true,
+			errorContains: "can only be set",
+		},
+		{
+			name:          "customer managed with encryption disabled",
+			backup:        &ServiceBackupModel{EncryptionEnabled: types.BoolValue(false), EncryptionMode: types.StringValue("customer_managed"), KMSKey: types.StringValue("key-ref")},
+			expectError:   true,
+			errorContains: "encryption_enabled must be true",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateServiceBackup(tt.backup)
+			if diags.HasError() != tt.expectError {
+				t.Fatalf("Expected error %v, got diagnostics: %v", tt.expectError, diags)
+			}
+			if tt.errorContains != "" && !strings.Contains(diags[0].Detail(), tt.errorContains) {
+				t.Errorf("Expected error detail to contain %q, got %q", tt.errorContains, diags[0].Detail())
+			}
+		})
+	}
+
+	resp := &resource.SchemaResponse{}
+	NewServiceResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+	block := resp.Schema.Blocks["backup"].(schema.SingleNestedBlock)
+
+	if !block.Attributes["kms_key"].(schema.StringAttribute).Sensitive {
+		t.Error("Expected kms_key to be sensitive")
+	}
+
+	mode := block.Attributes["encryption_mode"].(schema.StringAttribute)
+	for value, expectError := range map[string]bool{"platform": false, "customer_managed": false, "customer": true} {
+		if diags := runStringValidators(t, mode.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for encryption mode %q, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+}
+
+func TestServiceResource_BackupPITR_Mapping(t *testing.T) {
+	r := &ServiceResource{}
+
+	tests := []struct {
+		name     string
+		days     types.Int64
+		expected int64
+	}{
+		{"seven days", types.Int64Value(7), 7},
+		{"unset", types.Int64Null(), 0},
+		{"unknown", types.Int64Unknown(), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &ServiceResourceModel{
+				ApplicationID: types.Int64Value(1),
+				Type:          types.StringValue("postgresql"),
+				Settings:      types.MapNull(types.StringType),
+				Extensions:    types.ListNull(types.StringType),
+				Backup: &ServiceBackupModel{
+					EncryptionEnabled: types.BoolValue(false),
+					EncryptionMode:    types.StringValue("platform"),
+					KMSKey:            types.StringNull(),
+					PITRRetentionDays: tt.days,
+				},
+			}
+
+			service := r.toAPIModel(data)
+			if service.Backup == nil || service.Backup.PITRRetentionDays != tt.expected {
+				t.Errorf("Expected pitr_retention_days %d, got %+v", tt.expected, service.Backup)
+			}
+		})
+	}
+
+	// Read back
+	readTests := []struct {
+		name     string
+		planned  types.Int64
+		apiDays  int64
+		expected types.Int64
+	}{
+		{"reported by API", types.Int64Null(), 14, types.Int64Value(14)},
+		{"not reported keeps planned value", types.Int64Value(7), 0, types.Int64Value(7)},
+		{"not reported and unknown", types.Int64Unknown(), 0, types.Int64Null()},
+	}
+
+	for _, tt := range readTests {
+		t.Run("read "+tt.name, func(t *testing.T) {
+			data := &ServiceResourceModel{Backup: &ServiceBackupModel{KMSKey: types.StringNull(), PITRRetentionDays: tt.planned}}
+			r.fromAPIModel(&client.ApplicationService{
+				ID:            5,
+				ApplicationID: 1,
+				Type:          "postgresql",
+				Backup:        &client.ServiceBackup{EncryptionMode: "platform", PITRRetentionDays: tt.apiDays},
+			}, data)
+
+			if !data.Backup.PITRRetentionDays.Equal(tt.expected) {
+				t.Errorf("Expected pitr_retention_days %v, got %v", tt.expected, data.Backup.PITRRetentionDays)
+			}
+		})
+	}
+}
+
+func TestServiceResource_BackupPITR_Validation(t *testing.T) {
+	t.Run("service type scope", func(t *testing.T) {
+		tests := []struct {
+			serviceType types.String
+			expectError bool
+		}{
+			{types.StringValue("postgresql"), false},
+			{types.StringValue("mysql"), false},
+			{types.StringValue("mongodb"), true},
+			{types.StringValue("redis"), true},
+			{types.StringValue("valkey"), true},
+			{types.StringUnknown(), false},
+		}
+
+		for _, tt := range tests {
+			diags := validateServiceTypeScope(path.Root("backup").AtName("pitr_retention_days"), tt.serviceType, pitrServiceTypes...)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for type %v, got diagnostics: %v", tt.expectError, tt.serviceType, diags)
+			}
+		}
+	})
+
+	resp := &resource.SchemaResponse{}
+	NewServiceResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+	attr := resp.Schema.Blocks["backup"].(schema.SingleNestedBlock).Attributes["pitr_retention_days"].(schema.Int64Attribute)
+
+	for value, expectError := range map[int64]bool{1: false, 7: false, 35: false, 0: true, -1: true, 36: true} {
+		if diags := runInt64Validators(t, attr.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for %d days, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+}
+
+func TestServiceResource_Maintenance_Mapping(t *testing.T) {
+	r := &ServiceResource{}
+
+	data := &ServiceResourceModel{
+		ApplicationID: types.Int64Value(1),
+		Type:          types.StringValue("postgresql"),
+		Settings:      types.MapNull(types.StringType),
+		Extensions:    types.ListNull(types.StringType),
+		Maintenance: &ServiceMaintenanceModel{
+			VacuumSchedule:  types.StringValue("0 3 * * 0"),
+			AnalyzeSchedule: types.StringNull(),
+		},
+	}
+
+	expected := &client.ServiceMaintenance{VacuumSchedule: "0 3 * * 0"}
+	if service := r.toAPIModel(data); !reflect.DeepEqual(service.Maintenance, expected) {
+		t.Errorf("Expected maintenance %+v, got %+v", expected, service.Maintenance)
+	}
+
+	data.Maintenance = nil
+	if service := r.toAPIModel(data); service.Maintenance != nil {
+		t.Errorf("Expected maintenance to be omitted, got %+v", service.Maintenance)
+	}
+
+	// Read back: API schedules win, unreported schedules keep their planned value
+	data.Maintenance = &ServiceMaintenanceModel{
+		VacuumSchedule:  types.StringValue("0 3 * * 0"),
+		AnalyzeSchedule: types.StringValue("0 4 * * *"),
+	}
+	r.fromAPIModel(&client.ApplicationService{
+		ID:            5,
+		ApplicationID: 1,
+		Type:          "postgresql",
+		Maintenance:   &client.ServiceMaintenance{VacuumSchedule: "30 2 * * 6"},
+	}, data)
+
+	if !data.Maintenance.VacuumSchedule.Equal(types.StringValue("30 2 * * 6")) {
+		t.Errorf("Expected vacuum schedule from API, got %v", data.Maintenance.VacuumSchedule)
+	}
+	if !data.Maintenance.AnalyzeSchedule.Equal(types.StringValue("0 4 * * *")) {
+		t.Errorf("Expected planned analyze schedule to be preserved, got %v", data.Maintenance.AnalyzeSchedule)
+	}
+
+	data.Maintenance = nil
+	r.fromAPIModel(&client.ApplicationService{
+		ID:            5,
+		ApplicationID: 1,
+		Type:          "postgresql",
+		Maintenance:   &client.ServiceMaintenance{VacuumSchedule: "0 0 * * *"},
+	}, data)
+	if data.Maintenance != nil {
+		t.Errorf("Expected unconfigured maintenance block to stay unset, got %+v", data.Maintenance)
+	}
+}
+
+func TestServiceResource_Maintenance_Validation(t *testing.T) {
+	for _, tt := range []struct {
+		serviceType string
+		expectError bool
+	}{
+		{"postgresql", false},
+		{"mysql", true},
+		{"redis", true},
+	} {
+		diags := validateServiceTypeScope(path.Root("maintenance"), types.StringValue(tt.serviceType), "postgresql")
+		if diags.HasError() != tt.expectError {
+			t.Errorf("Expected error %v for type %s, got diagnostics: %v", tt.expectError, tt.serviceType, diags)
+		}
+	}
+
+	resp := &resource.SchemaResponse{}
+	NewServiceResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+	block := resp.Schema.Blocks["maintenance"].(schema.SingleNestedBlock)
+
+	for _, name := range []string{"vacuum_schedule", "analyze_schedule"} {
+		attr := block.Attributes[name].(schema.StringAttribute)
+		for value, expectError := range map[string]bool{
+			"0 3 * * 0":     false,
+			"@weekly":       false,
+			"*/30 * * * *":  false,
+			"0 3 * * 8":     true,
+			"0 0 3 * * 0":   true,
+			"every morning": true,
+		} {
+			if diags := runStringValidators(t, attr.Validators, value); diags.HasError() != expectError {
+				t.Errorf("Expected error %v for %s %q, got diagnostics: %v", expectError, name, value, diags)
+			}
+		}
+	}
+}
+
+func TestServiceResource_Queues_Mapping(t *testing.T) {
+	r := &ServiceResource{}
+	ctx := context.Background()
+
+	queues, _ := types.ListValueFrom(ctx, types.StringType, []string{"high", "default"})
+	data := &ServiceResourceModel{
+		ApplicationID:   types.Int64Value(1),
+		Type:            types.StringValue("worker"),
+		Settings:        types.MapNull(types.StringType),
+		Extensions:      types.ListNull(types.StringType),
+		Command:         types.StringValue("php artisan queue:work"),
+		QueueConnection: types.StringValue("redis"),
+		Queues:          queues,
+	}
+
+	settings := r.toAPIModel(data).Settings.ToMap()
+	if settings["queue_connection"] != "redis" {
+		t.Errorf("Expected queue_connection 'redis' in settings, got %q", settings["queue_connection"])
+	}
+	if settings["queues"] != "high,default" {
+		t.Errorf("Expected queues 'high,default' in settings, got %q", settings["queues"])
+	}
+
+	// Omitted when not configured
+	data.QueueConnection = types.StringNull()
+	data.Queues = types.ListNull(types.StringType)
+	settings = r.toAPIModel(data).Settings.ToMap()
+	if _, ok := settings["queue_connection"]; ok {
+		t.Error("Expected queue_connection to be omitted from settings")
+	}
+	if _, ok := settings["queues"]; ok {
+		t.Error("Expected queues to be omitted from settings")
+	}
+
+	// Read back from settings
+	data.QueueConnection = types.StringUnknown()
+	data.Queues = types.ListUnknown(types.StringType)
+	r.fromAPIModel(&client.ApplicationService{
+		ID:            7,
+		ApplicationID: 1,
+		Type:          "worker",
+		Settings: client.FlexibleSettingsFromMap(map[string]string{
+			"command":          "php artisan queue:work",
+			"queue_connection": "sqs",
+			"queues":           "emails,default",
+		}),
+	}, data)
+
+	expectedQueues, _ := types.ListValueFrom(ctx, types.StringType, []string{"emails", "default"})
+	if !data.QueueConnection.Equal(types.StringValue("sqs")) {
+		t.Errorf("Expected queue_connection 'sqs', got %v", data.QueueConnection)
+	}
+	if !data.Queues.Equal(expectedQueues) {
+		t.Errorf("Expected queues %v, got %v", expectedQueues, data.Queues)
+	}
+
+	// Settings without queue selection keep planned values and null out unknowns
+	data.QueueConnection = types.StringValue("redis")
+	data.Queues = types.ListUnknown(types.StringType)
+	r.fromAPIModel(&client.ApplicationService{ID: 7, ApplicationID: 1, Type: "worker"}, data)
+	if !data.QueueConnection.Equal(types.StringValue("redis")) {
+		t.Errorf("Expected planned queue_connection to be preserved, got %v", data.QueueConnection)
+	}
+	if !data.Queues.IsNull() {
+		t.Errorf("Expected null queues, got %v", data.Queues)
+	}
+}
+
+func TestServiceResource_Queues_Validation(t *testing.T) {
+	for _, tt := range []struct {
+		serviceType string
+		expectError bool
+	}{
+		{"worker", false},
+		{"redis", true},
+		{"postgresql", true},
+	} {
+		diags := validateServiceTypeScope(path.Root("queues"), types.StringValue(tt.serviceType), "worker")
+		if diags.HasError() != tt.expectError {
+			t.Errorf("Expected error %v for type %s, got diagnostics: %v", tt.expectError, tt.serviceType, diags)
+		}
+	}
+
+	resp := &resource.SchemaResponse{}
+	NewServiceResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	connection := resp.Schema.Attributes["queue_connection"].(schema.StringAttribute)
+	for value, expectError := range map[string]bool{
+		"redis":     false,
+		"sqs-fifo":  false,
+		"":          true,
+		"redis sqs": true,
+		"redis,sqs": true,
+	} {
+		if diags := runStringValidators(t, connection.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for queue_connection %q, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+
+	queuesAttr := resp.Schema.Attributes["queues"].(schema.ListAttribute)
+	for _, tt := range []struct {
+		name        string
+		queues      []string
+		expectError bool
+	}{
+		{"valid", []string{"high", "default"}, false},
+		{"empty list", []string{}, true},
+		{"empty name", []string{"high", ""}, true},
+		{"duplicate", []string{"default", "default"}, true},
+		{"comma", []string{"high,low"}, true},
+	} {
+		value, _ := types.ListValueFrom(context.Background(), types.StringType, tt.queues)
+		req := validator.ListRequest{Path: path.Root("queues"), ConfigValue: value}
+		var diags diag.Diagnostics
+		for _, v := range queuesAttr.Validators {
+			listResp := &validator.ListResponse{}
+			v.ValidateList(context.Background(), req, listResp)
+			diags.Append(listResp.Diagnostics...)
+		}
+		if diags.HasError() != tt.expectError {
+			t.Errorf("%s: expected error %v, got diagnostics: %v", tt.name, tt.expectError, diags)
+		}
+	}
+}
+
+func TestServiceResource_Alerts_Validation(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewServiceResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	alerts := resp.Schema.Blocks["alerts"].(schema.ListNestedBlock).NestedObject.Attributes
+
+	metric := alerts["metric"].(schema.StringAttribute)
+	for value, expectError := range map[string]bool{
+		"connections":        false,
+		"disk_usage_percent": false,
+		"cpu_usage_percent":  false,
+		"disk_usage":         true,
+		"":                   true,
+	} {
+		if diags := runStringValidators(t, metric.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for metric %q, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+
+	comparison := alerts["comparison"].(schema.StringAttribute)
+	for value, expectError := range map[string]bool{"gt": false, "lte": false, ">": true, "above": true} {
+		if diags := runStringValidators(t, comparison.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for comparison %q, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+
+	threshold := alerts["threshold"].(schema.Float64Attribute)
+	for value, expectError := range map[float64]bool{0: false, 85.5: false, 5000: false, -1: true} {
+		if diags := runFloat64Validators(t, threshold.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for threshold %g, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+
+	notificationURL := alerts["notification_url"].(schema.StringAttribute)
+	if !notificationURL.Sensitive {
+		t.Error("Expected notification_url to be sensitive")
+	}
+	if diags := runStringValidators(t, notificationURL.Validators, "not a url"); !diags.HasError() {
+		t.Error("Expected an error for an invalid notification_url")
+	}
+
+	alert := func(metric string, threshold float64, comparison types.String) ServiceAlertModel {
+		return ServiceAlertModel{
+			Metric:          types.StringValue(metric),
+			Threshold:       types.Float64Value(threshold),
+			Comparison:      comparison,
+			NotificationURL: types.StringValue("https://example.com/hook"),
+		}
+	}
+
+	tests := []struct {
+		name          string
+		alerts        []ServiceAlertModel
+		expectedError string
+	}{
+		{"none", nil, ""},
+		{"connections above 100", []ServiceAlertModel{alert("connections", 500, types.StringValue("gt"))}, ""},
+		{"percentage at 100", []ServiceAlertModel{alert("disk_usage_percent", 100, types.StringValue("gte"))}, ""},
+		{"percentage above 100", []ServiceAlertModel{alert("disk_usage_percent", 120, types.StringValue("gt"))}, "Invalid Alert Threshold"},
+		{"same metric with different comparisons", []ServiceAlertModel{alert("connections", 500, types.StringValue("gt")), alert("connections", 1, types.StringValue("lt"))}, ""},
+		{"duplicate metric and comparison", []ServiceAlertModel{alert("connections", 500, types.StringValue("gt")), alert("connections", 800, types.StringValue("gt"))}, "Duplicate Service Alert"},
+		{"duplicate with default comparison", []ServiceAlertModel{alert("connections", 500, types.StringNull()), alert("connections", 800, types.StringValue("gt"))}, "Duplicate Service Alert"},
+		{"unknown comparison", []ServiceAlertModel{alert("connections", 500, types.StringUnknown()), alert("connections", 800, types.StringValue("gt"))}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateServiceAlerts(tt.alerts)
+			if tt.expectedError == "" {
+				if diags.HasError() {
+					t.Errorf("Expected no errors, got %v", diags)
+				}
+				return
+			}
+			if diags.ErrorsCount() != 1 || diags[0].Summary() != tt.expectedError {
+				t.Errorf("Expected a single %q error, got %v", tt.expectedError, diags)
+			}
+		})
+	}
+}
+
+func TestServiceResource_SyncAlerts(t *testing.T) {
+	var requests []string
+	var payloads []client.ServiceAlert
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		var alert client.ServiceAlert
+		if r.Body != nil {
+			json.NewDecoder(r.Body).Decode(&alert)
+		}
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"data": [
+				{"id": 11, "metric": "connections", "threshold": 150, "comparison": "gt", "notification_url": "https://example.com/db"},
+				{"id": 12, "metric": "cpu_usage_percent", "threshold": 90, "comparison": "gt", "notification_url": "https://example.com/db"},
+				{"id": 13, "metric": "memory_usage_percent", "threshold": 80, "comparison": "gte", "notification_url": "https://example.com/db"}
+			]}`))
+		case http.MethodPost:
+			payloads = append(payloads, alert)
+			alert.ID = 14
+			alert.NotificationURL = ""
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": alert})
+		case http.MethodPut:
+			payloads = append(payloads, alert)
+			alert.ID = 11
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": alert})
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	r := &ServiceResource{client: client.NewClient("test-token", &server.URL)}
+
+	planned := []ServiceAlertModel{
+		{Metric: types.StringValue("disk_usage_percent"), Threshold: types.Float64Value(85), Comparison: types.StringValue("gte"), NotificationURL: types.StringValue("https://example.com/disk?token=abc")},
+		{Metric: types.StringValue("connections"), Threshold: types.Float64Value(200), Comparison: types.StringValue("gt"), NotificationURL: types.StringValue("https://example.com/db")},
+		{Metric: types.StringValue("memory_usage_percent"), Threshold: types.Float64Value(80), Comparison: types.StringValue("gte"), NotificationURL: types.StringValue("https://example.com/db")},
+	}
+
+	alerts, err := r.syncAlerts(context.Background(), 100, 1, planned)
+	if err != nil {
+		t.Fatalf("Expected success but got error: %v", err)
+	}
+
+	expectedRequests := []string{
+		"GET /applications/100/services/1/alerts",
+		"POST /applications/100/services/1/alerts",
+		"PUT /applications/100/services/1/alerts/11",
+		"DELETE /applications/100/services/1/alerts/12",
+	}
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Errorf("Expected requests %v, got %v", expectedRequests, requests)
+	}
+	if len(payloads) != 2 || payloads[0].NotificationURL != "https://example.com/disk?token=abc" || payloads[1].Threshold != 200 {
+		t.Errorf("Unexpected alert payloads: %+v", payloads)
+	}
+
+	models := serviceAlertsFromAPI(alerts, planned)
+	if !reflect.DeepEqual(models, planned) {
+		t.Errorf("Expected the planned alerts to be read back, got %+v", models)
+	}
+}
+
+func TestServiceResource_AlertsFromAPI(t *testing.T) {
+	planned := []ServiceAlertModel{
+		{Metric: types.StringValue("disk_usage_percent"), Threshold: types.Float64Value(85), Comparison: types.StringValue("gte"), NotificationURL: types.StringValue("https://example.com/disk")},
+		{Metric: types.StringValue("connections"), Threshold: types.Float64Value(200), Comparison: types.StringValue("gt"), NotificationURL: types.StringValue("https://example.com/db")},
+	}
+
+	// The API lists alerts in its own order, including one created outside Terraform
+	models := serviceAlertsFromAPI([]client.ServiceAlert{
+		{ID: 3, Metric: "cpu_usage_percent", Threshold: 95, Comparison: "gt", NotificationURL: "https://example.com/cpu"},
+		{ID: 2, Metric: "connections", Threshold: 250, Comparison: "gt"},
+		{ID: 1, Metric: "disk_usage_percent", Threshold: 85, Comparison: "gte", NotificationURL: "https://example.com/other"},
+	}, planned)
+
+	expected := []ServiceAlertModel{
+		{Metric: types.StringValue("disk_usage_percent"), Threshold: types.Float64Value(85), Comparison: types.StringValue("gte"), NotificationURL: types.StringValue("https://example.com/other")},
+		{Metric: types.StringValue("connections"), Threshold: types.Float64Value(250), Comparison: types.StringValue("gt"), NotificationURL: types.StringValue("https://example.com/db")},
+		{Metric: types.StringValue("cpu_usage_percent"), Threshold: types.Float64Value(95), Comparison: types.StringValue("gt"), NotificationURL: types.StringValue("https://example.com/cpu")},
+	}
+	if !reflect.DeepEqual(models, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, models)
+	}
+
+	// Alerts deleted outside Terraform disappear from the state
+	if models := serviceAlertsFromAPI(nil, planned); len(models) != 0 {
+		t.Errorf("Expected no alerts, got %+v", models)
+	}
+}
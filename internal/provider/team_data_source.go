@@ -64,6 +64,8 @@ func (d *TeamDataSource) Configure(ctx context.Context, req datasource.Configure
 }
 
 func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, d.client, &resp.Diagnostics)
+
 	var data TeamDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	// Embed the time zone database so validation does not depend on the host
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = timezoneNameValidator{}
+
+// timezoneNameValidator checks that a value is an IANA time zone name such as
+// "Europe/Amsterdam" or "UTC".
+type timezoneNameValidator struct{}
+
+func timezoneName() validator.String {
+	return timezoneNameValidator{}
+}
+
+func (v timezoneNameValidator) Description(ctx context.Context) string {
+	return "value must be an IANA time zone name such as Europe/Amsterdam or UTC"
+}
+
+func (v timezoneNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timezoneNameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	// time.LoadLocation treats "" and "Local" as the host zone, which the API cannot interpret
+	if _, err := time.LoadLocation(value); err != nil || value == "" || value == "Local" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Time Zone",
+			fmt.Sprintf("%q is not a valid IANA time zone name (e.g. Europe/Amsterdam or UTC)", value),
+		)
+	}
+}
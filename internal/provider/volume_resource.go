@@ -3,18 +3,28 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/ploi/terraform-provider-ploicloud/internal/client"
 )
 
 var _ resource.Resource = &VolumeResource{}
 var _ resource.ResourceWithImportState = &VolumeResource{}
+var _ resource.ResourceWithValidateConfig = &VolumeResource{}
+
+// provisionedPerformanceStorageClasses are the storage classes whose IOPS and
+// throughput can be provisioned
+var provisionedPerformanceStorageClasses = []string{"fast-ssd", "ssd"}
 
 func NewVolumeResource() resource.Resource {
 	return &VolumeResource{}
@@ -22,16 +32,26 @@ func NewVolumeResource() resource.Resource {
 
 type VolumeResource struct {
 	client *client.Client
+
+	// resizeTimeout controls how long Update waits for a resize when
+	// wait_for_resize is set. A zero value uses the default.
+	resizeTimeout time.Duration
 }
 
+const defaultVolumeResizeTimeout = 30 * time.Minute
+
 type VolumeResourceModel struct {
-	ID            types.Int64  `tfsdk:"id"`
-	ApplicationID types.Int64  `tfsdk:"application_id"`
-	Name          types.String `tfsdk:"name"`
-	Size          types.Int64  `tfsdk:"size"`
-	MountPath     types.String `tfsdk:"mount_path"`
-	StorageClass  types.String `tfsdk:"storage_class"`
-	ResizeStatus  types.String `tfsdk:"resize_status"`
+	ID             types.Int64  `tfsdk:"id"`
+	ApplicationID  types.Int64  `tfsdk:"application_id"`
+	Name           types.String `tfsdk:"name"`
+	Size           types.Int64  `tfsdk:"size"`
+	MountPath      types.String `tfsdk:"mount_path"`
+	StorageClass   types.String `tfsdk:"storage_class"`
+	ResizeStatus   types.String `tfsdk:"resize_status"`
+	ReclaimPolicy  types.String `tfsdk:"reclaim_policy"`
+	IOPS           types.Int64  `tfsdk:"iops"`
+	ThroughputMBps types.Int64  `tfsdk:"throughput_mbps"`
+	WaitForResize  types.Bool   `tfsdk:"wait_for_resize"`
 }
 
 func (r *VolumeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -68,10 +88,38 @@ func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 				MarkdownDescription: "Storage class for the volume",
 			},
+			"reclaim_policy": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "What happens to the underlying storage when the volume is deleted: `Retain` keeps the data, `Delete` removes it. Defaults to the platform default",
+				Validators: []validator.String{
+					stringvalidator.OneOf("Retain", "Delete"),
+				},
+			},
+			"iops": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Provisioned IOPS. Only applies to the fast-ssd and ssd storage classes",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"throughput_mbps": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Provisioned throughput in MB/s. Only applies to the fast-ssd and ssd storage classes",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"resize_status": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Volume resize status",
 			},
+			"wait_for_resize": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Wait until a resize triggered by changing `size` completes, and fail the apply if it does not",
+			},
 		},
 	}
 }
@@ -93,7 +141,55 @@ func (r *VolumeResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.client = client
 }
 
+func (r *VolumeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VolumeResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateVolumePerformance(&data)...)
+}
+
+// validateVolumePerformance warns when provisioned performance is configured
+// for a storage class that does not support it. The API ignores the values
+// for those classes rather than rejecting them.
+func validateVolumePerformance(data *VolumeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.StorageClass.IsNull() || data.StorageClass.IsUnknown() {
+		return diags
+	}
+	for _, storageClass := range provisionedPerformanceStorageClasses {
+		if data.StorageClass.ValueString() == storageClass {
+			return diags
+		}
+	}
+
+	attributes := []struct {
+		name  string
+		value types.Int64
+	}{
+		{"iops", data.IOPS},
+		{"throughput_mbps", data.ThroughputMBps},
+	}
+	for _, attr := range attributes {
+		if attr.value.IsNull() {
+			continue
+		}
+		diags.AddAttributeWarning(
+			path.Root(attr.name),
+			"Provisioned Performance Not Supported",
+			fmt.Sprintf("%s only applies to the %s storage classes and is ignored for %q", attr.name, strings.Join(provisionedPerformanceStorageClasses, ", "), data.StorageClass.ValueString()),
+		)
+	}
+	return diags
+}
+
 func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data VolumeResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -119,6 +215,8 @@ func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *VolumeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data VolumeResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -126,7 +224,7 @@ func (r *VolumeResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	volume, err := r.client.GetVolume(data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
+	volume, err := r.client.GetVolume(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume, got error: %s", err))
 		return
@@ -139,20 +237,27 @@ func (r *VolumeResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	r.fromAPIModel(volume, &data)
 
+	if data.WaitForResize.IsNull() {
+		data.WaitForResize = types.BoolValue(false)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *VolumeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data VolumeResourceModel
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
+	var data, state VolumeResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	volume := r.toAPIModel(&data)
 
-	updated, err := r.client.UpdateVolume(data.ApplicationID.ValueInt64(), data.ID.ValueInt64(), volume)
+	updated, err := r.client.UpdateVolume(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64(), volume)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update volume, got error: %s", err))
 		return
@@ -160,10 +265,28 @@ func (r *VolumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	r.fromAPIModel(updated, &data)
 
+	// Only a size change starts a resize, so other updates never wait
+	if data.WaitForResize.ValueBool() && !data.Size.Equal(state.Size) {
+		timeout := r.resizeTimeout
+		if timeout <= 0 {
+			timeout = defaultVolumeResizeTimeout
+		}
+
+		resized, err := r.client.WaitForVolumeResize(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64(), timeout)
+		if resized != nil {
+			r.fromAPIModel(resized, &data)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Volume Resize Failed", fmt.Sprintf("Volume %q did not finish resizing, got error: %s", data.Name.ValueString(), err))
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *VolumeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data VolumeResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -171,29 +294,25 @@ func (r *VolumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteVolume(data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
-	if err != nil {
+	err := r.client.DeleteVolume(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
+	// A volume that is already gone counts as deleted
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete volume, got error: %s", err))
 		return
 	}
-}
 
-func (r *VolumeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ".")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Invalid Import ID", "Import ID must be in the format 'application_id.volume_id'")
-		return
-	}
-
-	applicationID, err := strconv.ParseInt(parts[0], 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid Import ID", "Application ID must be a valid integer")
-		return
+	if data.ReclaimPolicy.ValueString() == "Retain" {
+		resp.Diagnostics.AddWarning(
+			"Volume Data Retained",
+			fmt.Sprintf("Volume %q was detached from the application, but its reclaim policy is Retain so the underlying storage and its data persist. Remove it from the Ploi Cloud dashboard when it is no longer needed.", data.Name.ValueString()),
+		)
 	}
+}
 
-	volumeID, err := strconv.ParseInt(parts[1], 10, 64)
+func (r *VolumeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	applicationID, volumeID, err := parseImportID(req.ID, "Volume")
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid Import ID", "Volume ID must be a valid integer")
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
 		return
 	}
 
@@ -217,6 +336,17 @@ func (r *VolumeResource) toAPIModel(data *VolumeResourceModel) *client.Applicati
 		volume.StorageClass = data.StorageClass.ValueString()
 	}
 
+	if !data.ReclaimPolicy.IsNull() && !data.ReclaimPolicy.IsUnknown() {
+		volume.ReclaimPolicy = data.ReclaimPolicy.ValueString()
+	}
+
+	if !data.IOPS.IsNull() && !data.IOPS.IsUnknown() {
+		volume.IOPS = data.IOPS.ValueInt64()
+	}
+	if !data.ThroughputMBps.IsNull() && !data.ThroughputMBps.IsUnknown() {
+		volume.ThroughputMBps = data.ThroughputMBps.ValueInt64()
+	}
+
 	return volume
 }
 
@@ -228,4 +358,22 @@ func (r *VolumeResource) fromAPIModel(volume *client.ApplicationVolume, data *Vo
 	data.MountPath = types.StringValue(volume.MountPath)
 	data.StorageClass = types.StringValue(volume.StorageClass)
 	data.ResizeStatus = types.StringValue(volume.ResizeStatus)
-}
\ No newline at end of file
+
+	// Keep the planned reclaim policy if the API does not report one
+	if volume.ReclaimPolicy != "" {
+		data.ReclaimPolicy = types.StringValue(volume.ReclaimPolicy)
+	} else if data.ReclaimPolicy.IsUnknown() {
+		data.ReclaimPolicy = types.StringNull()
+	}
+
+	if volume.IOPS != 0 {
+		data.IOPS = types.Int64Value(volume.IOPS)
+	} else if data.IOPS.IsUnknown() {
+		data.IOPS = types.Int64Null()
+	}
+	if volume.ThroughputMBps != 0 {
+		data.ThroughputMBps = types.Int64Value(volume.ThroughputMBps)
+	} else if data.ThroughputMBps.IsUnknown() {
+		data.ThroughputMBps = types.Int64Null()
+	}
+}
@@ -9,7 +9,10 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/ploi/terraform-provider-ploicloud/internal/client"
 )
 
@@ -488,7 +491,7 @@ func TestVolumeResource_StorageClass_APIClientIntegration(t *testing.T) {
 		StorageClass:  "fast-ssd",
 	}
 	
-	created, err := c.CreateVolume(volume)
+	created, err := c.CreateVolume(context.Background(), volume)
 	if err != nil {
 		t.Fatalf("Failed to create volume: %v", err)
 	}
@@ -634,4 +637,277 @@ func TestVolumeResource_StorageClass_ConversionAccuracy(t *testing.T) {
 		t.Errorf("Round-trip conversion failed: expected %v, got %v", 
 			originalData.StorageClass, convertedData.StorageClass)
 	}
-}
\ No newline at end of file
+}
+func TestVolumeResource_ReclaimPolicy_Mapping(t *testing.T) {
+	resource := &VolumeResource{}
+
+	data := &VolumeResourceModel{
+		ApplicationID: types.Int64Value(100),
+		Name:          types.StringValue("uploads"),
+		Size:          types.Int64Value(10),
+		MountPath:     types.StringValue("/var/www/storage"),
+		StorageClass:  types.StringNull(),
+		ReclaimPolicy: types.StringValue("Retain"),
+	}
+
+	if volume := resource.toAPIModel(data); volume.ReclaimPolicy != "Retain" {
+		t.Errorf("Expected ReclaimPolicy 'Retain', got '%s'", volume.ReclaimPolicy)
+	}
+
+	data.ReclaimPolicy = types.StringUnknown()
+	if volume := resource.toAPIModel(data); volume.ReclaimPolicy != "" {
+		t.Errorf("Expected ReclaimPolicy to be omitted for the platform default, got '%s'", volume.ReclaimPolicy)
+	}
+
+	tests := []struct {
+		name      string
+		planned   types.String
+		apiPolicy string
+		expected  types.String
+	}{
+		{"platform default reported", types.StringUnknown(), "Delete", types.StringValue("Delete")},
+		{"configured value read back", types.StringValue("Retain"), "Retain", types.StringValue("Retain")},
+		{"planned value kept when omitted", types.StringValue("Retain"), "", types.StringValue("Retain")},
+		{"unknown becomes null when omitted", types.StringUnknown(), "", types.StringNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &VolumeResourceModel{ReclaimPolicy: tt.planned}
+			resource.fromAPIModel(&client.ApplicationVolume{ID: 1, ApplicationID: 100, ReclaimPolicy: tt.apiPolicy}, data)
+			if !data.ReclaimPolicy.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, data.ReclaimPolicy)
+			}
+		})
+	}
+}
+
+func TestVolumeResource_ReclaimPolicy_Validation(t *testing.T) {
+	r := NewVolumeResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	attr := resp.Schema.Attributes["reclaim_policy"].(schema.StringAttribute)
+	for value, expectError := range map[string]bool{"Retain": false, "Delete": false, "retain": true, "Recycle": true} {
+		if diags := runStringValidators(t, attr.Validators, value); diags.HasError() != expectError {
+			t.Errorf("Expected error %v for %q, got diagnostics: %v", expectError, value, diags)
+		}
+	}
+}
+
+func TestVolumeResource_ReclaimPolicy_DeleteWarning(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" && r.URL.Path == "/applications/100/volumes/7" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &VolumeResource{client: client.NewClient("test-token", &server.URL)}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	for policy, expectWarning := range map[string]bool{"Retain": true, "Delete": false} {
+		t.Run(policy, func(t *testing.T) {
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			state.Set(ctx, &VolumeResourceModel{
+				ID:            types.Int64Value(7),
+				ApplicationID: types.Int64Value(100),
+				Name:          types.StringValue("uploads"),
+				Size:          types.Int64Value(10),
+				MountPath:     types.StringValue("/var/www/storage"),
+				StorageClass:  types.StringNull(),
+				ResizeStatus:  types.StringNull(),
+				ReclaimPolicy: types.StringValue(policy),
+			})
+
+			resp := &resource.DeleteResponse{State: state}
+			r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+			}
+			if (resp.Diagnostics.WarningsCount() > 0) != expectWarning {
+				t.Errorf("Expected warning %v, got diagnostics: %v", expectWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestVolumeResource_Performance_Mapping(t *testing.T) {
+	resource := &VolumeResource{}
+
+	data := &VolumeResourceModel{
+		ApplicationID:  types.Int64Value(100),
+		Name:           types.StringValue("mysql-data"),
+		Size:           types.Int64Value(50),
+		MountPath:      types.StringValue("/var/lib/mysql"),
+		StorageClass:   types.StringValue("fast-ssd"),
+		IOPS:           types.Int64Value(6000),
+		ThroughputMBps: types.Int64Value(250),
+	}
+
+	volume := resource.toAPIModel(data)
+	if volume.IOPS != 6000 || volume.ThroughputMBps != 250 {
+		t.Errorf("Expected IOPS 6000 and throughput 250, got %d / %d", volume.IOPS, volume.ThroughputMBps)
+	}
+
+	data.IOPS = types.Int64Null()
+	data.ThroughputMBps = types.Int64Unknown()
+	if volume := resource.toAPIModel(data); volume.IOPS != 0 || volume.ThroughputMBps != 0 {
+		t.Errorf("Expected performance to be omitted, got %d / %d", volume.IOPS, volume.ThroughputMBps)
+	}
+
+	tests := []struct {
+		name               string
+		plannedIOPS        types.Int64
+		apiIOPS            int64
+		expectedIOPS       types.Int64
+		plannedThroughput  types.Int64
+		apiThroughput      int64
+		expectedThroughput types.Int64
+	}{
+		{"read back", types.Int64Value(3000), 3000, types.Int64Value(3000), types.Int64Value(125), 125, types.Int64Value(125)},
+		{"drift detected", types.Int64Value(3000), 4000, types.Int64Value(4000), types.Int64Null(), 125, types.Int64Value(125)},
+		{"planned kept when omitted", types.Int64Value(3000), 0, types.Int64Value(3000), types.Int64Null(), 0, types.Int64Null()},
+		{"unknown becomes null when omitted", types.Int64Unknown(), 0, types.Int64Null(), types.Int64Unknown(), 0, types.Int64Null()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &VolumeResourceModel{IOPS: tt.plannedIOPS, ThroughputMBps: tt.plannedThroughput}
+			resource.fromAPIModel(&client.ApplicationVolume{ID: 1, ApplicationID: 100, IOPS: tt.apiIOPS, ThroughputMBps: tt.apiThroughput}, data)
+			if !data.IOPS.Equal(tt.expectedIOPS) {
+				t.Errorf("Expected IOPS %v, got %v", tt.expectedIOPS, data.IOPS)
+			}
+			if !data.ThroughputMBps.Equal(tt.expectedThroughput) {
+				t.Errorf("Expected throughput %v, got %v", tt.expectedThroughput, data.ThroughputMBps)
+			}
+		})
+	}
+}
+
+func TestVolumeResource_Performance_Validation(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewVolumeResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	for _, name := range []string{"iops", "throughput_mbps"} {
+		attr := resp.Schema.Attributes[name].(schema.Int64Attribute)
+		for value, expectError := range map[int64]bool{1: false, 16000: false, 0: true, -100: true} {
+			if diags := runInt64Validators(t, attr.Validators, value); diags.HasError() != expectError {
+				t.Errorf("Expected error %v for %s %d, got diagnostics: %v", expectError, name, value, diags)
+			}
+		}
+	}
+
+	tests := []struct {
+		name             string
+		storageClass     types.String
+		iops             types.Int64
+		throughput       types.Int64
+		expectedWarnings int
+	}{
+		{"fast-ssd", types.StringValue("fast-ssd"), types.Int64Value(6000), types.Int64Value(250), 0},
+		{"ssd", types.StringValue("ssd"), types.Int64Value(3000), types.Int64Null(), 0},
+		{"standard", types.StringValue("standard"), types.Int64Value(3000), types.Int64Value(125), 2},
+		{"standard with iops only", types.StringValue("standard"), types.Int64Value(3000), types.Int64Null(), 1},
+		{"standard without performance", types.StringValue("standard"), types.Int64Null(), types.Int64Null(), 0},
+		{"platform default", types.StringNull(), types.Int64Value(3000), types.Int64Null(), 0},
+		{"unknown storage class", types.StringUnknown(), types.Int64Value(3000), types.Int64Null(), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateVolumePerformance(&VolumeResourceModel{StorageClass: tt.storageClass, IOPS: tt.iops, ThroughputMBps: tt.throughput})
+			if diags.HasError() {
+				t.Fatalf("Expected only warnings, got %v", diags)
+			}
+			if diags.WarningsCount() != tt.expectedWarnings {
+				t.Errorf("Expected %d warnings, got %v", tt.expectedWarnings, diags)
+			}
+		})
+	}
+}
+
+func TestVolumeResource_Update_WaitForResize(t *testing.T) {
+	tests := []struct {
+		name           string
+		plannedSize    int64
+		getStatus      string
+		expectGets     int
+		expectError    bool
+		expectedStatus string
+	}{
+		{"waits for a resize to complete", 20, "completed", 1, false, "completed"},
+		{"reports a failed resize", 20, "failed", 1, true, "failed"},
+		{"skips the wait when size is unchanged", 10, "completed", 0, false, "resizing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gets := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/applications/100/volumes/7" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				status := "resizing"
+				if r.Method == "GET" {
+					gets++
+					status = tt.getStatus
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"data": {"id": 7, "application_id": 100, "name": "uploads", "size": %d, "mount_path": "/var/www/storage", "resize_status": "%s"}}`, tt.plannedSize, status)
+			}))
+			defer server.Close()
+
+			ctx := context.Background()
+			r := &VolumeResource{client: client.NewClient("test-token", &server.URL), resizeTimeout: time.Second}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			model := VolumeResourceModel{
+				ID:            types.Int64Value(7),
+				ApplicationID: types.Int64Value(100),
+				Name:          types.StringValue("uploads"),
+				Size:          types.Int64Value(10),
+				MountPath:     types.StringValue("/var/www/storage"),
+				StorageClass:  types.StringValue("standard"),
+				ResizeStatus:  types.StringValue("completed"),
+				WaitForResize: types.BoolValue(true),
+			}
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			state.Set(ctx, &model)
+
+			model.Size = types.Int64Value(tt.plannedSize)
+			model.ResizeStatus = types.StringUnknown()
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			plan.Set(ctx, &model)
+
+			resp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Fatalf("Expected error %v, got diagnostics: %v", tt.expectError, resp.Diagnostics)
+			}
+			if gets != tt.expectGets {
+				t.Errorf("Expected %d status reads, got %d", tt.expectGets, gets)
+			}
+
+			var result VolumeResourceModel
+			resp.State.Get(ctx, &result)
+			if result.ResizeStatus.ValueString() != tt.expectedStatus {
+				t.Errorf("Expected resize_status %q in state, got %v", tt.expectedStatus, result.ResizeStatus)
+			}
+		})
+	}
+}
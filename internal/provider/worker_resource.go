@@ -3,8 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -112,6 +110,8 @@ func (r *WorkerResource) Configure(ctx context.Context, req resource.ConfigureRe
 }
 
 func (r *WorkerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data WorkerResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -152,6 +152,8 @@ func (r *WorkerResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *WorkerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data WorkerResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -159,7 +161,7 @@ func (r *WorkerResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	worker, err := r.client.GetWorker(data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
+	worker, err := r.client.GetWorker(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read worker, got error: %s", err))
 		return
@@ -176,6 +178,8 @@ func (r *WorkerResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *WorkerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data WorkerResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -185,7 +189,7 @@ func (r *WorkerResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	worker := r.toAPIModel(&data)
 
-	updated, err := r.client.UpdateWorker(data.ApplicationID.ValueInt64(), data.ID.ValueInt64(), worker)
+	updated, err := r.client.UpdateWorker(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64(), worker)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update worker, got error: %s", err))
 		return
@@ -197,6 +201,8 @@ func (r *WorkerResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *WorkerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer appendDeprecationWarnings(ctx, r.client, &resp.Diagnostics)
+
 	var data WorkerResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -204,29 +210,18 @@ func (r *WorkerResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteWorker(data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
-	if err != nil {
+	err := r.client.DeleteWorker(ctx, data.ApplicationID.ValueInt64(), data.ID.ValueInt64())
+	// A worker that is already gone counts as deleted
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete worker, got error: %s", err))
 		return
 	}
 }
 
 func (r *WorkerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ".")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Invalid Import ID", "Import ID must be in the format 'application_id.worker_id'")
-		return
-	}
-
-	applicationID, err := strconv.ParseInt(parts[0], 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid Import ID", "Application ID must be a valid integer")
-		return
-	}
-
-	workerID, err := strconv.ParseInt(parts[1], 10, 64)
+	applicationID, workerID, err := parseImportID(req.ID, "Worker")
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid Import ID", "Worker ID must be a valid integer")
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
 		return
 	}
 
@@ -639,7 +639,7 @@ func TestWorkerResource_APIClientIntegration(t *testing.T) {
 		CPURequest:    "250m",
 	}
 	
-	created, err := c.CreateWorker(worker)
+	created, err := c.CreateWorker(context.Background(), worker)
 	if err != nil {
 		t.Fatalf("Failed to create worker: %v", err)
 	}